@@ -92,6 +92,99 @@ type GruleV3ParserListener struct {
 	StopParse     bool
 	ErrorCallback *pkg.GruleErrorReporter
 	KnowledgeBase *ast.KnowledgeBase
+
+	// ruleName is the name of the rule entry, or the scope of the function, being parsed. It scopes its local variables.
+	ruleName string
+	// locals are the local variables declared by let statements, one map for each nested block of the rule entry.
+	locals []map[string]*ast.Variable
+	// imports are the qualified names of the functions and consts imported one by one, by their simple name.
+	imports map[string]string
+	// wildcardImports are the packages imported with .*, in the order of their imports.
+	wildcardImports []string
+	// grlConstants are the simple names of all the consts declared in this GRL, to report the ones used before their
+	// declaration.
+	grlConstants map[string]bool
+}
+
+// declareLocal will declare the local variable of a let statement in the innermost block.
+func (thisListener *GruleV3ParserListener) declareLocal(variable *ast.Variable) error {
+	if thisListener.lookupLocal(variable.Name) != nil {
+
+		return fmt.Errorf("local variable %s is already declared in rule %s", variable.Name, thisListener.ruleName)
+	}
+	thisListener.locals[len(thisListener.locals)-1][variable.Name] = variable
+
+	return nil
+}
+
+// lookupLocal returns the local variable visible in the current block with the name, nil if there is none.
+func (thisListener *GruleV3ParserListener) lookupLocal(name string) *ast.Variable {
+	for i := len(thisListener.locals) - 1; i >= 0; i-- {
+		if variable, ok := thisListener.locals[i][name]; ok {
+
+			return variable
+		}
+	}
+
+	return nil
+}
+
+// qualify returns the name qualified with the package of this GRL, the name itself if the GRL declares no package.
+func (thisListener *GruleV3ParserListener) qualify(name string) string {
+	if len(thisListener.Grl.Package) == 0 {
+
+		return name
+	}
+
+	return thisListener.Grl.Package + "." + name
+}
+
+// resolveName returns the qualified name a name used in this GRL refers to, looking for it in the package of the GRL
+// first, then in the imports. It returns the name itself when exists is false for all of them.
+func (thisListener *GruleV3ParserListener) resolveName(name string, exists func(qualified string) bool) string {
+	candidates := make([]string, 0, 2+len(thisListener.wildcardImports))
+	if len(thisListener.Grl.Package) > 0 {
+		candidates = append(candidates, thisListener.qualify(name))
+	}
+	if imported, ok := thisListener.imports[name]; ok {
+		candidates = append(candidates, imported)
+	}
+	for _, pkgName := range thisListener.wildcardImports {
+		candidates = append(candidates, pkgName+"."+name)
+	}
+	for _, candidate := range candidates {
+		if exists(candidate) {
+
+			return candidate
+		}
+	}
+
+	return name
+}
+
+// lookupConstant returns the value of the const the name refers to, declared earlier in this GRL or in an earlier
+// resource of the knowledge base, nil if there is none.
+func (thisListener *GruleV3ParserListener) lookupConstant(name string) *ast.Constant {
+	exists := func(qualified string) bool {
+
+		return thisListener.declaredConstant(qualified) != nil
+	}
+
+	return thisListener.declaredConstant(thisListener.resolveName(name, exists))
+}
+
+// declaredConstant returns the value of the const declared with the qualified name, nil if there is none.
+func (thisListener *GruleV3ParserListener) declaredConstant(name string) *ast.Constant {
+	if declaration, ok := thisListener.Grl.Declarations[name]; ok {
+		if declaration.Kind == ast.DeclarationConst {
+
+			return declaration.Constant
+		}
+
+		return nil
+	}
+
+	return thisListener.KnowledgeBase.GetConstant(name)
 }
 
 // VisitTerminal is called when a terminal node is visited.
@@ -122,6 +215,12 @@ func (thisListener *GruleV3ParserListener) ExitEveryRule(ctx antlr.ParserRuleCon
 func (thisListener *GruleV3ParserListener) EnterGrl(ctx *grulev3.GrlContext) {
 	thisListener.Grl = ast.NewGrl()
 	thisListener.Stack.Push(thisListener.Grl)
+	thisListener.grlConstants = make(map[string]bool)
+	for _, declaration := range ctx.AllConstDeclaration() {
+		if declaration.SIMPLENAME() != nil {
+			thisListener.grlConstants[declaration.SIMPLENAME().GetText()] = true
+		}
+	}
 }
 
 // ExitGrl is called when production root is exited. The listener will instruct working memory re-index here.
@@ -135,12 +234,253 @@ func (thisListener *GruleV3ParserListener) ExitGrl(ctx *grulev3.GrlContext) {
 
 		return
 	}
-	for _, re := range thisListener.Grl.RuleEntries {
+	resolved := make(map[string]bool)
+	for _, re := range thisListener.Grl.DeclaredRuleEntries() {
+		err := thisListener.extendRuleEntry(re, resolved, nil)
+		if err != nil {
+			thisListener.StopParse = true
+			thisListener.ErrorCallback.AddError(err)
+
+			return
+		}
+	}
+	for _, re := range thisListener.Grl.DeclaredRuleEntries() {
 		err := thisListener.KnowledgeBase.AddRuleEntry(re)
 		if err != nil {
 			thisListener.ErrorCallback.AddError(err)
 		}
 	}
+	for _, function := range thisListener.Grl.Functions {
+		err := thisListener.KnowledgeBase.AddFunction(function)
+		if err != nil {
+			thisListener.ErrorCallback.AddError(err)
+		}
+	}
+	for _, declaration := range thisListener.Grl.Declarations {
+		err := thisListener.KnowledgeBase.AddDeclaration(declaration)
+		if err != nil {
+			thisListener.ErrorCallback.AddError(err)
+		}
+	}
+}
+
+// extendRuleEntry ANDs the when scope expression of a rule entry declared with extends with the one of its parent,
+// which is declared in this GRL or in an earlier resource of the knowledge base. A parent declared in this GRL is
+// extended first, chain holds the rule entries being extended to detect the cycles.
+func (thisListener *GruleV3ParserListener) extendRuleEntry(entry *ast.RuleEntry, resolved map[string]bool, chain []string) error {
+	if len(entry.Extends) == 0 || resolved[entry.RuleName] {
+
+		return nil
+	}
+	exists := func(qualified string) bool {
+		_, ok := thisListener.Grl.RuleEntries[qualified]
+
+		return ok || thisListener.KnowledgeBase.ContainsRuleEntry(qualified)
+	}
+	entry.Extends = thisListener.resolveName(entry.Extends, exists)
+	for i, name := range chain {
+		if name == entry.RuleName {
+
+			return fmt.Errorf("rule %s can not extend itself: %s", entry.RuleName, strings.Join(append(chain[i:], entry.RuleName), " extends "))
+		}
+	}
+	parent, ok := thisListener.Grl.RuleEntries[entry.Extends]
+	if ok {
+		err := thisListener.extendRuleEntry(parent, resolved, append(chain, entry.RuleName))
+		if err != nil {
+
+			return err
+		}
+	} else if parent, ok = thisListener.KnowledgeBase.RuleEntries[entry.Extends]; !ok {
+
+		return fmt.Errorf("rule %s extends rule %s, which is not declared in knowledge base %s:%s", entry.RuleName, entry.Extends, thisListener.KnowledgeBase.Name, thisListener.KnowledgeBase.Version)
+	}
+	expr := ast.NewExpression()
+	expr.GrlText = fmt.Sprintf("(%s) && (%s)", parent.WhenScope.Expression.GetGrlText(), entry.WhenScope.Expression.GetGrlText())
+	expr.LeftExpression = parent.WhenScope.Expression
+	expr.RightExpression = entry.WhenScope.Expression
+	expr.Operator = ast.OpAnd
+	entry.WhenScope.Expression = thisListener.KnowledgeBase.WorkingMemory.AddExpression(expr)
+	resolved[entry.RuleName] = true
+
+	return nil
+}
+
+// EnterPackageDeclaration is called when production packageDeclaration is entered.
+func (thisListener *GruleV3ParserListener) EnterPackageDeclaration(ctx *grulev3.PackageDeclarationContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	thisListener.Grl.Package = ctx.QualifiedName().GetText()
+}
+
+// EnterImportDeclaration is called when production importDeclaration is entered. The imported functions and consts
+// must be declared in an earlier resource of the knowledge base.
+func (thisListener *GruleV3ParserListener) EnterImportDeclaration(ctx *grulev3.ImportDeclarationContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	name := ctx.QualifiedName().GetText()
+	if ctx.MUL() != nil {
+		if !thisListener.KnowledgeBase.ContainsPackage(name) {
+			thisListener.StopParse = true
+			thisListener.ErrorCallback.AddError(fmt.Errorf("import %s.*: package %s is not in knowledge base %s:%s", name, name, thisListener.KnowledgeBase.Name, thisListener.KnowledgeBase.Version))
+
+			return
+		}
+		thisListener.wildcardImports = append(thisListener.wildcardImports, name)
+
+		return
+	}
+	if thisListener.KnowledgeBase.WorkingMemory.GetFunction(name) == nil && thisListener.KnowledgeBase.GetConstant(name) == nil {
+		thisListener.StopParse = true
+		// the rules, and so the templates they are expanded from, are not imported.
+		if entry, ok := thisListener.KnowledgeBase.RuleEntries[name]; ok {
+			if template := entry.GetMetadata(pkg.TemplateMetadata); len(template) > 0 {
+				thisListener.ErrorCallback.AddError(fmt.Errorf("import %s: rule %s is expanded from template %s, templates can not be imported, only functions and consts", name, name, template[0]))
+			} else {
+				thisListener.ErrorCallback.AddError(fmt.Errorf("import %s: %s is a rule, only functions and consts can be imported", name, name))
+			}
+
+			return
+		}
+		thisListener.ErrorCallback.AddError(fmt.Errorf("import %s: there is no function nor const %s in knowledge base %s:%s", name, name, thisListener.KnowledgeBase.Name, thisListener.KnowledgeBase.Version))
+
+		return
+	}
+	simpleName := name[strings.LastIndex(name, ".")+1:]
+	if imported, ok := thisListener.imports[simpleName]; ok && imported != name {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(fmt.Errorf("import %s conflicts with import %s", name, imported))
+
+		return
+	}
+	if thisListener.imports == nil {
+		thisListener.imports = make(map[string]string)
+	}
+	thisListener.imports[simpleName] = name
+}
+
+// EnterConstDeclaration is called when production constDeclaration is entered.
+func (thisListener *GruleV3ParserListener) EnterConstDeclaration(ctx *grulev3.ConstDeclarationContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	declaration := ast.NewDeclaration(ast.DeclarationConst)
+	declaration.GrlText = ctx.GetText()
+	declaration.Name = thisListener.qualify(ctx.SIMPLENAME().GetText())
+	thisListener.Stack.Push(declaration)
+}
+
+// ExitConstDeclaration is called when production constDeclaration is exited.
+func (thisListener *GruleV3ParserListener) ExitConstDeclaration(ctx *grulev3.ConstDeclarationContext) {
+	thisListener.exitDeclaration()
+}
+
+// EnterGlobalDeclaration is called when production globalDeclaration is entered.
+func (thisListener *GruleV3ParserListener) EnterGlobalDeclaration(ctx *grulev3.GlobalDeclarationContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	declaration := ast.NewDeclaration(ast.DeclarationGlobal)
+	declaration.GrlText = ctx.GetText()
+	declaration.Name = ctx.SIMPLENAME().GetText()
+	declaration.Type = ctx.TypeName().GetText()
+	thisListener.Stack.Push(declaration)
+}
+
+// ExitGlobalDeclaration is called when production globalDeclaration is exited.
+func (thisListener *GruleV3ParserListener) ExitGlobalDeclaration(ctx *grulev3.GlobalDeclarationContext) {
+	thisListener.exitDeclaration()
+}
+
+// exitDeclaration pops a const or global declaration and hands it to its receiver.
+func (thisListener *GruleV3ParserListener) exitDeclaration() {
+	if thisListener.StopParse {
+
+		return
+	}
+	declaration, popOk := thisListener.Stack.Pop().(*ast.Declaration)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	receiver, popOk := thisListener.Stack.Peek().(ast.DeclarationReceiver)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	err := receiver.AcceptDeclaration(declaration)
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+	}
+}
+
+// EnterTypeName is called when production typeName is entered.
+func (thisListener *GruleV3ParserListener) EnterTypeName(ctx *grulev3.TypeNameContext) {}
+
+// ExitTypeName is called when production typeName is exited.
+func (thisListener *GruleV3ParserListener) ExitTypeName(ctx *grulev3.TypeNameContext) {}
+
+// EnterFunctionDeclaration is called when production functionDeclaration is entered.
+func (thisListener *GruleV3ParserListener) EnterFunctionDeclaration(ctx *grulev3.FunctionDeclarationContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	function := ast.NewFunction()
+	function.GrlText = ctx.GetText()
+	function.Name = thisListener.qualify(ctx.SIMPLENAME().GetText())
+	thisListener.ruleName = ast.FunctionScope(function.Name)
+	thisListener.locals = []map[string]*ast.Variable{make(map[string]*ast.Variable)}
+	if ctx.ParameterList() != nil {
+		for _, name := range ctx.ParameterList().AllIdentifier() {
+			vari := ast.NewVariable()
+			vari.Name = name.GetText()
+			vari.GrlText = vari.Name
+			vari.Local = ast.LocalVariableKey(thisListener.ruleName, vari.Name)
+			err := thisListener.declareLocal(vari)
+			if err != nil {
+				thisListener.StopParse = true
+				thisListener.ErrorCallback.AddError(err)
+
+				return
+			}
+			function.Parameters = append(function.Parameters, thisListener.KnowledgeBase.WorkingMemory.AddVariable(vari))
+		}
+	}
+	thisListener.Stack.Push(function)
+}
+
+// ExitFunctionDeclaration is called when production functionDeclaration is exited.
+func (thisListener *GruleV3ParserListener) ExitFunctionDeclaration(ctx *grulev3.FunctionDeclarationContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	function, popOk := thisListener.Stack.Pop().(*ast.Function)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	receiver, popOk := thisListener.Stack.Peek().(ast.FunctionReceiver)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	err := receiver.AcceptFunction(function)
+	if err != nil {
+		thisListener.ErrorCallback.AddError(err)
+	}
 }
 
 // EnterRuleEntry is called when production ruleEntry is entered.
@@ -151,6 +491,10 @@ func (thisListener *GruleV3ParserListener) EnterRuleEntry(ctx *grulev3.RuleEntry
 	}
 	entry := ast.NewRuleEntry()
 	entry.GrlText = ctx.GetText()
+	if ctx.RuleName() != nil {
+		thisListener.ruleName = thisListener.qualify(ctx.RuleName().GetText())
+	}
+	thisListener.locals = []map[string]*ast.Variable{make(map[string]*ast.Variable)}
 	thisListener.Stack.Push(entry)
 }
 
@@ -168,7 +512,11 @@ func (thisListener *GruleV3ParserListener) ExitRuleEntry(ctx *grulev3.RuleEntryC
 	}
 
 	if ctx.RuleName() != nil {
-		entry.RuleName = ctx.RuleName().GetText()
+		entry.RuleName = thisListener.qualify(ctx.RuleName().GetText())
+	}
+	entry.Package = thisListener.Grl.Package
+	if ctx.RuleExtends() != nil {
+		entry.Extends = ctx.RuleExtends().QualifiedName().GetText()
 	}
 	if ctx.RuleDescription() != nil {
 		txt := ctx.RuleDescription().GetText()
@@ -181,119 +529,404 @@ func (thisListener *GruleV3ParserListener) ExitRuleEntry(ctx *grulev3.RuleEntryC
 
 		return
 	}
-	err := entryReceiver.ReceiveRuleEntry(entry)
+	err := entryReceiver.ReceiveRuleEntry(entry)
+	if err != nil {
+		thisListener.ErrorCallback.AddError(err)
+	} else {
+		LoggerV3.Debugf("Added RuleEntry : %thisListener", entry.RuleName)
+	}
+}
+
+// EnterSalience is called when production salience is entered.
+func (thisListener *GruleV3ParserListener) EnterSalience(ctx *grulev3.SalienceContext) {
+	sal := ast.NewSalience(0)
+	thisListener.Stack.Push(sal)
+}
+
+// ExitSalience is called when production salience is exited.
+func (thisListener *GruleV3ParserListener) ExitSalience(ctx *grulev3.SalienceContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	salience, popOk := thisListener.Stack.Pop().(*ast.Salience)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	salienceReceiver, popOk := thisListener.Stack.Peek().(ast.SalienceReceiver)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	err := salienceReceiver.AcceptSalience(salience)
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+	}
+}
+
+// EnterAgendaGroup is called when production agendaGroup is entered.
+func (thisListener *GruleV3ParserListener) EnterAgendaGroup(ctx *grulev3.AgendaGroupContext) {
+	thisListener.Stack.Push(ast.NewAgendaGroup())
+}
+
+// ExitAgendaGroup is called when production agendaGroup is exited.
+func (thisListener *GruleV3ParserListener) ExitAgendaGroup(ctx *grulev3.AgendaGroupContext) {
+	thisListener.exitRuleGroup()
+}
+
+// EnterActivationGroup is called when production activationGroup is entered.
+func (thisListener *GruleV3ParserListener) EnterActivationGroup(ctx *grulev3.ActivationGroupContext) {
+	thisListener.Stack.Push(ast.NewActivationGroup())
+}
+
+// ExitActivationGroup is called when production activationGroup is exited.
+func (thisListener *GruleV3ParserListener) ExitActivationGroup(ctx *grulev3.ActivationGroupContext) {
+	thisListener.exitRuleGroup()
+}
+
+// EnterNoLoop is called when production noLoop is entered.
+func (thisListener *GruleV3ParserListener) EnterNoLoop(ctx *grulev3.NoLoopContext) {
+	thisListener.Stack.Push(ast.NewRuleFlag(ast.NoLoopFlag))
+}
+
+// ExitNoLoop is called when production noLoop is exited.
+func (thisListener *GruleV3ParserListener) ExitNoLoop(ctx *grulev3.NoLoopContext) {
+	thisListener.exitRuleFlag()
+}
+
+// EnterLockOnActive is called when production lockOnActive is entered.
+func (thisListener *GruleV3ParserListener) EnterLockOnActive(ctx *grulev3.LockOnActiveContext) {
+	thisListener.Stack.Push(ast.NewRuleFlag(ast.LockOnActiveFlag))
+}
+
+// ExitLockOnActive is called when production lockOnActive is exited.
+func (thisListener *GruleV3ParserListener) ExitLockOnActive(ctx *grulev3.LockOnActiveContext) {
+	thisListener.exitRuleFlag()
+}
+
+// EnterEnabled is called when production enabled is entered.
+func (thisListener *GruleV3ParserListener) EnterEnabled(ctx *grulev3.EnabledContext) {
+	thisListener.Stack.Push(ast.NewRuleFlag(ast.EnabledFlag))
+}
+
+// ExitEnabled is called when production enabled is exited.
+func (thisListener *GruleV3ParserListener) ExitEnabled(ctx *grulev3.EnabledContext) {
+	thisListener.exitRuleFlag()
+}
+
+// EnterDateEffective is called when production dateEffective is entered.
+func (thisListener *GruleV3ParserListener) EnterDateEffective(ctx *grulev3.DateEffectiveContext) {
+	thisListener.Stack.Push(ast.NewRuleDate(ast.DateEffectiveAttribute))
+}
+
+// ExitDateEffective is called when production dateEffective is exited.
+func (thisListener *GruleV3ParserListener) ExitDateEffective(ctx *grulev3.DateEffectiveContext) {
+	thisListener.exitRuleDate()
+}
+
+// EnterDateExpires is called when production dateExpires is entered.
+func (thisListener *GruleV3ParserListener) EnterDateExpires(ctx *grulev3.DateExpiresContext) {
+	thisListener.Stack.Push(ast.NewRuleDate(ast.DateExpiresAttribute))
+}
+
+// ExitDateExpires is called when production dateExpires is exited.
+func (thisListener *GruleV3ParserListener) ExitDateExpires(ctx *grulev3.DateExpiresContext) {
+	thisListener.exitRuleDate()
+}
+
+// EnterRuleMetadata is called when production ruleMetadata is entered.
+func (thisListener *GruleV3ParserListener) EnterRuleMetadata(ctx *grulev3.RuleMetadataContext) {
+	thisListener.Stack.Push(ast.NewRuleMetadata(ctx.SIMPLENAME().GetText()))
+}
+
+// ExitRuleMetadata is called when production ruleMetadata is exited.
+func (thisListener *GruleV3ParserListener) ExitRuleMetadata(ctx *grulev3.RuleMetadataContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	metadata, popOk := thisListener.Stack.Pop().(*ast.RuleMetadata)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	receiver, popOk := thisListener.Stack.Peek().(ast.RuleMetadataReceiver)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	err := receiver.AcceptRuleMetadata(metadata)
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+	}
+}
+
+// exitRuleDate hands over the date-effective or date-expires date on top of the stack to its receiver.
+func (thisListener *GruleV3ParserListener) exitRuleDate() {
+	if thisListener.StopParse {
+
+		return
+	}
+	date, popOk := thisListener.Stack.Pop().(*ast.RuleDate)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	receiver, popOk := thisListener.Stack.Peek().(ast.RuleDateReceiver)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	err := receiver.AcceptRuleDate(date)
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+	}
+}
+
+// exitRuleFlag hands over the no-loop, lock-on-active or enabled flag on top of the stack to its receiver.
+func (thisListener *GruleV3ParserListener) exitRuleFlag() {
+	if thisListener.StopParse {
+
+		return
+	}
+	flag, popOk := thisListener.Stack.Pop().(*ast.RuleFlag)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	receiver, popOk := thisListener.Stack.Peek().(ast.RuleFlagReceiver)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	err := receiver.AcceptRuleFlag(flag)
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+	}
+}
+
+// exitRuleGroup hands over the agenda group or activation group on top of the stack to its receiver.
+func (thisListener *GruleV3ParserListener) exitRuleGroup() {
+	if thisListener.StopParse {
+
+		return
+	}
+	group, popOk := thisListener.Stack.Pop().(*ast.RuleGroup)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	receiver, popOk := thisListener.Stack.Peek().(ast.RuleGroupReceiver)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	err := receiver.AcceptRuleGroup(group)
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+	}
+}
+
+// EnterWhenScope is called when production whenScope is entered.
+func (thisListener *GruleV3ParserListener) EnterWhenScope(ctx *grulev3.WhenScopeContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	whenScope := ast.NewWhenScope()
+	whenScope.GrlText = ctx.GetText()
+	thisListener.Stack.Push(whenScope)
+}
+
+// ExitWhenScope is called when production whenScope is exited.
+func (thisListener *GruleV3ParserListener) ExitWhenScope(ctx *grulev3.WhenScopeContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	when, popOk := thisListener.Stack.Pop().(*ast.WhenScope)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	receiver, popOk := thisListener.Stack.Peek().(ast.WhenScopeReceiver)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	err := receiver.AcceptWhenScope(when)
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+	}
+}
+
+// EnterThenScope is called when production thenScope is entered.
+func (thisListener *GruleV3ParserListener) EnterThenScope(ctx *grulev3.ThenScopeContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	then := ast.NewThenScope()
+	then.GrlText = ctx.GetText()
+	thisListener.Stack.Push(then)
+}
+
+// ExitThenScope is called when production thenScope is exited.
+func (thisListener *GruleV3ParserListener) ExitThenScope(ctx *grulev3.ThenScopeContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	then, popOk := thisListener.Stack.Pop().(*ast.ThenScope)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	receiver, popOk := thisListener.Stack.Peek().(ast.ThenScopeReceiver)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	err := receiver.AcceptThenScope(then)
 	if err != nil {
+		thisListener.StopParse = true
 		thisListener.ErrorCallback.AddError(err)
-	} else {
-		LoggerV3.Debugf("Added RuleEntry : %thisListener", entry.RuleName)
 	}
 }
 
-// EnterSalience is called when production salience is entered.
-func (thisListener *GruleV3ParserListener) EnterSalience(ctx *grulev3.SalienceContext) {
-	sal := ast.NewSalience(0)
-	thisListener.Stack.Push(sal)
+// EnterThenExpressionList is called when production thenExpressionList is entered.
+func (thisListener *GruleV3ParserListener) EnterThenExpressionList(ctx *grulev3.ThenExpressionListContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	thenExpList := ast.NewThenExpressionList()
+	thenExpList.GrlText = ctx.GetText()
+	thisListener.Stack.Push(thenExpList)
 }
 
-// ExitSalience is called when production salience is exited.
-func (thisListener *GruleV3ParserListener) ExitSalience(ctx *grulev3.SalienceContext) {
+// ExitThenExpressionList is called when production thenExpressionList is exited.
+func (thisListener *GruleV3ParserListener) ExitThenExpressionList(ctx *grulev3.ThenExpressionListContext) {
 	if thisListener.StopParse {
 
 		return
 	}
-	salience, popOk := thisListener.Stack.Pop().(*ast.Salience)
+	thenExpList, popOk := thisListener.Stack.Pop().(*ast.ThenExpressionList)
 	if !popOk {
 		thisListener.StopParse = true
 
 		return
 	}
-	salienceReceiver, popOk := thisListener.Stack.Peek().(ast.SalienceReceiver)
+	receiver, popOk := thisListener.Stack.Peek().(ast.ThenExpressionListReceiver)
 	if !popOk {
 		thisListener.StopParse = true
 
 		return
 	}
-	err := salienceReceiver.AcceptSalience(salience)
+	err := receiver.AcceptThenExpressionList(thenExpList)
 	if err != nil {
 		thisListener.StopParse = true
 		thisListener.ErrorCallback.AddError(err)
 	}
 }
 
-// EnterWhenScope is called when production whenScope is entered.
-func (thisListener *GruleV3ParserListener) EnterWhenScope(ctx *grulev3.WhenScopeContext) {
+// EnterThenExpression is called when production thenExpression is entered.
+func (thisListener *GruleV3ParserListener) EnterThenExpression(ctx *grulev3.ThenExpressionContext) {
 	if thisListener.StopParse {
 
 		return
 	}
-	whenScope := ast.NewWhenScope()
-	whenScope.GrlText = ctx.GetText()
-	thisListener.Stack.Push(whenScope)
+	thenExpr := ast.NewThenExpression()
+	thenExpr.GrlText = ctx.GetText()
+	thenExpr.Line = ctx.GetStart().GetLine()
+	thisListener.Stack.Push(thenExpr)
 }
 
-// ExitWhenScope is called when production whenScope is exited.
-func (thisListener *GruleV3ParserListener) ExitWhenScope(ctx *grulev3.WhenScopeContext) {
+// ExitThenExpression is called when production thenExpression is exited.
+func (thisListener *GruleV3ParserListener) ExitThenExpression(ctx *grulev3.ThenExpressionContext) {
 	if thisListener.StopParse {
 
 		return
 	}
-	when, popOk := thisListener.Stack.Pop().(*ast.WhenScope)
+	thenExpr, popOk := thisListener.Stack.Pop().(*ast.ThenExpression)
 	if !popOk {
 		thisListener.StopParse = true
 
 		return
 	}
-	receiver, popOk := thisListener.Stack.Peek().(ast.WhenScopeReceiver)
+	receiver, popOk := thisListener.Stack.Peek().(ast.ThenExpressionReceiver)
 	if !popOk {
 		thisListener.StopParse = true
 
 		return
 	}
-	err := receiver.AcceptWhenScope(when)
+	err := receiver.AcceptThenExpression(thenExpr)
 	if err != nil {
 		thisListener.StopParse = true
 		thisListener.ErrorCallback.AddError(err)
 	}
 }
 
-// EnterThenScope is called when production thenScope is entered.
-func (thisListener *GruleV3ParserListener) EnterThenScope(ctx *grulev3.ThenScopeContext) {
+// EnterIfStatement is called when production ifStatement is entered.
+func (thisListener *GruleV3ParserListener) EnterIfStatement(ctx *grulev3.IfStatementContext) {
 	if thisListener.StopParse {
 
 		return
 	}
-	then := ast.NewThenScope()
-	then.GrlText = ctx.GetText()
-	thisListener.Stack.Push(then)
+	ifStatement := ast.NewIfStatement()
+	ifStatement.GrlText = ctx.GetText()
+	ifStatement.Line = ctx.GetStart().GetLine()
+	thisListener.Stack.Push(ifStatement)
 }
 
-// ExitThenScope is called when production thenScope is exited.
-func (thisListener *GruleV3ParserListener) ExitThenScope(ctx *grulev3.ThenScopeContext) {
+// ExitIfStatement is called when production ifStatement is exited.
+func (thisListener *GruleV3ParserListener) ExitIfStatement(ctx *grulev3.IfStatementContext) {
 	if thisListener.StopParse {
 
 		return
 	}
-	then, popOk := thisListener.Stack.Pop().(*ast.ThenScope)
+	ifStatement, popOk := thisListener.Stack.Pop().(*ast.IfStatement)
 	if !popOk {
 		thisListener.StopParse = true
 
 		return
 	}
-	receiver, popOk := thisListener.Stack.Peek().(ast.ThenScopeReceiver)
+	receiver, popOk := thisListener.Stack.Peek().(ast.IfStatementReceiver)
 	if !popOk {
 		thisListener.StopParse = true
 
 		return
 	}
-	err := receiver.AcceptThenScope(then)
+	err := receiver.AcceptIfStatement(ifStatement)
 	if err != nil {
 		thisListener.StopParse = true
 		thisListener.ErrorCallback.AddError(err)
 	}
 }
 
-// EnterThenExpressionList is called when production thenExpressionList is entered.
-func (thisListener *GruleV3ParserListener) EnterThenExpressionList(ctx *grulev3.ThenExpressionListContext) {
+// EnterThenBlock is called when production thenBlock is entered.
+func (thisListener *GruleV3ParserListener) EnterThenBlock(ctx *grulev3.ThenBlockContext) {
 	if thisListener.StopParse {
 
 		return
@@ -301,14 +934,16 @@ func (thisListener *GruleV3ParserListener) EnterThenExpressionList(ctx *grulev3.
 	thenExpList := ast.NewThenExpressionList()
 	thenExpList.GrlText = ctx.GetText()
 	thisListener.Stack.Push(thenExpList)
+	thisListener.locals = append(thisListener.locals, make(map[string]*ast.Variable))
 }
 
-// ExitThenExpressionList is called when production thenExpressionList is exited.
-func (thisListener *GruleV3ParserListener) ExitThenExpressionList(ctx *grulev3.ThenExpressionListContext) {
+// ExitThenBlock is called when production thenBlock is exited.
+func (thisListener *GruleV3ParserListener) ExitThenBlock(ctx *grulev3.ThenBlockContext) {
 	if thisListener.StopParse {
 
 		return
 	}
+	thisListener.locals = thisListener.locals[:len(thisListener.locals)-1]
 	thenExpList, popOk := thisListener.Stack.Pop().(*ast.ThenExpressionList)
 	if !popOk {
 		thisListener.StopParse = true
@@ -328,36 +963,53 @@ func (thisListener *GruleV3ParserListener) ExitThenExpressionList(ctx *grulev3.T
 	}
 }
 
-// EnterThenExpression is called when production thenExpression is entered.
-func (thisListener *GruleV3ParserListener) EnterThenExpression(ctx *grulev3.ThenExpressionContext) {
+// EnterLetStatement is called when production letStatement is entered.
+func (thisListener *GruleV3ParserListener) EnterLetStatement(ctx *grulev3.LetStatementContext) {
 	if thisListener.StopParse {
 
 		return
 	}
-	thenExpr := ast.NewThenExpression()
-	thenExpr.GrlText = ctx.GetText()
-	thisListener.Stack.Push(thenExpr)
+	letStatement := ast.NewLetStatement()
+	letStatement.GrlText = ctx.GetText()
+	letStatement.Line = ctx.GetStart().GetLine()
+	letStatement.Name = ctx.Identifier().GetText()
+	thisListener.Stack.Push(letStatement)
 }
 
-// ExitThenExpression is called when production thenExpression is exited.
-func (thisListener *GruleV3ParserListener) ExitThenExpression(ctx *grulev3.ThenExpressionContext) {
+// ExitLetStatement is called when production letStatement is exited.
+func (thisListener *GruleV3ParserListener) ExitLetStatement(ctx *grulev3.LetStatementContext) {
 	if thisListener.StopParse {
 
 		return
 	}
-	thenExpr, popOk := thisListener.Stack.Pop().(*ast.ThenExpression)
+	letStatement, popOk := thisListener.Stack.Pop().(*ast.LetStatement)
 	if !popOk {
 		thisListener.StopParse = true
 
 		return
 	}
-	receiver, popOk := thisListener.Stack.Peek().(ast.ThenExpressionReceiver)
+	receiver, popOk := thisListener.Stack.Peek().(ast.LetStatementReceiver)
 	if !popOk {
 		thisListener.StopParse = true
 
 		return
 	}
-	err := receiver.AcceptThenExpression(thenExpr)
+	vari := ast.NewVariable()
+	vari.Name = letStatement.Name
+	vari.GrlText = letStatement.Name
+	vari.Local = ast.LocalVariableKey(thisListener.ruleName, letStatement.Name)
+	if _, inWhen := receiver.(*ast.WhenScope); inWhen {
+		vari.Binding = letStatement.Expression
+	}
+	err := thisListener.declareLocal(vari)
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+
+		return
+	}
+	letStatement.Variable = thisListener.KnowledgeBase.WorkingMemory.AddVariable(vari)
+	err = receiver.AcceptLetStatement(letStatement)
 	if err != nil {
 		thisListener.StopParse = true
 		thisListener.ErrorCallback.AddError(err)
@@ -414,6 +1066,20 @@ func (thisListener *GruleV3ParserListener) EnterExpression(ctx *grulev3.Expressi
 	}
 	expr := ast.NewExpression()
 	expr.GrlText = ctx.GetText()
+	if ctx.BETWEEN() != nil {
+		expr.Operator = ast.OpBetween
+	}
+	if ctx.QUESTION() != nil {
+		expr.Operator = ast.OpConditional
+	}
+	if ctx.NULL_COALESCE() != nil {
+		expr.Operator = ast.OpCoalesce
+	}
+	if ctx.POW() != nil {
+		expr.Operator = ast.OpPow
+	}
+	// the minus of an addition is in its addMinusOperators, a MINUS of the expression itself is a unary minus.
+	expr.Negative = ctx.MINUS() != nil
 	thisListener.Stack.Push(expr)
 }
 
@@ -466,6 +1132,8 @@ func (thisListener *GruleV3ParserListener) EnterMulDivOperators(ctx *grulev3.Mul
 		expr.Operator = ast.OpDiv
 	case "%":
 		expr.Operator = ast.OpMod
+	case "~/":
+		expr.Operator = ast.OpIntDiv
 	}
 }
 
@@ -512,7 +1180,7 @@ func (thisListener *GruleV3ParserListener) EnterComparisonOperator(ctx *grulev3.
 
 		return
 	}
-	switch ctx.GetText() {
+	switch strings.ToLower(ctx.GetText()) {
 	case "<":
 		expr.Operator = ast.OpLT
 	case "<=":
@@ -525,6 +1193,12 @@ func (thisListener *GruleV3ParserListener) EnterComparisonOperator(ctx *grulev3.
 		expr.Operator = ast.OpEq
 	case "!=":
 		expr.Operator = ast.OpNEq
+	case "in":
+		expr.Operator = ast.OpIn
+	case "notin":
+		expr.Operator = ast.OpNotIn
+	case "matches":
+		expr.Operator = ast.OpMatches
 	}
 }
 
@@ -599,6 +1273,12 @@ func (thisListener *GruleV3ParserListener) ExitExpressionAtom(ctx *grulev3.Expre
 		return
 	}
 	expressionAtm.Negated = ctx.NEGATION() != nil
+	if ctx.MemberVariable() != nil {
+		expressionAtm.NullSafe = ctx.MemberVariable().SAFE_DOT() != nil
+	}
+	if ctx.MethodCall() != nil {
+		expressionAtm.NullSafe = ctx.MethodCall().SAFE_DOT() != nil
+	}
 
 	err := expr.AcceptExpressionAtom(thisListener.KnowledgeBase.WorkingMemory.AddExpressionAtom(expressionAtm))
 	if err != nil {
@@ -607,6 +1287,122 @@ func (thisListener *GruleV3ParserListener) ExitExpressionAtom(ctx *grulev3.Expre
 	}
 }
 
+// EnterQuantifier is called when production quantifier is entered.
+func (thisListener *GruleV3ParserListener) EnterQuantifier(ctx *grulev3.QuantifierContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	quantifier := ast.NewQuantifier()
+	quantifier.GrlText = ctx.GetText()
+	quantifier.Kind = strings.ToLower(ctx.SIMPLENAME().GetText())
+	if !ast.IsQuantifier(quantifier.Kind) {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(fmt.Errorf("%s is not a quantifier, expecting exists, any, forall or count", ctx.SIMPLENAME().GetText()))
+
+		return
+	}
+	// the element variable is only visible in the quantifier.
+	thisListener.locals = append(thisListener.locals, make(map[string]*ast.Variable))
+	vari := ast.NewVariable()
+	vari.Name = ctx.Identifier().GetText()
+	vari.GrlText = vari.Name
+	vari.Local = ast.LocalVariableKey(thisListener.ruleName, vari.Name)
+	err := thisListener.declareLocal(vari)
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+
+		return
+	}
+	quantifier.Variable = thisListener.KnowledgeBase.WorkingMemory.AddVariable(vari)
+	thisListener.Stack.Push(quantifier)
+}
+
+// ExitQuantifier is called when production quantifier is exited.
+func (thisListener *GruleV3ParserListener) ExitQuantifier(ctx *grulev3.QuantifierContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	thisListener.locals = thisListener.locals[:len(thisListener.locals)-1]
+	quantifier, popOk := thisListener.Stack.Pop().(*ast.Quantifier)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	receiver, popOk := thisListener.Stack.Peek().(ast.QuantifierReceiver)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	err := receiver.AcceptQuantifier(quantifier)
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+	}
+}
+
+// EnterAggregate is called when production aggregate is entered.
+func (thisListener *GruleV3ParserListener) EnterAggregate(ctx *grulev3.AggregateContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	aggregate := ast.NewAggregate()
+	aggregate.GrlText = ctx.GetText()
+	aggregate.Kind = strings.ToLower(ctx.SIMPLENAME().GetText())
+	if !ast.IsAggregate(aggregate.Kind) {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(fmt.Errorf("%s is not an aggregate, expecting sum, avg, min or max", ctx.SIMPLENAME().GetText()))
+
+		return
+	}
+	// the element variable is only visible in the aggregate, including the value expression preceding it.
+	thisListener.locals = append(thisListener.locals, make(map[string]*ast.Variable))
+	vari := ast.NewVariable()
+	vari.Name = ctx.Identifier().GetText()
+	vari.GrlText = vari.Name
+	vari.Local = ast.LocalVariableKey(thisListener.ruleName, vari.Name)
+	err := thisListener.declareLocal(vari)
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+
+		return
+	}
+	aggregate.Variable = thisListener.KnowledgeBase.WorkingMemory.AddVariable(vari)
+	thisListener.Stack.Push(aggregate)
+}
+
+// ExitAggregate is called when production aggregate is exited.
+func (thisListener *GruleV3ParserListener) ExitAggregate(ctx *grulev3.AggregateContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	thisListener.locals = thisListener.locals[:len(thisListener.locals)-1]
+	aggregate, popOk := thisListener.Stack.Pop().(*ast.Aggregate)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	receiver, popOk := thisListener.Stack.Peek().(ast.AggregateReceiver)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	err := receiver.AcceptAggregate(aggregate)
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+	}
+}
+
 // EnterArrayMapSelector is called when production arrayMapSelector is entered.
 func (thisListener *GruleV3ParserListener) EnterArrayMapSelector(ctx *grulev3.ArrayMapSelectorContext) {
 	if thisListener.StopParse {
@@ -650,7 +1446,17 @@ func (thisListener *GruleV3ParserListener) EnterFunctionCall(ctx *grulev3.Functi
 		return
 	}
 	fun := ast.NewFunctionCall()
-	fun.FunctionName = ctx.SIMPLENAME().GetText()
+	fun.FunctionName = ctx.GetStart().GetText()
+	if _, ok := ctx.GetParent().(*grulev3.MethodCallContext); !ok {
+		// a function declared in GRL is looked up in the package of this GRL at runtime, the imported ones here.
+		exists := func(qualified string) bool {
+			_, ok := thisListener.Grl.Functions[qualified]
+
+			return ok || thisListener.KnowledgeBase.WorkingMemory.GetFunction(qualified) != nil
+		}
+		fun.FunctionName = thisListener.resolveName(fun.FunctionName, exists)
+		fun.Package = thisListener.Grl.Package
+	}
 	thisListener.Stack.Push(fun)
 }
 
@@ -723,11 +1529,12 @@ func (thisListener *GruleV3ParserListener) EnterVariable(ctx *grulev3.VariableCo
 		return
 	}
 	vari := ast.NewVariable()
-	if ctx.SIMPLENAME() != nil && len(ctx.SIMPLENAME().GetText()) > 0 {
-		vari.Name = ctx.SIMPLENAME().GetText()
+	if ctx.Variable() == nil {
+		vari.Name = ctx.GetStart().GetText()
 	}
 	if ctx.MemberVariable() != nil && len(ctx.MemberVariable().GetText()) > 0 {
-		vari.Name = ctx.MemberVariable().GetText()[1:]
+		vari.Name = ctx.MemberVariable().GetStop().GetText()
+		vari.NullSafe = ctx.MemberVariable().SAFE_DOT() != nil
 	}
 	vari.GrlText = ctx.GetText()
 	thisListener.Stack.Push(vari)
@@ -752,6 +1559,34 @@ func (thisListener *GruleV3ParserListener) ExitVariable(ctx *grulev3.VariableCon
 		return
 	}
 
+	if ctx.Variable() == nil {
+		if local := thisListener.lookupLocal(vari.Name); local != nil {
+			vari.Local = local.Local
+			vari.Binding = local.Binding
+		} else if cons := thisListener.lookupConstant(vari.Name); cons != nil {
+			// a const is inlined, its value takes the place of the variable.
+			atom, ok := variRec.(*ast.ExpressionAtom)
+			if !ok {
+				thisListener.StopParse = true
+				thisListener.ErrorCallback.AddError(fmt.Errorf("constant %s can only be used as a value", vari.Name))
+
+				return
+			}
+			err := atom.AcceptConstant(cons)
+			if err != nil {
+				thisListener.StopParse = true
+				thisListener.ErrorCallback.AddError(err)
+			}
+
+			return
+		} else if thisListener.grlConstants[vari.Name] {
+			thisListener.StopParse = true
+			thisListener.ErrorCallback.AddError(fmt.Errorf("constant %s is used before its declaration", vari.Name))
+
+			return
+		}
+	}
+
 	err := variRec.AcceptVariable(thisListener.KnowledgeBase.WorkingMemory.AddVariable(vari))
 	if err != nil {
 		thisListener.StopParse = true
@@ -770,7 +1605,7 @@ func (thisListener *GruleV3ParserListener) ExitMemberVariable(ctx *grulev3.Membe
 
 		return
 	}
-	vari.AcceptMemberVariable(ctx.SIMPLENAME().GetText())
+	vari.AcceptMemberVariable(ctx.GetStop().GetText())
 }
 
 // EnterConstant is called when production constant is entered.
@@ -863,6 +1698,61 @@ func (thisListener *GruleV3ParserListener) ExitBooleanLiteral(ctx *grulev3.Boole
 
 }
 
+// EnterDurationLiteral is called when production durationLiteral is entered.
+func (thisListener *GruleV3ParserListener) EnterDurationLiteral(ctx *grulev3.DurationLiteralContext) {
+}
+
+// ExitDurationLiteral is called when production durationLiteral is exited.
+func (thisListener *GruleV3ParserListener) ExitDurationLiteral(ctx *grulev3.DurationLiteralContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	receiver, ok := thisListener.Stack.Peek().(ast.DurationLiteralReceiver)
+	if !ok {
+		thisListener.StopParse = true
+
+		return
+	}
+	val, err := pkg.ParseDurationLiteral(ctx.GetStop().GetText())
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+
+		return
+	}
+	lit := &ast.DurationLiteral{Duration: val}
+	if ctx.MINUS() != nil {
+		lit.Negate()
+	}
+	receiver.AcceptDurationLiteral(lit)
+}
+
+// EnterDateLiteral is called when production dateLiteral is entered.
+func (thisListener *GruleV3ParserListener) EnterDateLiteral(ctx *grulev3.DateLiteralContext) {}
+
+// ExitDateLiteral is called when production dateLiteral is exited.
+func (thisListener *GruleV3ParserListener) ExitDateLiteral(ctx *grulev3.DateLiteralContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	receiver, ok := thisListener.Stack.Peek().(ast.DateLiteralReceiver)
+	if !ok {
+		thisListener.StopParse = true
+
+		return
+	}
+	date, err := pkg.ParseDateLiteral(ctx.GetText())
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+
+		return
+	}
+	receiver.AcceptDateLiteral(&ast.DateLiteral{Date: date})
+}
+
 // EnterIntegerLiteral is called when production integerLiteral is entered.
 func (thisListener *GruleV3ParserListener) EnterIntegerLiteral(ctx *grulev3.IntegerLiteralContext) {}
 
@@ -906,3 +1796,63 @@ func (thisListener *GruleV3ParserListener) ExitFloatLiteral(ctx *grulev3.FloatLi
 	}
 	receiver.AcceptFloatLiteral(lit)
 }
+
+// EnterListLiteral is called when production listLiteral is entered.
+func (thisListener *GruleV3ParserListener) EnterListLiteral(ctx *grulev3.ListLiteralContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	thisListener.Stack.Push(&ast.ListLiteral{})
+}
+
+// ExitListLiteral is called when production listLiteral is exited.
+func (thisListener *GruleV3ParserListener) ExitListLiteral(ctx *grulev3.ListLiteralContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	lit, popOk := thisListener.Stack.Pop().(*ast.ListLiteral)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	receiver, ok := thisListener.Stack.Peek().(ast.ListLiteralReceiver)
+	if !ok {
+		thisListener.StopParse = true
+
+		return
+	}
+	receiver.AcceptListLiteral(lit)
+}
+
+// EnterMapLiteral is called when production mapLiteral is entered.
+func (thisListener *GruleV3ParserListener) EnterMapLiteral(ctx *grulev3.MapLiteralContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	thisListener.Stack.Push(&ast.MapLiteral{})
+}
+
+// ExitMapLiteral is called when production mapLiteral is exited.
+func (thisListener *GruleV3ParserListener) ExitMapLiteral(ctx *grulev3.MapLiteralContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	lit, popOk := thisListener.Stack.Pop().(*ast.MapLiteral)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	receiver, ok := thisListener.Stack.Peek().(ast.MapLiteralReceiver)
+	if !ok {
+		thisListener.StopParse = true
+
+		return
+	}
+	receiver.AcceptMapLiteral(lit)
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	parser "github.com/DataWiseHQ/grule-rule-engine/antlr/parser/grulev3"
@@ -543,3 +544,85 @@ func TestV3ParserGarbageInput(t *testing.T) {
 		assert.True(t, errReporter.HasError(), fmt.Sprintf("Garbage test case %d should have resulted in a parse error", i))
 	}
 }
+
+func TestV3KeywordsAsNames(t *testing.T) {
+	for _, keyword := range []string{"in", "for", "not", "matches", "between", "and", "function", "return",
+		"const", "global", "extends", "package", "import", "if", "else", "let", "enabled"} {
+		ruleName := strings.ToUpper(keyword[:1]) + keyword[1:]
+		data := fmt.Sprintf(`
+rule RuleOne "RuleOneDesc" {
+    when
+        Fact.%[1]s == %[1]s.Name && Fact.%[1]s() && %[1]s(Fact.%[1]s) && Fact.List[0].%[1]s
+    then
+        %[1]s.Name = Fact.%[1]s;
+        Fact.%[1]s = %[1]s.%[1]s(1);
+        %[1]s.Call();
+        Retract("RuleOne");
+}
+
+rule %[2]s extends RuleOne "a rule named after the keyword" {
+    when
+        exists(%[1]s in Fact.List : %[1]s.Done) && sum(%[1]s.Price for %[1]s in Fact.List) > 0
+    then
+        let %[1]s = keep(Fact.Value);
+        Fact.Value = %[1]s;
+}
+
+rule RuleTwo extends %[2]s "extends the rule named after the keyword" {
+    when
+        true
+    then
+        Retract("RuleTwo");
+}
+
+function keep(%[1]s) {
+    let value = %[1]s;
+    return value;
+}
+`, keyword, ruleName)
+		kb, _ := prepareV3TestKnowledgeBase(t, data)
+		assert.NotNil(t, kb.RuleEntries["RuleOne"], keyword)
+		assert.Len(t, kb.RuleEntries["RuleOne"].ThenScope.ThenExpressionList.ThenExpressions, 4, keyword)
+		assert.NotNil(t, kb.RuleEntries[ruleName], keyword)
+		assert.Equal(t, ruleName, kb.RuleEntries["RuleTwo"].Extends, keyword)
+		assert.Equal(t, keyword, kb.WorkingMemory.GetFunction("keep").Parameters[0].Name, keyword)
+	}
+}
+
+func TestV3DurationLikeNames(t *testing.T) {
+	grl := `
+rule RuleOne "RuleOneDesc" {
+    when
+        Fact.%[1]s == 1
+    then
+        Fact.%[1]s = 2;
+        Retract("RuleOne");
+}`
+	// a name written as an ISO 8601 duration is a duration, the names are reserved.
+	for _, name := range []string{"P1D", "PT2H", "P1Y", "P2W", "PT30M", "P1Y2M10DT2H"} {
+		is := antlr.NewInputStream(fmt.Sprintf(grl, name))
+		lexer := parser.Newgrulev3Lexer(is)
+		stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+
+		errReporter := &pkg.GruleErrorReporter{
+			Errors: make([]error, 0),
+		}
+		kb := ast.NewKnowledgeLibrary().GetKnowledgeBase("T", "1")
+
+		listener := NewGruleV3ParserListener(kb, errReporter)
+
+		psr := parser.Newgrulev3Parser(stream)
+		psr.BuildParseTrees = true
+
+		psr.RemoveErrorListeners()
+		psr.AddErrorListener(errReporter)
+
+		antlr.ParseTreeWalkerDefault.Walk(listener, psr.Grl())
+		assert.True(t, errReporter.HasError(), name)
+	}
+	// a name only starting like a duration, or in lower case, is a name.
+	for _, name := range []string{"p1d", "P1Dx", "PT2Hours", "Period", "P"} {
+		kb, _ := prepareV3TestKnowledgeBase(t, fmt.Sprintf(grl, name))
+		assert.NotNil(t, kb.RuleEntries["RuleOne"], name)
+	}
+}
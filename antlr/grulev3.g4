@@ -2,19 +2,103 @@ grammar grulev3;
 
 // PARSER HERE
 grl
-    : ruleEntry* EOF
+    : packageDeclaration? importDeclaration* ( ruleEntry | functionDeclaration | constDeclaration | globalDeclaration )* EOF
+    ;
+
+packageDeclaration
+    : PACKAGE qualifiedName SEMICOLON
+    ;
+
+importDeclaration
+    : IMPORT qualifiedName ( DOT MUL )? SEMICOLON
+    ;
+
+qualifiedName
+    : identifier ( DOT identifier )*
+    ;
+
+identifier
+    : SIMPLENAME | IN | FOR | NOT | MATCHES | BETWEEN | AND_WORD | FUNCTION | RETURN | CONST | GLOBAL | EXTENDS | PACKAGE | IMPORT | IF | ELSE | LET | ENABLED
+    ;
+
+functionDeclaration
+    : FUNCTION SIMPLENAME LR_BRACKET parameterList? RR_BRACKET LR_BRACE ( letStatement SEMICOLON )* RETURN expression SEMICOLON RR_BRACE
+    ;
+
+parameterList
+    : identifier ( ',' identifier )*
+    ;
+
+constDeclaration
+    : CONST SIMPLENAME ASSIGN constant SEMICOLON
+    ;
+
+globalDeclaration
+    : GLOBAL typeName SIMPLENAME SEMICOLON
+    ;
+
+typeName
+    : MUL? SIMPLENAME ( DOT SIMPLENAME )?
     ;
 
 ruleEntry
-    : RULE ruleName ruleDescription? salience? LR_BRACE whenScope thenScope RR_BRACE
+    : RULE ruleName ruleExtends? ruleDescription? ruleAttribute* LR_BRACE whenScope thenScope RR_BRACE
+    ;
+
+ruleExtends
+    : EXTENDS qualifiedName
+    ;
+
+ruleAttribute
+    : salience
+    | agendaGroup
+    | activationGroup
+    | noLoop
+    | lockOnActive
+    | dateEffective
+    | dateExpires
+    | enabled
+    | ruleMetadata
     ;
 
 salience
     : SALIENCE integerLiteral
     ;
 
+agendaGroup
+    : AGENDA_GROUP stringLiteral
+    ;
+
+activationGroup
+    : ACTIVATION_GROUP stringLiteral
+    ;
+
+noLoop
+    : NO_LOOP booleanLiteral?
+    ;
+
+lockOnActive
+    : LOCK_ON_ACTIVE booleanLiteral?
+    ;
+
+dateEffective
+    : DATE_EFFECTIVE stringLiteral
+    ;
+
+dateExpires
+    : DATE_EXPIRES stringLiteral
+    ;
+
+enabled
+    : ENABLED booleanLiteral
+    ;
+
+ruleMetadata
+    : AT SIMPLENAME ( LR_BRACKET ( stringLiteral ( ',' stringLiteral )* )? RR_BRACKET )?
+    ;
+
 ruleName
-    : SIMPLENAME
+    : identifier
     ;
 
 ruleDescription
@@ -22,7 +106,7 @@ ruleDescription
     ;
 
 whenScope
-    : WHEN  expression
+    : WHEN ( letStatement SEMICOLON )* expression
     ;
 
 thenScope
@@ -30,7 +114,25 @@ thenScope
     ;
 
 thenExpressionList
-    : (thenExpression SEMICOLON)+
+    : thenStatement+
+    ;
+
+thenStatement
+    : thenExpression SEMICOLON
+    | letStatement SEMICOLON
+    | ifStatement
+    ;
+
+letStatement
+    : LET identifier ASSIGN expression
+    ;
+
+ifStatement
+    : IF LR_BRACKET expression RR_BRACKET thenBlock ( ELSE ( ifStatement | thenBlock ) )?
+    ;
+
+thenBlock
+    : LR_BRACE thenStatement* RR_BRACE
     ;
 
 thenExpression
@@ -43,17 +145,22 @@ assignment
     ;
 
 expression
-    : expression mulDivOperators expression
+    : <assoc=right> expression POW expression
+    | MINUS expression
+    | expression mulDivOperators expression
     | expression addMinusOperators expression
     | expression comparisonOperator expression
+    | expression BETWEEN expression AND_WORD expression
     | expression andLogicOperator expression
     | expression orLogicOperator expression
+    | <assoc=right> expression NULL_COALESCE expression
+    | <assoc=right> expression QUESTION expression COLON expression
     | NEGATION? LR_BRACKET expression RR_BRACKET
     | expressionAtom
     ;
 
 mulDivOperators
-    : MUL | DIV | MOD
+    : MUL | DIV | MOD | INT_DIV
     ;
 
 addMinusOperators
@@ -61,7 +168,7 @@ addMinusOperators
     ;
 
 comparisonOperator
-    : GT | LT | GTE | LTE | EQUALS | NOTEQUALS
+    : GT | LT | GTE | LTE | EQUALS | NOTEQUALS | IN | NOT IN | MATCHES
     ;
 
 andLogicOperator
@@ -76,6 +183,8 @@ expressionAtom
     : constant
     | variable
     | functionCall
+    | quantifier
+    | aggregate
     | expressionAtom methodCall
     | expressionAtom memberVariable
     | expressionAtom arrayMapSelector
@@ -88,12 +197,28 @@ constant
     | floatLiteral
     | booleanLiteral
     | NIL_LITERAL
+    | durationLiteral
+    | dateLiteral
+    | listLiteral
+    | mapLiteral
+    ;
+
+listLiteral
+    : LS_BRACKET ( constant ( ',' constant )* )? RS_BRACKET
+    ;
+
+mapLiteral
+    : LR_BRACE ( mapEntry ( ',' mapEntry )* )? RR_BRACE
+    ;
+
+mapEntry
+    : constant COLON constant
     ;
 
 variable
     : variable memberVariable
     | variable arrayMapSelector
-    | SIMPLENAME
+    | identifier
     ;
 
 arrayMapSelector
@@ -101,15 +226,23 @@ arrayMapSelector
     ;
 
 memberVariable
-    : DOT SIMPLENAME
+    : ( DOT | SAFE_DOT ) identifier
     ;
 
 functionCall
-    : SIMPLENAME LR_BRACKET argumentList? RR_BRACKET
+    : identifier LR_BRACKET argumentList? RR_BRACKET
+    ;
+
+quantifier
+    : SIMPLENAME LR_BRACKET identifier IN expressionAtom COLON expression RR_BRACKET
+    ;
+
+aggregate
+    : SIMPLENAME LR_BRACKET expression FOR identifier IN expressionAtom ( IF expression )? RR_BRACKET
     ;
 
 methodCall
-    : DOT functionCall
+    : ( DOT | SAFE_DOT ) functionCall
     ;
 
 argumentList
@@ -151,6 +284,14 @@ stringLiteral
     : DQUOTA_STRING | SQUOTA_STRING
     ;
 
+durationLiteral
+    : MINUS? ( DURATION_LIT | ISO_DURATION_LIT )
+    ;
+
+dateLiteral
+    : DATE_LIT
+    ;
+
 booleanLiteral
     : TRUE | FALSE
     ;
@@ -209,10 +350,17 @@ fragment IC                 : ISC
 PLUS                        : '+' ;
 MINUS                       : '-' ;
 DIV                         : '/' ;
+POW                         : '**' ;
+INT_DIV                     : '~/' ;
 MUL                         : '*' ;
 MOD                         : '%' ;
 DOT                         : '.' ;
 SEMICOLON                   : ';' ;
+COLON                       : ':' ;
+QUESTION                    : '?' ;
+SAFE_DOT                    : '?.' ;
+NULL_COALESCE               : '??' ;
+AT                          : '@' ;
 
 LR_BRACE                    : '{';
 RR_BRACE                    : '}';
@@ -224,6 +372,22 @@ RS_BRACKET                  : ']';
 RULE                        : R U L E  ;
 WHEN                        : W H E N ;
 THEN                        : T H E N ;
+IF                          : I F ;
+ELSE                        : E L S E ;
+LET                         : L E T ;
+IN                          : I N ;
+FOR                         : F O R ;
+NOT                         : N O T ;
+MATCHES                     : M A T C H E S ;
+BETWEEN                     : B E T W E E N ;
+AND_WORD                    : A N D ;
+FUNCTION                    : F U N C T I O N ;
+RETURN                      : R E T U R N ;
+CONST                       : C O N S T ;
+GLOBAL                      : G L O B A L ;
+EXTENDS                     : E X T E N D S ;
+PACKAGE                     : P A C K A G E ;
+IMPORT                      : I M P O R T ;
 AND                         : '&&' ;
 OR                          : '||' ;
 TRUE                        : T R U E ;
@@ -231,6 +395,13 @@ FALSE                       : F A L S E ;
 NIL_LITERAL                 : N I L ;
 NEGATION                    : '!' ;
 SALIENCE                    : S A L I E N C E ;
+AGENDA_GROUP                : A G E N D A '-' G R O U P ;
+ACTIVATION_GROUP            : A C T I V A T I O N '-' G R O U P ;
+NO_LOOP                     : N O '-' L O O P ;
+LOCK_ON_ACTIVE              : L O C K '-' O N '-' A C T I V E ;
+DATE_EFFECTIVE              : D A T E '-' E F F E C T I V E ;
+DATE_EXPIRES                : D A T E '-' E X P I R E S ;
+ENABLED                     : E N A B L E D ;
 
 EQUALS                      : '==' ;
 ASSIGN                      : '=' ;
@@ -247,6 +418,10 @@ NOTEQUALS                   : '!=' ;
 BITAND                      : '&';
 BITOR                       : '|';
 
+ISO_DURATION_LIT            : 'P' ( DEC_DIGITS [YMWD] )+ ( 'T' ( DEC_DIGITS [HMS] )+ )?
+                            | 'PT' ( DEC_DIGITS [HMS] )+
+                            ;
+
 SIMPLENAME                  : ISC IC*;
 
 DQUOTA_STRING               : '"' ( '\\'. | '""' | ~('"'| '\\') )* '"';
@@ -276,12 +451,18 @@ DEC_LIT                     : '0'
                             ;
 
 HEX_LIT                     : '0' X HEX_DIGITS;
+DURATION_LIT                : ( DEC_DIGITS ( 'ns' | 'us' | 'ms' | [smhdw] ) )+ ;
+DATE_LIT                    : '@' DATE_DIGITS DATE_DIGITS '-' DATE_DIGITS '-' DATE_DIGITS
+                              ( 'T' DATE_DIGITS ':' DATE_DIGITS ( ':' DATE_DIGITS ( DOT DEC_DIGITS )? )? DATE_ZONE? )?
+                            ;
 OCT_LIT                     : '0' OCT_DIGITS;
 
 fragment HEX_DIGITS         : HEX_DIGIT+;
 fragment DEC_DIGITS         : DEC_DIGIT+;
 fragment OCT_DIGITS         : OCT_DIGIT+;
 fragment DEC_DIGIT          : [0-9];
+fragment DATE_DIGITS        : DEC_DIGIT DEC_DIGIT;
+fragment DATE_ZONE          : 'Z' | ( PLUS | MINUS ) DATE_DIGITS ':' DATE_DIGITS;
 fragment OCT_DIGIT          : [0-7];
 fragment HEX_DIGIT          : [0-9a-fA-F];
 
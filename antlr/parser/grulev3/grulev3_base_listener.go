@@ -26,18 +26,132 @@ func (s *Basegrulev3Listener) EnterGrl(ctx *GrlContext) {}
 // ExitGrl is called when production grl is exited.
 func (s *Basegrulev3Listener) ExitGrl(ctx *GrlContext) {}
 
+// EnterPackageDeclaration is called when production packageDeclaration is entered.
+func (s *Basegrulev3Listener) EnterPackageDeclaration(ctx *PackageDeclarationContext) {}
+
+// ExitPackageDeclaration is called when production packageDeclaration is exited.
+func (s *Basegrulev3Listener) ExitPackageDeclaration(ctx *PackageDeclarationContext) {}
+
+// EnterImportDeclaration is called when production importDeclaration is entered.
+func (s *Basegrulev3Listener) EnterImportDeclaration(ctx *ImportDeclarationContext) {}
+
+// ExitImportDeclaration is called when production importDeclaration is exited.
+func (s *Basegrulev3Listener) ExitImportDeclaration(ctx *ImportDeclarationContext) {}
+
+// EnterQualifiedName is called when production qualifiedName is entered.
+func (s *Basegrulev3Listener) EnterQualifiedName(ctx *QualifiedNameContext) {}
+
+// ExitQualifiedName is called when production qualifiedName is exited.
+func (s *Basegrulev3Listener) ExitQualifiedName(ctx *QualifiedNameContext) {}
+
+// EnterIdentifier is called when production identifier is entered.
+func (s *Basegrulev3Listener) EnterIdentifier(ctx *IdentifierContext) {}
+
+// ExitIdentifier is called when production identifier is exited.
+func (s *Basegrulev3Listener) ExitIdentifier(ctx *IdentifierContext) {}
+
+// EnterFunctionDeclaration is called when production functionDeclaration is entered.
+func (s *Basegrulev3Listener) EnterFunctionDeclaration(ctx *FunctionDeclarationContext) {}
+
+// ExitFunctionDeclaration is called when production functionDeclaration is exited.
+func (s *Basegrulev3Listener) ExitFunctionDeclaration(ctx *FunctionDeclarationContext) {}
+
+// EnterParameterList is called when production parameterList is entered.
+func (s *Basegrulev3Listener) EnterParameterList(ctx *ParameterListContext) {}
+
+// ExitParameterList is called when production parameterList is exited.
+func (s *Basegrulev3Listener) ExitParameterList(ctx *ParameterListContext) {}
+
+// EnterConstDeclaration is called when production constDeclaration is entered.
+func (s *Basegrulev3Listener) EnterConstDeclaration(ctx *ConstDeclarationContext) {}
+
+// ExitConstDeclaration is called when production constDeclaration is exited.
+func (s *Basegrulev3Listener) ExitConstDeclaration(ctx *ConstDeclarationContext) {}
+
+// EnterGlobalDeclaration is called when production globalDeclaration is entered.
+func (s *Basegrulev3Listener) EnterGlobalDeclaration(ctx *GlobalDeclarationContext) {}
+
+// ExitGlobalDeclaration is called when production globalDeclaration is exited.
+func (s *Basegrulev3Listener) ExitGlobalDeclaration(ctx *GlobalDeclarationContext) {}
+
+// EnterTypeName is called when production typeName is entered.
+func (s *Basegrulev3Listener) EnterTypeName(ctx *TypeNameContext) {}
+
+// ExitTypeName is called when production typeName is exited.
+func (s *Basegrulev3Listener) ExitTypeName(ctx *TypeNameContext) {}
+
 // EnterRuleEntry is called when production ruleEntry is entered.
 func (s *Basegrulev3Listener) EnterRuleEntry(ctx *RuleEntryContext) {}
 
 // ExitRuleEntry is called when production ruleEntry is exited.
 func (s *Basegrulev3Listener) ExitRuleEntry(ctx *RuleEntryContext) {}
 
+// EnterRuleExtends is called when production ruleExtends is entered.
+func (s *Basegrulev3Listener) EnterRuleExtends(ctx *RuleExtendsContext) {}
+
+// ExitRuleExtends is called when production ruleExtends is exited.
+func (s *Basegrulev3Listener) ExitRuleExtends(ctx *RuleExtendsContext) {}
+
+// EnterRuleAttribute is called when production ruleAttribute is entered.
+func (s *Basegrulev3Listener) EnterRuleAttribute(ctx *RuleAttributeContext) {}
+
+// ExitRuleAttribute is called when production ruleAttribute is exited.
+func (s *Basegrulev3Listener) ExitRuleAttribute(ctx *RuleAttributeContext) {}
+
 // EnterSalience is called when production salience is entered.
 func (s *Basegrulev3Listener) EnterSalience(ctx *SalienceContext) {}
 
 // ExitSalience is called when production salience is exited.
 func (s *Basegrulev3Listener) ExitSalience(ctx *SalienceContext) {}
 
+// EnterAgendaGroup is called when production agendaGroup is entered.
+func (s *Basegrulev3Listener) EnterAgendaGroup(ctx *AgendaGroupContext) {}
+
+// ExitAgendaGroup is called when production agendaGroup is exited.
+func (s *Basegrulev3Listener) ExitAgendaGroup(ctx *AgendaGroupContext) {}
+
+// EnterActivationGroup is called when production activationGroup is entered.
+func (s *Basegrulev3Listener) EnterActivationGroup(ctx *ActivationGroupContext) {}
+
+// ExitActivationGroup is called when production activationGroup is exited.
+func (s *Basegrulev3Listener) ExitActivationGroup(ctx *ActivationGroupContext) {}
+
+// EnterNoLoop is called when production noLoop is entered.
+func (s *Basegrulev3Listener) EnterNoLoop(ctx *NoLoopContext) {}
+
+// ExitNoLoop is called when production noLoop is exited.
+func (s *Basegrulev3Listener) ExitNoLoop(ctx *NoLoopContext) {}
+
+// EnterLockOnActive is called when production lockOnActive is entered.
+func (s *Basegrulev3Listener) EnterLockOnActive(ctx *LockOnActiveContext) {}
+
+// ExitLockOnActive is called when production lockOnActive is exited.
+func (s *Basegrulev3Listener) ExitLockOnActive(ctx *LockOnActiveContext) {}
+
+// EnterDateEffective is called when production dateEffective is entered.
+func (s *Basegrulev3Listener) EnterDateEffective(ctx *DateEffectiveContext) {}
+
+// ExitDateEffective is called when production dateEffective is exited.
+func (s *Basegrulev3Listener) ExitDateEffective(ctx *DateEffectiveContext) {}
+
+// EnterDateExpires is called when production dateExpires is entered.
+func (s *Basegrulev3Listener) EnterDateExpires(ctx *DateExpiresContext) {}
+
+// ExitDateExpires is called when production dateExpires is exited.
+func (s *Basegrulev3Listener) ExitDateExpires(ctx *DateExpiresContext) {}
+
+// EnterEnabled is called when production enabled is entered.
+func (s *Basegrulev3Listener) EnterEnabled(ctx *EnabledContext) {}
+
+// ExitEnabled is called when production enabled is exited.
+func (s *Basegrulev3Listener) ExitEnabled(ctx *EnabledContext) {}
+
+// EnterRuleMetadata is called when production ruleMetadata is entered.
+func (s *Basegrulev3Listener) EnterRuleMetadata(ctx *RuleMetadataContext) {}
+
+// ExitRuleMetadata is called when production ruleMetadata is exited.
+func (s *Basegrulev3Listener) ExitRuleMetadata(ctx *RuleMetadataContext) {}
+
 // EnterRuleName is called when production ruleName is entered.
 func (s *Basegrulev3Listener) EnterRuleName(ctx *RuleNameContext) {}
 
@@ -68,6 +182,30 @@ func (s *Basegrulev3Listener) EnterThenExpressionList(ctx *ThenExpressionListCon
 // ExitThenExpressionList is called when production thenExpressionList is exited.
 func (s *Basegrulev3Listener) ExitThenExpressionList(ctx *ThenExpressionListContext) {}
 
+// EnterThenStatement is called when production thenStatement is entered.
+func (s *Basegrulev3Listener) EnterThenStatement(ctx *ThenStatementContext) {}
+
+// ExitThenStatement is called when production thenStatement is exited.
+func (s *Basegrulev3Listener) ExitThenStatement(ctx *ThenStatementContext) {}
+
+// EnterLetStatement is called when production letStatement is entered.
+func (s *Basegrulev3Listener) EnterLetStatement(ctx *LetStatementContext) {}
+
+// ExitLetStatement is called when production letStatement is exited.
+func (s *Basegrulev3Listener) ExitLetStatement(ctx *LetStatementContext) {}
+
+// EnterIfStatement is called when production ifStatement is entered.
+func (s *Basegrulev3Listener) EnterIfStatement(ctx *IfStatementContext) {}
+
+// ExitIfStatement is called when production ifStatement is exited.
+func (s *Basegrulev3Listener) ExitIfStatement(ctx *IfStatementContext) {}
+
+// EnterThenBlock is called when production thenBlock is entered.
+func (s *Basegrulev3Listener) EnterThenBlock(ctx *ThenBlockContext) {}
+
+// ExitThenBlock is called when production thenBlock is exited.
+func (s *Basegrulev3Listener) ExitThenBlock(ctx *ThenBlockContext) {}
+
 // EnterThenExpression is called when production thenExpression is entered.
 func (s *Basegrulev3Listener) EnterThenExpression(ctx *ThenExpressionContext) {}
 
@@ -128,6 +266,24 @@ func (s *Basegrulev3Listener) EnterConstant(ctx *ConstantContext) {}
 // ExitConstant is called when production constant is exited.
 func (s *Basegrulev3Listener) ExitConstant(ctx *ConstantContext) {}
 
+// EnterListLiteral is called when production listLiteral is entered.
+func (s *Basegrulev3Listener) EnterListLiteral(ctx *ListLiteralContext) {}
+
+// ExitListLiteral is called when production listLiteral is exited.
+func (s *Basegrulev3Listener) ExitListLiteral(ctx *ListLiteralContext) {}
+
+// EnterMapLiteral is called when production mapLiteral is entered.
+func (s *Basegrulev3Listener) EnterMapLiteral(ctx *MapLiteralContext) {}
+
+// ExitMapLiteral is called when production mapLiteral is exited.
+func (s *Basegrulev3Listener) ExitMapLiteral(ctx *MapLiteralContext) {}
+
+// EnterMapEntry is called when production mapEntry is entered.
+func (s *Basegrulev3Listener) EnterMapEntry(ctx *MapEntryContext) {}
+
+// ExitMapEntry is called when production mapEntry is exited.
+func (s *Basegrulev3Listener) ExitMapEntry(ctx *MapEntryContext) {}
+
 // EnterVariable is called when production variable is entered.
 func (s *Basegrulev3Listener) EnterVariable(ctx *VariableContext) {}
 
@@ -152,6 +308,18 @@ func (s *Basegrulev3Listener) EnterFunctionCall(ctx *FunctionCallContext) {}
 // ExitFunctionCall is called when production functionCall is exited.
 func (s *Basegrulev3Listener) ExitFunctionCall(ctx *FunctionCallContext) {}
 
+// EnterQuantifier is called when production quantifier is entered.
+func (s *Basegrulev3Listener) EnterQuantifier(ctx *QuantifierContext) {}
+
+// ExitQuantifier is called when production quantifier is exited.
+func (s *Basegrulev3Listener) ExitQuantifier(ctx *QuantifierContext) {}
+
+// EnterAggregate is called when production aggregate is entered.
+func (s *Basegrulev3Listener) EnterAggregate(ctx *AggregateContext) {}
+
+// ExitAggregate is called when production aggregate is exited.
+func (s *Basegrulev3Listener) ExitAggregate(ctx *AggregateContext) {}
+
 // EnterMethodCall is called when production methodCall is entered.
 func (s *Basegrulev3Listener) EnterMethodCall(ctx *MethodCallContext) {}
 
@@ -212,6 +380,18 @@ func (s *Basegrulev3Listener) EnterStringLiteral(ctx *StringLiteralContext) {}
 // ExitStringLiteral is called when production stringLiteral is exited.
 func (s *Basegrulev3Listener) ExitStringLiteral(ctx *StringLiteralContext) {}
 
+// EnterDurationLiteral is called when production durationLiteral is entered.
+func (s *Basegrulev3Listener) EnterDurationLiteral(ctx *DurationLiteralContext) {}
+
+// ExitDurationLiteral is called when production durationLiteral is exited.
+func (s *Basegrulev3Listener) ExitDurationLiteral(ctx *DurationLiteralContext) {}
+
+// EnterDateLiteral is called when production dateLiteral is entered.
+func (s *Basegrulev3Listener) EnterDateLiteral(ctx *DateLiteralContext) {}
+
+// ExitDateLiteral is called when production dateLiteral is exited.
+func (s *Basegrulev3Listener) ExitDateLiteral(ctx *DateLiteralContext) {}
+
 // EnterBooleanLiteral is called when production booleanLiteral is entered.
 func (s *Basegrulev3Listener) EnterBooleanLiteral(ctx *BooleanLiteralContext) {}
 
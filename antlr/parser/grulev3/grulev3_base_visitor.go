@@ -11,14 +11,90 @@ func (v *Basegrulev3Visitor) VisitGrl(ctx *GrlContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *Basegrulev3Visitor) VisitPackageDeclaration(ctx *PackageDeclarationContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitImportDeclaration(ctx *ImportDeclarationContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitQualifiedName(ctx *QualifiedNameContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitIdentifier(ctx *IdentifierContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitFunctionDeclaration(ctx *FunctionDeclarationContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitParameterList(ctx *ParameterListContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitConstDeclaration(ctx *ConstDeclarationContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitGlobalDeclaration(ctx *GlobalDeclarationContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitTypeName(ctx *TypeNameContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *Basegrulev3Visitor) VisitRuleEntry(ctx *RuleEntryContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *Basegrulev3Visitor) VisitRuleExtends(ctx *RuleExtendsContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitRuleAttribute(ctx *RuleAttributeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *Basegrulev3Visitor) VisitSalience(ctx *SalienceContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *Basegrulev3Visitor) VisitAgendaGroup(ctx *AgendaGroupContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitActivationGroup(ctx *ActivationGroupContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitNoLoop(ctx *NoLoopContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitLockOnActive(ctx *LockOnActiveContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitDateEffective(ctx *DateEffectiveContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitDateExpires(ctx *DateExpiresContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitEnabled(ctx *EnabledContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitRuleMetadata(ctx *RuleMetadataContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *Basegrulev3Visitor) VisitRuleName(ctx *RuleNameContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -39,6 +115,22 @@ func (v *Basegrulev3Visitor) VisitThenExpressionList(ctx *ThenExpressionListCont
 	return v.VisitChildren(ctx)
 }
 
+func (v *Basegrulev3Visitor) VisitThenStatement(ctx *ThenStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitLetStatement(ctx *LetStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitIfStatement(ctx *IfStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitThenBlock(ctx *ThenBlockContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *Basegrulev3Visitor) VisitThenExpression(ctx *ThenExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -79,6 +171,18 @@ func (v *Basegrulev3Visitor) VisitConstant(ctx *ConstantContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *Basegrulev3Visitor) VisitListLiteral(ctx *ListLiteralContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitMapLiteral(ctx *MapLiteralContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitMapEntry(ctx *MapEntryContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *Basegrulev3Visitor) VisitVariable(ctx *VariableContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -95,6 +199,14 @@ func (v *Basegrulev3Visitor) VisitFunctionCall(ctx *FunctionCallContext) interfa
 	return v.VisitChildren(ctx)
 }
 
+func (v *Basegrulev3Visitor) VisitQuantifier(ctx *QuantifierContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitAggregate(ctx *AggregateContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *Basegrulev3Visitor) VisitMethodCall(ctx *MethodCallContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -135,6 +247,14 @@ func (v *Basegrulev3Visitor) VisitStringLiteral(ctx *StringLiteralContext) inter
 	return v.VisitChildren(ctx)
 }
 
+func (v *Basegrulev3Visitor) VisitDurationLiteral(ctx *DurationLiteralContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitDateLiteral(ctx *DateLiteralContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *Basegrulev3Visitor) VisitBooleanLiteral(ctx *BooleanLiteralContext) interface{} {
 	return v.VisitChildren(ctx)
 }
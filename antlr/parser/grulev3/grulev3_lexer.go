@@ -43,37 +43,50 @@ func grulev3lexerLexerInit() {
 		"DEFAULT_MODE",
 	}
 	staticData.LiteralNames = []string{
-		"", "','", "'+'", "'-'", "'/'", "'*'", "'%'", "'.'", "';'", "'{'", "'}'",
-		"'('", "')'", "'['", "']'", "", "", "", "'&&'", "'||'", "", "", "",
-		"'!'", "", "'=='", "'='", "'+='", "'-='", "'/='", "'*='", "'>'", "'<'",
-		"'>='", "'<='", "'!='", "'&'", "'|'",
+		"", "','", "'+'", "'-'", "'/'", "'**'", "'~/'", "'*'", "'%'", "'.'",
+		"';'", "':'", "'?'", "'?.'", "'??'", "'@'", "'{'", "'}'", "'('", "')'",
+		"'['", "']'", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "'&&'", "'||'", "", "", "", "'!'", "", "", "", "",
+		"", "", "", "", "'=='", "'='", "'+='", "'-='", "'/='", "'*='", "'>'",
+		"'<'", "'>='", "'<='", "'!='", "'&'", "'|'",
 	}
 	staticData.SymbolicNames = []string{
-		"", "", "PLUS", "MINUS", "DIV", "MUL", "MOD", "DOT", "SEMICOLON", "LR_BRACE",
-		"RR_BRACE", "LR_BRACKET", "RR_BRACKET", "LS_BRACKET", "RS_BRACKET",
-		"RULE", "WHEN", "THEN", "AND", "OR", "TRUE", "FALSE", "NIL_LITERAL",
-		"NEGATION", "SALIENCE", "EQUALS", "ASSIGN", "PLUS_ASIGN", "MINUS_ASIGN",
-		"DIV_ASIGN", "MUL_ASIGN", "GT", "LT", "GTE", "LTE", "NOTEQUALS", "BITAND",
-		"BITOR", "SIMPLENAME", "DQUOTA_STRING", "SQUOTA_STRING", "DECIMAL_FLOAT_LIT",
-		"DECIMAL_EXPONENT", "HEX_FLOAT_LIT", "HEX_EXPONENT", "DEC_LIT", "HEX_LIT",
-		"OCT_LIT", "SPACE", "COMMENT", "LINE_COMMENT",
+		"", "", "PLUS", "MINUS", "DIV", "POW", "INT_DIV", "MUL", "MOD", "DOT",
+		"SEMICOLON", "COLON", "QUESTION", "SAFE_DOT", "NULL_COALESCE", "AT",
+		"LR_BRACE", "RR_BRACE", "LR_BRACKET", "RR_BRACKET", "LS_BRACKET", "RS_BRACKET",
+		"RULE", "WHEN", "THEN", "IF", "ELSE", "LET", "IN", "FOR", "NOT", "MATCHES",
+		"BETWEEN", "AND_WORD", "FUNCTION", "RETURN", "CONST", "GLOBAL", "EXTENDS",
+		"PACKAGE", "IMPORT", "AND", "OR", "TRUE", "FALSE", "NIL_LITERAL", "NEGATION",
+		"SALIENCE", "AGENDA_GROUP", "ACTIVATION_GROUP", "NO_LOOP", "LOCK_ON_ACTIVE",
+		"DATE_EFFECTIVE", "DATE_EXPIRES", "ENABLED", "EQUALS", "ASSIGN", "PLUS_ASIGN",
+		"MINUS_ASIGN", "DIV_ASIGN", "MUL_ASIGN", "GT", "LT", "GTE", "LTE", "NOTEQUALS",
+		"BITAND", "BITOR", "ISO_DURATION_LIT", "SIMPLENAME", "DQUOTA_STRING",
+		"SQUOTA_STRING", "DECIMAL_FLOAT_LIT", "DECIMAL_EXPONENT", "HEX_FLOAT_LIT",
+		"HEX_EXPONENT", "DEC_LIT", "HEX_LIT", "DURATION_LIT", "DATE_LIT", "OCT_LIT",
+		"SPACE", "COMMENT", "LINE_COMMENT",
 	}
 	staticData.RuleNames = []string{
 		"T__0", "A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L",
 		"M", "N", "O", "P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z",
-		"ISC", "IC", "PLUS", "MINUS", "DIV", "MUL", "MOD", "DOT", "SEMICOLON",
-		"LR_BRACE", "RR_BRACE", "LR_BRACKET", "RR_BRACKET", "LS_BRACKET", "RS_BRACKET",
-		"RULE", "WHEN", "THEN", "AND", "OR", "TRUE", "FALSE", "NIL_LITERAL",
-		"NEGATION", "SALIENCE", "EQUALS", "ASSIGN", "PLUS_ASIGN", "MINUS_ASIGN",
-		"DIV_ASIGN", "MUL_ASIGN", "GT", "LT", "GTE", "LTE", "NOTEQUALS", "BITAND",
-		"BITOR", "SIMPLENAME", "DQUOTA_STRING", "SQUOTA_STRING", "DECIMAL_FLOAT_LIT",
+		"ISC", "IC", "PLUS", "MINUS", "DIV", "POW", "INT_DIV", "MUL", "MOD",
+		"DOT", "SEMICOLON", "COLON", "QUESTION", "SAFE_DOT", "NULL_COALESCE",
+		"AT", "LR_BRACE", "RR_BRACE", "LR_BRACKET", "RR_BRACKET", "LS_BRACKET",
+		"RS_BRACKET", "RULE", "WHEN", "THEN", "IF", "ELSE", "LET", "IN", "FOR",
+		"NOT", "MATCHES", "BETWEEN", "AND_WORD", "FUNCTION", "RETURN", "CONST",
+		"GLOBAL", "EXTENDS", "PACKAGE", "IMPORT", "AND", "OR", "TRUE", "FALSE",
+		"NIL_LITERAL", "NEGATION", "SALIENCE", "AGENDA_GROUP", "ACTIVATION_GROUP",
+		"NO_LOOP", "LOCK_ON_ACTIVE", "DATE_EFFECTIVE", "DATE_EXPIRES", "ENABLED",
+		"EQUALS", "ASSIGN", "PLUS_ASIGN", "MINUS_ASIGN", "DIV_ASIGN", "MUL_ASIGN",
+		"GT", "LT", "GTE", "LTE", "NOTEQUALS", "BITAND", "BITOR", "ISO_DURATION_LIT",
+		"SIMPLENAME", "DQUOTA_STRING", "SQUOTA_STRING", "DECIMAL_FLOAT_LIT",
 		"DECIMAL_EXPONENT", "HEX_FLOAT_LIT", "HEX_MANTISA", "HEX_EXPONENT",
-		"DEC_LIT", "HEX_LIT", "OCT_LIT", "HEX_DIGITS", "DEC_DIGITS", "OCT_DIGITS",
-		"DEC_DIGIT", "OCT_DIGIT", "HEX_DIGIT", "SPACE", "COMMENT", "LINE_COMMENT",
+		"DEC_LIT", "HEX_LIT", "DURATION_LIT", "DATE_LIT", "OCT_LIT", "HEX_DIGITS",
+		"DEC_DIGITS", "OCT_DIGITS", "DEC_DIGIT", "DATE_DIGITS", "DATE_ZONE",
+		"OCT_DIGIT", "HEX_DIGIT", "SPACE", "COMMENT", "LINE_COMMENT",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 50, 484, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
+		4, 0, 83, 839, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
 		4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2,
 		10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15,
 		7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7,
@@ -89,208 +102,369 @@ func grulev3lexerLexerInit() {
 		2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2,
 		73, 7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78,
 		7, 78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7,
-		83, 2, 84, 7, 84, 1, 0, 1, 0, 1, 1, 1, 1, 1, 2, 1, 2, 1, 3, 1, 3, 1, 4,
-		1, 4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 8, 1, 8, 1, 9, 1, 9, 1, 10,
-		1, 10, 1, 11, 1, 11, 1, 12, 1, 12, 1, 13, 1, 13, 1, 14, 1, 14, 1, 15, 1,
-		15, 1, 16, 1, 16, 1, 17, 1, 17, 1, 18, 1, 18, 1, 19, 1, 19, 1, 20, 1, 20,
-		1, 21, 1, 21, 1, 22, 1, 22, 1, 23, 1, 23, 1, 24, 1, 24, 1, 25, 1, 25, 1,
-		26, 1, 26, 1, 27, 1, 27, 1, 28, 1, 28, 3, 28, 230, 8, 28, 1, 29, 1, 29,
-		1, 30, 1, 30, 1, 31, 1, 31, 1, 32, 1, 32, 1, 33, 1, 33, 1, 34, 1, 34, 1,
-		35, 1, 35, 1, 36, 1, 36, 1, 37, 1, 37, 1, 38, 1, 38, 1, 39, 1, 39, 1, 40,
-		1, 40, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1,
-		43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45,
-		1, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1,
-		48, 1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 51,
-		1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1,
-		52, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 56, 1, 56,
-		1, 56, 1, 57, 1, 57, 1, 57, 1, 58, 1, 58, 1, 59, 1, 59, 1, 60, 1, 60, 1,
-		60, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 64, 1, 64,
-		1, 65, 1, 65, 5, 65, 341, 8, 65, 10, 65, 12, 65, 344, 9, 65, 1, 66, 1,
-		66, 1, 66, 1, 66, 1, 66, 1, 66, 5, 66, 352, 8, 66, 10, 66, 12, 66, 355,
-		9, 66, 1, 66, 1, 66, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 5, 67, 365,
-		8, 67, 10, 67, 12, 67, 368, 9, 67, 1, 67, 1, 67, 1, 68, 1, 68, 1, 68, 1,
-		68, 3, 68, 376, 8, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 3, 68,
-		384, 8, 68, 3, 68, 386, 8, 68, 1, 69, 1, 69, 1, 69, 3, 69, 391, 8, 69,
-		1, 69, 1, 69, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 71, 1, 71, 1, 71, 3,
-		71, 403, 8, 71, 1, 71, 1, 71, 1, 71, 1, 71, 3, 71, 409, 8, 71, 1, 72, 1,
-		72, 1, 72, 3, 72, 414, 8, 72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 3, 73,
-		421, 8, 73, 3, 73, 423, 8, 73, 1, 74, 1, 74, 1, 74, 1, 74, 1, 75, 1, 75,
-		1, 75, 1, 76, 4, 76, 433, 8, 76, 11, 76, 12, 76, 434, 1, 77, 4, 77, 438,
-		8, 77, 11, 77, 12, 77, 439, 1, 78, 4, 78, 443, 8, 78, 11, 78, 12, 78, 444,
-		1, 79, 1, 79, 1, 80, 1, 80, 1, 81, 1, 81, 1, 82, 4, 82, 454, 8, 82, 11,
-		82, 12, 82, 455, 1, 82, 1, 82, 1, 83, 1, 83, 1, 83, 1, 83, 5, 83, 464,
-		8, 83, 10, 83, 12, 83, 467, 9, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1,
-		84, 1, 84, 1, 84, 1, 84, 5, 84, 478, 8, 84, 10, 84, 12, 84, 481, 9, 84,
-		1, 84, 1, 84, 1, 465, 0, 85, 1, 1, 3, 0, 5, 0, 7, 0, 9, 0, 11, 0, 13, 0,
-		15, 0, 17, 0, 19, 0, 21, 0, 23, 0, 25, 0, 27, 0, 29, 0, 31, 0, 33, 0, 35,
-		0, 37, 0, 39, 0, 41, 0, 43, 0, 45, 0, 47, 0, 49, 0, 51, 0, 53, 0, 55, 0,
-		57, 0, 59, 2, 61, 3, 63, 4, 65, 5, 67, 6, 69, 7, 71, 8, 73, 9, 75, 10,
-		77, 11, 79, 12, 81, 13, 83, 14, 85, 15, 87, 16, 89, 17, 91, 18, 93, 19,
-		95, 20, 97, 21, 99, 22, 101, 23, 103, 24, 105, 25, 107, 26, 109, 27, 111,
-		28, 113, 29, 115, 30, 117, 31, 119, 32, 121, 33, 123, 34, 125, 35, 127,
-		36, 129, 37, 131, 38, 133, 39, 135, 40, 137, 41, 139, 42, 141, 43, 143,
-		0, 145, 44, 147, 45, 149, 46, 151, 47, 153, 0, 155, 0, 157, 0, 159, 0,
-		161, 0, 163, 0, 165, 48, 167, 49, 169, 50, 1, 0, 36, 2, 0, 65, 65, 97,
-		97, 2, 0, 66, 66, 98, 98, 2, 0, 67, 67, 99, 99, 2, 0, 68, 68, 100, 100,
-		2, 0, 69, 69, 101, 101, 2, 0, 70, 70, 102, 102, 2, 0, 71, 71, 103, 103,
-		2, 0, 72, 72, 104, 104, 2, 0, 73, 73, 105, 105, 2, 0, 74, 74, 106, 106,
-		2, 0, 75, 75, 107, 107, 2, 0, 76, 76, 108, 108, 2, 0, 77, 77, 109, 109,
-		2, 0, 78, 78, 110, 110, 2, 0, 79, 79, 111, 111, 2, 0, 80, 80, 112, 112,
-		2, 0, 81, 81, 113, 113, 2, 0, 82, 82, 114, 114, 2, 0, 83, 83, 115, 115,
-		2, 0, 84, 84, 116, 116, 2, 0, 85, 85, 117, 117, 2, 0, 86, 86, 118, 118,
-		2, 0, 87, 87, 119, 119, 2, 0, 88, 88, 120, 120, 2, 0, 89, 89, 121, 121,
-		2, 0, 90, 90, 122, 122, 13, 0, 65, 90, 97, 122, 192, 214, 216, 246, 248,
-		767, 880, 893, 895, 8191, 8204, 8205, 8304, 8591, 11264, 12271, 12289,
-		55295, 63744, 64975, 65008, 65533, 5, 0, 48, 57, 95, 95, 183, 183, 768,
-		879, 8255, 8256, 2, 0, 34, 34, 92, 92, 2, 0, 39, 39, 92, 92, 1, 0, 49,
-		57, 1, 0, 48, 57, 1, 0, 48, 55, 3, 0, 48, 57, 65, 70, 97, 102, 3, 0, 9,
-		10, 13, 13, 32, 32, 2, 0, 10, 10, 13, 13, 475, 0, 1, 1, 0, 0, 0, 0, 59,
-		1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0,
-		67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0,
-		0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0,
-		0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0,
-		0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1,
-		0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0,
-		105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111, 1, 0,
-		0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0, 0, 119,
-		1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0, 125, 1, 0, 0, 0,
-		0, 127, 1, 0, 0, 0, 0, 129, 1, 0, 0, 0, 0, 131, 1, 0, 0, 0, 0, 133, 1,
-		0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139, 1, 0, 0, 0, 0,
-		141, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0, 0, 147, 1, 0, 0, 0, 0, 149, 1, 0,
-		0, 0, 0, 151, 1, 0, 0, 0, 0, 165, 1, 0, 0, 0, 0, 167, 1, 0, 0, 0, 0, 169,
-		1, 0, 0, 0, 1, 171, 1, 0, 0, 0, 3, 173, 1, 0, 0, 0, 5, 175, 1, 0, 0, 0,
-		7, 177, 1, 0, 0, 0, 9, 179, 1, 0, 0, 0, 11, 181, 1, 0, 0, 0, 13, 183, 1,
-		0, 0, 0, 15, 185, 1, 0, 0, 0, 17, 187, 1, 0, 0, 0, 19, 189, 1, 0, 0, 0,
-		21, 191, 1, 0, 0, 0, 23, 193, 1, 0, 0, 0, 25, 195, 1, 0, 0, 0, 27, 197,
-		1, 0, 0, 0, 29, 199, 1, 0, 0, 0, 31, 201, 1, 0, 0, 0, 33, 203, 1, 0, 0,
-		0, 35, 205, 1, 0, 0, 0, 37, 207, 1, 0, 0, 0, 39, 209, 1, 0, 0, 0, 41, 211,
-		1, 0, 0, 0, 43, 213, 1, 0, 0, 0, 45, 215, 1, 0, 0, 0, 47, 217, 1, 0, 0,
-		0, 49, 219, 1, 0, 0, 0, 51, 221, 1, 0, 0, 0, 53, 223, 1, 0, 0, 0, 55, 225,
-		1, 0, 0, 0, 57, 229, 1, 0, 0, 0, 59, 231, 1, 0, 0, 0, 61, 233, 1, 0, 0,
-		0, 63, 235, 1, 0, 0, 0, 65, 237, 1, 0, 0, 0, 67, 239, 1, 0, 0, 0, 69, 241,
-		1, 0, 0, 0, 71, 243, 1, 0, 0, 0, 73, 245, 1, 0, 0, 0, 75, 247, 1, 0, 0,
-		0, 77, 249, 1, 0, 0, 0, 79, 251, 1, 0, 0, 0, 81, 253, 1, 0, 0, 0, 83, 255,
-		1, 0, 0, 0, 85, 257, 1, 0, 0, 0, 87, 262, 1, 0, 0, 0, 89, 267, 1, 0, 0,
-		0, 91, 272, 1, 0, 0, 0, 93, 275, 1, 0, 0, 0, 95, 278, 1, 0, 0, 0, 97, 283,
-		1, 0, 0, 0, 99, 289, 1, 0, 0, 0, 101, 293, 1, 0, 0, 0, 103, 295, 1, 0,
-		0, 0, 105, 304, 1, 0, 0, 0, 107, 307, 1, 0, 0, 0, 109, 309, 1, 0, 0, 0,
-		111, 312, 1, 0, 0, 0, 113, 315, 1, 0, 0, 0, 115, 318, 1, 0, 0, 0, 117,
-		321, 1, 0, 0, 0, 119, 323, 1, 0, 0, 0, 121, 325, 1, 0, 0, 0, 123, 328,
-		1, 0, 0, 0, 125, 331, 1, 0, 0, 0, 127, 334, 1, 0, 0, 0, 129, 336, 1, 0,
-		0, 0, 131, 338, 1, 0, 0, 0, 133, 345, 1, 0, 0, 0, 135, 358, 1, 0, 0, 0,
-		137, 385, 1, 0, 0, 0, 139, 387, 1, 0, 0, 0, 141, 394, 1, 0, 0, 0, 143,
-		408, 1, 0, 0, 0, 145, 410, 1, 0, 0, 0, 147, 422, 1, 0, 0, 0, 149, 424,
-		1, 0, 0, 0, 151, 428, 1, 0, 0, 0, 153, 432, 1, 0, 0, 0, 155, 437, 1, 0,
-		0, 0, 157, 442, 1, 0, 0, 0, 159, 446, 1, 0, 0, 0, 161, 448, 1, 0, 0, 0,
-		163, 450, 1, 0, 0, 0, 165, 453, 1, 0, 0, 0, 167, 459, 1, 0, 0, 0, 169,
-		473, 1, 0, 0, 0, 171, 172, 5, 44, 0, 0, 172, 2, 1, 0, 0, 0, 173, 174, 7,
-		0, 0, 0, 174, 4, 1, 0, 0, 0, 175, 176, 7, 1, 0, 0, 176, 6, 1, 0, 0, 0,
-		177, 178, 7, 2, 0, 0, 178, 8, 1, 0, 0, 0, 179, 180, 7, 3, 0, 0, 180, 10,
-		1, 0, 0, 0, 181, 182, 7, 4, 0, 0, 182, 12, 1, 0, 0, 0, 183, 184, 7, 5,
-		0, 0, 184, 14, 1, 0, 0, 0, 185, 186, 7, 6, 0, 0, 186, 16, 1, 0, 0, 0, 187,
-		188, 7, 7, 0, 0, 188, 18, 1, 0, 0, 0, 189, 190, 7, 8, 0, 0, 190, 20, 1,
-		0, 0, 0, 191, 192, 7, 9, 0, 0, 192, 22, 1, 0, 0, 0, 193, 194, 7, 10, 0,
-		0, 194, 24, 1, 0, 0, 0, 195, 196, 7, 11, 0, 0, 196, 26, 1, 0, 0, 0, 197,
-		198, 7, 12, 0, 0, 198, 28, 1, 0, 0, 0, 199, 200, 7, 13, 0, 0, 200, 30,
-		1, 0, 0, 0, 201, 202, 7, 14, 0, 0, 202, 32, 1, 0, 0, 0, 203, 204, 7, 15,
-		0, 0, 204, 34, 1, 0, 0, 0, 205, 206, 7, 16, 0, 0, 206, 36, 1, 0, 0, 0,
-		207, 208, 7, 17, 0, 0, 208, 38, 1, 0, 0, 0, 209, 210, 7, 18, 0, 0, 210,
-		40, 1, 0, 0, 0, 211, 212, 7, 19, 0, 0, 212, 42, 1, 0, 0, 0, 213, 214, 7,
-		20, 0, 0, 214, 44, 1, 0, 0, 0, 215, 216, 7, 21, 0, 0, 216, 46, 1, 0, 0,
-		0, 217, 218, 7, 22, 0, 0, 218, 48, 1, 0, 0, 0, 219, 220, 7, 23, 0, 0, 220,
-		50, 1, 0, 0, 0, 221, 222, 7, 24, 0, 0, 222, 52, 1, 0, 0, 0, 223, 224, 7,
-		25, 0, 0, 224, 54, 1, 0, 0, 0, 225, 226, 7, 26, 0, 0, 226, 56, 1, 0, 0,
-		0, 227, 230, 3, 55, 27, 0, 228, 230, 7, 27, 0, 0, 229, 227, 1, 0, 0, 0,
-		229, 228, 1, 0, 0, 0, 230, 58, 1, 0, 0, 0, 231, 232, 5, 43, 0, 0, 232,
-		60, 1, 0, 0, 0, 233, 234, 5, 45, 0, 0, 234, 62, 1, 0, 0, 0, 235, 236, 5,
-		47, 0, 0, 236, 64, 1, 0, 0, 0, 237, 238, 5, 42, 0, 0, 238, 66, 1, 0, 0,
-		0, 239, 240, 5, 37, 0, 0, 240, 68, 1, 0, 0, 0, 241, 242, 5, 46, 0, 0, 242,
-		70, 1, 0, 0, 0, 243, 244, 5, 59, 0, 0, 244, 72, 1, 0, 0, 0, 245, 246, 5,
-		123, 0, 0, 246, 74, 1, 0, 0, 0, 247, 248, 5, 125, 0, 0, 248, 76, 1, 0,
-		0, 0, 249, 250, 5, 40, 0, 0, 250, 78, 1, 0, 0, 0, 251, 252, 5, 41, 0, 0,
-		252, 80, 1, 0, 0, 0, 253, 254, 5, 91, 0, 0, 254, 82, 1, 0, 0, 0, 255, 256,
-		5, 93, 0, 0, 256, 84, 1, 0, 0, 0, 257, 258, 3, 37, 18, 0, 258, 259, 3,
-		43, 21, 0, 259, 260, 3, 25, 12, 0, 260, 261, 3, 11, 5, 0, 261, 86, 1, 0,
-		0, 0, 262, 263, 3, 47, 23, 0, 263, 264, 3, 17, 8, 0, 264, 265, 3, 11, 5,
-		0, 265, 266, 3, 29, 14, 0, 266, 88, 1, 0, 0, 0, 267, 268, 3, 41, 20, 0,
-		268, 269, 3, 17, 8, 0, 269, 270, 3, 11, 5, 0, 270, 271, 3, 29, 14, 0, 271,
-		90, 1, 0, 0, 0, 272, 273, 5, 38, 0, 0, 273, 274, 5, 38, 0, 0, 274, 92,
-		1, 0, 0, 0, 275, 276, 5, 124, 0, 0, 276, 277, 5, 124, 0, 0, 277, 94, 1,
-		0, 0, 0, 278, 279, 3, 41, 20, 0, 279, 280, 3, 37, 18, 0, 280, 281, 3, 43,
-		21, 0, 281, 282, 3, 11, 5, 0, 282, 96, 1, 0, 0, 0, 283, 284, 3, 13, 6,
-		0, 284, 285, 3, 3, 1, 0, 285, 286, 3, 25, 12, 0, 286, 287, 3, 39, 19, 0,
-		287, 288, 3, 11, 5, 0, 288, 98, 1, 0, 0, 0, 289, 290, 3, 29, 14, 0, 290,
-		291, 3, 19, 9, 0, 291, 292, 3, 25, 12, 0, 292, 100, 1, 0, 0, 0, 293, 294,
-		5, 33, 0, 0, 294, 102, 1, 0, 0, 0, 295, 296, 3, 39, 19, 0, 296, 297, 3,
-		3, 1, 0, 297, 298, 3, 25, 12, 0, 298, 299, 3, 19, 9, 0, 299, 300, 3, 11,
-		5, 0, 300, 301, 3, 29, 14, 0, 301, 302, 3, 7, 3, 0, 302, 303, 3, 11, 5,
-		0, 303, 104, 1, 0, 0, 0, 304, 305, 5, 61, 0, 0, 305, 306, 5, 61, 0, 0,
-		306, 106, 1, 0, 0, 0, 307, 308, 5, 61, 0, 0, 308, 108, 1, 0, 0, 0, 309,
-		310, 5, 43, 0, 0, 310, 311, 5, 61, 0, 0, 311, 110, 1, 0, 0, 0, 312, 313,
-		5, 45, 0, 0, 313, 314, 5, 61, 0, 0, 314, 112, 1, 0, 0, 0, 315, 316, 5,
-		47, 0, 0, 316, 317, 5, 61, 0, 0, 317, 114, 1, 0, 0, 0, 318, 319, 5, 42,
-		0, 0, 319, 320, 5, 61, 0, 0, 320, 116, 1, 0, 0, 0, 321, 322, 5, 62, 0,
-		0, 322, 118, 1, 0, 0, 0, 323, 324, 5, 60, 0, 0, 324, 120, 1, 0, 0, 0, 325,
-		326, 5, 62, 0, 0, 326, 327, 5, 61, 0, 0, 327, 122, 1, 0, 0, 0, 328, 329,
-		5, 60, 0, 0, 329, 330, 5, 61, 0, 0, 330, 124, 1, 0, 0, 0, 331, 332, 5,
-		33, 0, 0, 332, 333, 5, 61, 0, 0, 333, 126, 1, 0, 0, 0, 334, 335, 5, 38,
-		0, 0, 335, 128, 1, 0, 0, 0, 336, 337, 5, 124, 0, 0, 337, 130, 1, 0, 0,
-		0, 338, 342, 3, 55, 27, 0, 339, 341, 3, 57, 28, 0, 340, 339, 1, 0, 0, 0,
-		341, 344, 1, 0, 0, 0, 342, 340, 1, 0, 0, 0, 342, 343, 1, 0, 0, 0, 343,
-		132, 1, 0, 0, 0, 344, 342, 1, 0, 0, 0, 345, 353, 5, 34, 0, 0, 346, 347,
-		5, 92, 0, 0, 347, 352, 9, 0, 0, 0, 348, 349, 5, 34, 0, 0, 349, 352, 5,
-		34, 0, 0, 350, 352, 8, 28, 0, 0, 351, 346, 1, 0, 0, 0, 351, 348, 1, 0,
-		0, 0, 351, 350, 1, 0, 0, 0, 352, 355, 1, 0, 0, 0, 353, 351, 1, 0, 0, 0,
-		353, 354, 1, 0, 0, 0, 354, 356, 1, 0, 0, 0, 355, 353, 1, 0, 0, 0, 356,
-		357, 5, 34, 0, 0, 357, 134, 1, 0, 0, 0, 358, 366, 5, 39, 0, 0, 359, 360,
-		5, 92, 0, 0, 360, 365, 9, 0, 0, 0, 361, 362, 5, 39, 0, 0, 362, 365, 5,
-		39, 0, 0, 363, 365, 8, 29, 0, 0, 364, 359, 1, 0, 0, 0, 364, 361, 1, 0,
-		0, 0, 364, 363, 1, 0, 0, 0, 365, 368, 1, 0, 0, 0, 366, 364, 1, 0, 0, 0,
-		366, 367, 1, 0, 0, 0, 367, 369, 1, 0, 0, 0, 368, 366, 1, 0, 0, 0, 369,
-		370, 5, 39, 0, 0, 370, 136, 1, 0, 0, 0, 371, 372, 3, 147, 73, 0, 372, 373,
-		3, 69, 34, 0, 373, 375, 3, 155, 77, 0, 374, 376, 3, 139, 69, 0, 375, 374,
-		1, 0, 0, 0, 375, 376, 1, 0, 0, 0, 376, 386, 1, 0, 0, 0, 377, 378, 3, 147,
-		73, 0, 378, 379, 3, 139, 69, 0, 379, 386, 1, 0, 0, 0, 380, 381, 3, 69,
-		34, 0, 381, 383, 3, 155, 77, 0, 382, 384, 3, 139, 69, 0, 383, 382, 1, 0,
-		0, 0, 383, 384, 1, 0, 0, 0, 384, 386, 1, 0, 0, 0, 385, 371, 1, 0, 0, 0,
-		385, 377, 1, 0, 0, 0, 385, 380, 1, 0, 0, 0, 386, 138, 1, 0, 0, 0, 387,
-		390, 3, 11, 5, 0, 388, 391, 3, 59, 29, 0, 389, 391, 3, 61, 30, 0, 390,
-		388, 1, 0, 0, 0, 390, 389, 1, 0, 0, 0, 390, 391, 1, 0, 0, 0, 391, 392,
-		1, 0, 0, 0, 392, 393, 3, 155, 77, 0, 393, 140, 1, 0, 0, 0, 394, 395, 5,
-		48, 0, 0, 395, 396, 3, 49, 24, 0, 396, 397, 3, 143, 71, 0, 397, 398, 3,
-		145, 72, 0, 398, 142, 1, 0, 0, 0, 399, 400, 3, 153, 76, 0, 400, 402, 3,
-		69, 34, 0, 401, 403, 3, 153, 76, 0, 402, 401, 1, 0, 0, 0, 402, 403, 1,
-		0, 0, 0, 403, 409, 1, 0, 0, 0, 404, 409, 3, 153, 76, 0, 405, 406, 3, 69,
-		34, 0, 406, 407, 3, 153, 76, 0, 407, 409, 1, 0, 0, 0, 408, 399, 1, 0, 0,
-		0, 408, 404, 1, 0, 0, 0, 408, 405, 1, 0, 0, 0, 409, 144, 1, 0, 0, 0, 410,
-		413, 3, 33, 16, 0, 411, 414, 3, 59, 29, 0, 412, 414, 3, 61, 30, 0, 413,
-		411, 1, 0, 0, 0, 413, 412, 1, 0, 0, 0, 413, 414, 1, 0, 0, 0, 414, 415,
-		1, 0, 0, 0, 415, 416, 3, 155, 77, 0, 416, 146, 1, 0, 0, 0, 417, 423, 5,
-		48, 0, 0, 418, 420, 7, 30, 0, 0, 419, 421, 3, 155, 77, 0, 420, 419, 1,
-		0, 0, 0, 420, 421, 1, 0, 0, 0, 421, 423, 1, 0, 0, 0, 422, 417, 1, 0, 0,
-		0, 422, 418, 1, 0, 0, 0, 423, 148, 1, 0, 0, 0, 424, 425, 5, 48, 0, 0, 425,
-		426, 3, 49, 24, 0, 426, 427, 3, 153, 76, 0, 427, 150, 1, 0, 0, 0, 428,
-		429, 5, 48, 0, 0, 429, 430, 3, 157, 78, 0, 430, 152, 1, 0, 0, 0, 431, 433,
-		3, 163, 81, 0, 432, 431, 1, 0, 0, 0, 433, 434, 1, 0, 0, 0, 434, 432, 1,
-		0, 0, 0, 434, 435, 1, 0, 0, 0, 435, 154, 1, 0, 0, 0, 436, 438, 3, 159,
-		79, 0, 437, 436, 1, 0, 0, 0, 438, 439, 1, 0, 0, 0, 439, 437, 1, 0, 0, 0,
-		439, 440, 1, 0, 0, 0, 440, 156, 1, 0, 0, 0, 441, 443, 3, 161, 80, 0, 442,
-		441, 1, 0, 0, 0, 443, 444, 1, 0, 0, 0, 444, 442, 1, 0, 0, 0, 444, 445,
-		1, 0, 0, 0, 445, 158, 1, 0, 0, 0, 446, 447, 7, 31, 0, 0, 447, 160, 1, 0,
-		0, 0, 448, 449, 7, 32, 0, 0, 449, 162, 1, 0, 0, 0, 450, 451, 7, 33, 0,
-		0, 451, 164, 1, 0, 0, 0, 452, 454, 7, 34, 0, 0, 453, 452, 1, 0, 0, 0, 454,
-		455, 1, 0, 0, 0, 455, 453, 1, 0, 0, 0, 455, 456, 1, 0, 0, 0, 456, 457,
-		1, 0, 0, 0, 457, 458, 6, 82, 0, 0, 458, 166, 1, 0, 0, 0, 459, 460, 5, 47,
-		0, 0, 460, 461, 5, 42, 0, 0, 461, 465, 1, 0, 0, 0, 462, 464, 9, 0, 0, 0,
-		463, 462, 1, 0, 0, 0, 464, 467, 1, 0, 0, 0, 465, 466, 1, 0, 0, 0, 465,
-		463, 1, 0, 0, 0, 466, 468, 1, 0, 0, 0, 467, 465, 1, 0, 0, 0, 468, 469,
-		5, 42, 0, 0, 469, 470, 5, 47, 0, 0, 470, 471, 1, 0, 0, 0, 471, 472, 6,
-		83, 0, 0, 472, 168, 1, 0, 0, 0, 473, 474, 5, 47, 0, 0, 474, 475, 5, 47,
-		0, 0, 475, 479, 1, 0, 0, 0, 476, 478, 8, 35, 0, 0, 477, 476, 1, 0, 0, 0,
-		478, 481, 1, 0, 0, 0, 479, 477, 1, 0, 0, 0, 479, 480, 1, 0, 0, 0, 480,
-		482, 1, 0, 0, 0, 481, 479, 1, 0, 0, 0, 482, 483, 6, 84, 0, 0, 483, 170,
-		1, 0, 0, 0, 22, 0, 229, 342, 351, 353, 364, 366, 375, 383, 385, 390, 402,
-		408, 413, 420, 422, 434, 439, 444, 455, 465, 479, 1, 6, 0, 0,
+		83, 2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88,
+		2, 89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2,
+		94, 7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2, 99,
+		7, 99, 2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103,
+		2, 104, 7, 104, 2, 105, 7, 105, 2, 106, 7, 106, 2, 107, 7, 107, 2, 108,
+		7, 108, 2, 109, 7, 109, 2, 110, 7, 110, 2, 111, 7, 111, 2, 112, 7, 112,
+		2, 113, 7, 113, 2, 114, 7, 114, 2, 115, 7, 115, 2, 116, 7, 116, 2, 117,
+		7, 117, 2, 118, 7, 118, 2, 119, 7, 119, 1, 0, 1, 0, 1, 1, 1, 1, 1, 2, 1,
+		2, 1, 3, 1, 3, 1, 4, 1, 4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 8, 1,
+		8, 1, 9, 1, 9, 1, 10, 1, 10, 1, 11, 1, 11, 1, 12, 1, 12, 1, 13, 1, 13,
+		1, 14, 1, 14, 1, 15, 1, 15, 1, 16, 1, 16, 1, 17, 1, 17, 1, 18, 1, 18, 1,
+		19, 1, 19, 1, 20, 1, 20, 1, 21, 1, 21, 1, 22, 1, 22, 1, 23, 1, 23, 1, 24,
+		1, 24, 1, 25, 1, 25, 1, 26, 1, 26, 1, 27, 1, 27, 1, 28, 1, 28, 3, 28, 300,
+		8, 28, 1, 29, 1, 29, 1, 30, 1, 30, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1,
+		33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 35, 1, 35, 1, 36, 1, 36, 1, 37, 1, 37,
+		1, 38, 1, 38, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1,
+		42, 1, 42, 1, 43, 1, 43, 1, 44, 1, 44, 1, 45, 1, 45, 1, 46, 1, 46, 1, 47,
+		1, 47, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1,
+		50, 1, 50, 1, 50, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52,
+		1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 54, 1, 55, 1,
+		55, 1, 55, 1, 56, 1, 56, 1, 56, 1, 56, 1, 57, 1, 57, 1, 57, 1, 57, 1, 58,
+		1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 1,
+		59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61,
+		1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 1,
+		62, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 64,
+		1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 65, 1, 65, 1, 65, 1, 65, 1,
+		65, 1, 65, 1, 65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66,
+		1, 66, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 68, 1, 68, 1,
+		68, 1, 69, 1, 69, 1, 69, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 71, 1, 71,
+		1, 71, 1, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 73, 1, 73, 1,
+		74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 75, 1, 75,
+		1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1,
+		75, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76,
+		1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 77, 1, 77, 1, 77, 1,
+		77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78,
+		1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 79, 1,
+		79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79,
+		1, 79, 1, 79, 1, 79, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1,
+		80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81,
+		1, 81, 1, 81, 1, 81, 1, 82, 1, 82, 1, 82, 1, 83, 1, 83, 1, 84, 1, 84, 1,
+		84, 1, 85, 1, 85, 1, 85, 1, 86, 1, 86, 1, 86, 1, 87, 1, 87, 1, 87, 1, 88,
+		1, 88, 1, 89, 1, 89, 1, 90, 1, 90, 1, 90, 1, 91, 1, 91, 1, 91, 1, 92, 1,
+		92, 1, 92, 1, 93, 1, 93, 1, 94, 1, 94, 1, 95, 1, 95, 1, 95, 1, 95, 4, 95,
+		615, 8, 95, 11, 95, 12, 95, 616, 1, 95, 1, 95, 1, 95, 1, 95, 4, 95, 623,
+		8, 95, 11, 95, 12, 95, 624, 3, 95, 627, 8, 95, 1, 95, 1, 95, 1, 95, 1,
+		95, 1, 95, 1, 95, 4, 95, 635, 8, 95, 11, 95, 12, 95, 636, 3, 95, 639, 8,
+		95, 1, 96, 1, 96, 5, 96, 643, 8, 96, 10, 96, 12, 96, 646, 9, 96, 1, 97,
+		1, 97, 1, 97, 1, 97, 1, 97, 1, 97, 5, 97, 654, 8, 97, 10, 97, 12, 97, 657,
+		9, 97, 1, 97, 1, 97, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 5, 98, 667,
+		8, 98, 10, 98, 12, 98, 670, 9, 98, 1, 98, 1, 98, 1, 99, 1, 99, 1, 99, 1,
+		99, 3, 99, 678, 8, 99, 1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 3, 99,
+		686, 8, 99, 3, 99, 688, 8, 99, 1, 100, 1, 100, 1, 100, 3, 100, 693, 8,
+		100, 1, 100, 1, 100, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 102, 1,
+		102, 1, 102, 3, 102, 705, 8, 102, 1, 102, 1, 102, 1, 102, 1, 102, 3, 102,
+		711, 8, 102, 1, 103, 1, 103, 1, 103, 3, 103, 716, 8, 103, 1, 103, 1, 103,
+		1, 104, 1, 104, 1, 104, 3, 104, 723, 8, 104, 3, 104, 725, 8, 104, 1, 105,
+		1, 105, 1, 105, 1, 105, 1, 106, 1, 106, 1, 106, 1, 106, 1, 106, 1, 106,
+		1, 106, 1, 106, 3, 106, 739, 8, 106, 4, 106, 741, 8, 106, 11, 106, 12,
+		106, 742, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107,
+		1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 3, 107,
+		761, 8, 107, 3, 107, 763, 8, 107, 1, 107, 3, 107, 766, 8, 107, 3, 107,
+		768, 8, 107, 1, 108, 1, 108, 1, 108, 1, 109, 4, 109, 774, 8, 109, 11, 109,
+		12, 109, 775, 1, 110, 4, 110, 779, 8, 110, 11, 110, 12, 110, 780, 1, 111,
+		4, 111, 784, 8, 111, 11, 111, 12, 111, 785, 1, 112, 1, 112, 1, 113, 1,
+		113, 1, 113, 1, 114, 1, 114, 1, 114, 3, 114, 796, 8, 114, 1, 114, 1, 114,
+		1, 114, 1, 114, 3, 114, 802, 8, 114, 1, 115, 1, 115, 1, 116, 1, 116, 1,
+		117, 4, 117, 809, 8, 117, 11, 117, 12, 117, 810, 1, 117, 1, 117, 1, 118,
+		1, 118, 1, 118, 1, 118, 5, 118, 819, 8, 118, 10, 118, 12, 118, 822, 9,
+		118, 1, 118, 1, 118, 1, 118, 1, 118, 1, 118, 1, 119, 1, 119, 1, 119, 1,
+		119, 5, 119, 833, 8, 119, 10, 119, 12, 119, 836, 9, 119, 1, 119, 1, 119,
+		1, 820, 0, 120, 1, 1, 3, 0, 5, 0, 7, 0, 9, 0, 11, 0, 13, 0, 15, 0, 17,
+		0, 19, 0, 21, 0, 23, 0, 25, 0, 27, 0, 29, 0, 31, 0, 33, 0, 35, 0, 37, 0,
+		39, 0, 41, 0, 43, 0, 45, 0, 47, 0, 49, 0, 51, 0, 53, 0, 55, 0, 57, 0, 59,
+		2, 61, 3, 63, 4, 65, 5, 67, 6, 69, 7, 71, 8, 73, 9, 75, 10, 77, 11, 79,
+		12, 81, 13, 83, 14, 85, 15, 87, 16, 89, 17, 91, 18, 93, 19, 95, 20, 97,
+		21, 99, 22, 101, 23, 103, 24, 105, 25, 107, 26, 109, 27, 111, 28, 113,
+		29, 115, 30, 117, 31, 119, 32, 121, 33, 123, 34, 125, 35, 127, 36, 129,
+		37, 131, 38, 133, 39, 135, 40, 137, 41, 139, 42, 141, 43, 143, 44, 145,
+		45, 147, 46, 149, 47, 151, 48, 153, 49, 155, 50, 157, 51, 159, 52, 161,
+		53, 163, 54, 165, 55, 167, 56, 169, 57, 171, 58, 173, 59, 175, 60, 177,
+		61, 179, 62, 181, 63, 183, 64, 185, 65, 187, 66, 189, 67, 191, 68, 193,
+		69, 195, 70, 197, 71, 199, 72, 201, 73, 203, 74, 205, 0, 207, 75, 209,
+		76, 211, 77, 213, 78, 215, 79, 217, 80, 219, 0, 221, 0, 223, 0, 225, 0,
+		227, 0, 229, 0, 231, 0, 233, 0, 235, 81, 237, 82, 239, 83, 1, 0, 39, 2,
+		0, 65, 65, 97, 97, 2, 0, 66, 66, 98, 98, 2, 0, 67, 67, 99, 99, 2, 0, 68,
+		68, 100, 100, 2, 0, 69, 69, 101, 101, 2, 0, 70, 70, 102, 102, 2, 0, 71,
+		71, 103, 103, 2, 0, 72, 72, 104, 104, 2, 0, 73, 73, 105, 105, 2, 0, 74,
+		74, 106, 106, 2, 0, 75, 75, 107, 107, 2, 0, 76, 76, 108, 108, 2, 0, 77,
+		77, 109, 109, 2, 0, 78, 78, 110, 110, 2, 0, 79, 79, 111, 111, 2, 0, 80,
+		80, 112, 112, 2, 0, 81, 81, 113, 113, 2, 0, 82, 82, 114, 114, 2, 0, 83,
+		83, 115, 115, 2, 0, 84, 84, 116, 116, 2, 0, 85, 85, 117, 117, 2, 0, 86,
+		86, 118, 118, 2, 0, 87, 87, 119, 119, 2, 0, 88, 88, 120, 120, 2, 0, 89,
+		89, 121, 121, 2, 0, 90, 90, 122, 122, 13, 0, 65, 90, 97, 122, 192, 214,
+		216, 246, 248, 767, 880, 893, 895, 8191, 8204, 8205, 8304, 8591, 11264,
+		12271, 12289, 55295, 63744, 64975, 65008, 65533, 5, 0, 48, 57, 95, 95,
+		183, 183, 768, 879, 8255, 8256, 4, 0, 68, 68, 77, 77, 87, 87, 89, 89, 3,
+		0, 72, 72, 77, 77, 83, 83, 2, 0, 34, 34, 92, 92, 2, 0, 39, 39, 92, 92,
+		1, 0, 49, 57, 5, 0, 100, 100, 104, 104, 109, 109, 115, 115, 119, 119, 1,
+		0, 48, 57, 1, 0, 48, 55, 3, 0, 48, 57, 65, 70, 97, 102, 3, 0, 9, 10, 13,
+		13, 32, 32, 2, 0, 10, 10, 13, 13, 843, 0, 1, 1, 0, 0, 0, 0, 59, 1, 0, 0,
+		0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 67, 1, 0,
+		0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0, 0, 75, 1,
+		0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0, 0, 0, 83,
+		1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0, 0, 0, 0,
+		91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1, 0, 0, 0,
+		0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0, 105, 1, 0,
+		0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111, 1, 0, 0, 0, 0, 113,
+		1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0, 0, 119, 1, 0, 0, 0,
+		0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0, 125, 1, 0, 0, 0, 0, 127, 1,
+		0, 0, 0, 0, 129, 1, 0, 0, 0, 0, 131, 1, 0, 0, 0, 0, 133, 1, 0, 0, 0, 0,
+		135, 1, 0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139, 1, 0, 0, 0, 0, 141, 1, 0,
+		0, 0, 0, 143, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0, 0, 147, 1, 0, 0, 0, 0, 149,
+		1, 0, 0, 0, 0, 151, 1, 0, 0, 0, 0, 153, 1, 0, 0, 0, 0, 155, 1, 0, 0, 0,
+		0, 157, 1, 0, 0, 0, 0, 159, 1, 0, 0, 0, 0, 161, 1, 0, 0, 0, 0, 163, 1,
+		0, 0, 0, 0, 165, 1, 0, 0, 0, 0, 167, 1, 0, 0, 0, 0, 169, 1, 0, 0, 0, 0,
+		171, 1, 0, 0, 0, 0, 173, 1, 0, 0, 0, 0, 175, 1, 0, 0, 0, 0, 177, 1, 0,
+		0, 0, 0, 179, 1, 0, 0, 0, 0, 181, 1, 0, 0, 0, 0, 183, 1, 0, 0, 0, 0, 185,
+		1, 0, 0, 0, 0, 187, 1, 0, 0, 0, 0, 189, 1, 0, 0, 0, 0, 191, 1, 0, 0, 0,
+		0, 193, 1, 0, 0, 0, 0, 195, 1, 0, 0, 0, 0, 197, 1, 0, 0, 0, 0, 199, 1,
+		0, 0, 0, 0, 201, 1, 0, 0, 0, 0, 203, 1, 0, 0, 0, 0, 207, 1, 0, 0, 0, 0,
+		209, 1, 0, 0, 0, 0, 211, 1, 0, 0, 0, 0, 213, 1, 0, 0, 0, 0, 215, 1, 0,
+		0, 0, 0, 217, 1, 0, 0, 0, 0, 235, 1, 0, 0, 0, 0, 237, 1, 0, 0, 0, 0, 239,
+		1, 0, 0, 0, 1, 241, 1, 0, 0, 0, 3, 243, 1, 0, 0, 0, 5, 245, 1, 0, 0, 0,
+		7, 247, 1, 0, 0, 0, 9, 249, 1, 0, 0, 0, 11, 251, 1, 0, 0, 0, 13, 253, 1,
+		0, 0, 0, 15, 255, 1, 0, 0, 0, 17, 257, 1, 0, 0, 0, 19, 259, 1, 0, 0, 0,
+		21, 261, 1, 0, 0, 0, 23, 263, 1, 0, 0, 0, 25, 265, 1, 0, 0, 0, 27, 267,
+		1, 0, 0, 0, 29, 269, 1, 0, 0, 0, 31, 271, 1, 0, 0, 0, 33, 273, 1, 0, 0,
+		0, 35, 275, 1, 0, 0, 0, 37, 277, 1, 0, 0, 0, 39, 279, 1, 0, 0, 0, 41, 281,
+		1, 0, 0, 0, 43, 283, 1, 0, 0, 0, 45, 285, 1, 0, 0, 0, 47, 287, 1, 0, 0,
+		0, 49, 289, 1, 0, 0, 0, 51, 291, 1, 0, 0, 0, 53, 293, 1, 0, 0, 0, 55, 295,
+		1, 0, 0, 0, 57, 299, 1, 0, 0, 0, 59, 301, 1, 0, 0, 0, 61, 303, 1, 0, 0,
+		0, 63, 305, 1, 0, 0, 0, 65, 307, 1, 0, 0, 0, 67, 310, 1, 0, 0, 0, 69, 313,
+		1, 0, 0, 0, 71, 315, 1, 0, 0, 0, 73, 317, 1, 0, 0, 0, 75, 319, 1, 0, 0,
+		0, 77, 321, 1, 0, 0, 0, 79, 323, 1, 0, 0, 0, 81, 325, 1, 0, 0, 0, 83, 328,
+		1, 0, 0, 0, 85, 331, 1, 0, 0, 0, 87, 333, 1, 0, 0, 0, 89, 335, 1, 0, 0,
+		0, 91, 337, 1, 0, 0, 0, 93, 339, 1, 0, 0, 0, 95, 341, 1, 0, 0, 0, 97, 343,
+		1, 0, 0, 0, 99, 345, 1, 0, 0, 0, 101, 350, 1, 0, 0, 0, 103, 355, 1, 0,
+		0, 0, 105, 360, 1, 0, 0, 0, 107, 363, 1, 0, 0, 0, 109, 368, 1, 0, 0, 0,
+		111, 372, 1, 0, 0, 0, 113, 375, 1, 0, 0, 0, 115, 379, 1, 0, 0, 0, 117,
+		383, 1, 0, 0, 0, 119, 391, 1, 0, 0, 0, 121, 399, 1, 0, 0, 0, 123, 403,
+		1, 0, 0, 0, 125, 412, 1, 0, 0, 0, 127, 419, 1, 0, 0, 0, 129, 425, 1, 0,
+		0, 0, 131, 432, 1, 0, 0, 0, 133, 440, 1, 0, 0, 0, 135, 448, 1, 0, 0, 0,
+		137, 455, 1, 0, 0, 0, 139, 458, 1, 0, 0, 0, 141, 461, 1, 0, 0, 0, 143,
+		466, 1, 0, 0, 0, 145, 472, 1, 0, 0, 0, 147, 476, 1, 0, 0, 0, 149, 478,
+		1, 0, 0, 0, 151, 487, 1, 0, 0, 0, 153, 500, 1, 0, 0, 0, 155, 517, 1, 0,
+		0, 0, 157, 525, 1, 0, 0, 0, 159, 540, 1, 0, 0, 0, 161, 555, 1, 0, 0, 0,
+		163, 568, 1, 0, 0, 0, 165, 576, 1, 0, 0, 0, 167, 579, 1, 0, 0, 0, 169,
+		581, 1, 0, 0, 0, 171, 584, 1, 0, 0, 0, 173, 587, 1, 0, 0, 0, 175, 590,
+		1, 0, 0, 0, 177, 593, 1, 0, 0, 0, 179, 595, 1, 0, 0, 0, 181, 597, 1, 0,
+		0, 0, 183, 600, 1, 0, 0, 0, 185, 603, 1, 0, 0, 0, 187, 606, 1, 0, 0, 0,
+		189, 608, 1, 0, 0, 0, 191, 638, 1, 0, 0, 0, 193, 640, 1, 0, 0, 0, 195,
+		647, 1, 0, 0, 0, 197, 660, 1, 0, 0, 0, 199, 687, 1, 0, 0, 0, 201, 689,
+		1, 0, 0, 0, 203, 696, 1, 0, 0, 0, 205, 710, 1, 0, 0, 0, 207, 712, 1, 0,
+		0, 0, 209, 724, 1, 0, 0, 0, 211, 726, 1, 0, 0, 0, 213, 740, 1, 0, 0, 0,
+		215, 744, 1, 0, 0, 0, 217, 769, 1, 0, 0, 0, 219, 773, 1, 0, 0, 0, 221,
+		778, 1, 0, 0, 0, 223, 783, 1, 0, 0, 0, 225, 787, 1, 0, 0, 0, 227, 789,
+		1, 0, 0, 0, 229, 801, 1, 0, 0, 0, 231, 803, 1, 0, 0, 0, 233, 805, 1, 0,
+		0, 0, 235, 808, 1, 0, 0, 0, 237, 814, 1, 0, 0, 0, 239, 828, 1, 0, 0, 0,
+		241, 242, 5, 44, 0, 0, 242, 2, 1, 0, 0, 0, 243, 244, 7, 0, 0, 0, 244, 4,
+		1, 0, 0, 0, 245, 246, 7, 1, 0, 0, 246, 6, 1, 0, 0, 0, 247, 248, 7, 2, 0,
+		0, 248, 8, 1, 0, 0, 0, 249, 250, 7, 3, 0, 0, 250, 10, 1, 0, 0, 0, 251,
+		252, 7, 4, 0, 0, 252, 12, 1, 0, 0, 0, 253, 254, 7, 5, 0, 0, 254, 14, 1,
+		0, 0, 0, 255, 256, 7, 6, 0, 0, 256, 16, 1, 0, 0, 0, 257, 258, 7, 7, 0,
+		0, 258, 18, 1, 0, 0, 0, 259, 260, 7, 8, 0, 0, 260, 20, 1, 0, 0, 0, 261,
+		262, 7, 9, 0, 0, 262, 22, 1, 0, 0, 0, 263, 264, 7, 10, 0, 0, 264, 24, 1,
+		0, 0, 0, 265, 266, 7, 11, 0, 0, 266, 26, 1, 0, 0, 0, 267, 268, 7, 12, 0,
+		0, 268, 28, 1, 0, 0, 0, 269, 270, 7, 13, 0, 0, 270, 30, 1, 0, 0, 0, 271,
+		272, 7, 14, 0, 0, 272, 32, 1, 0, 0, 0, 273, 274, 7, 15, 0, 0, 274, 34,
+		1, 0, 0, 0, 275, 276, 7, 16, 0, 0, 276, 36, 1, 0, 0, 0, 277, 278, 7, 17,
+		0, 0, 278, 38, 1, 0, 0, 0, 279, 280, 7, 18, 0, 0, 280, 40, 1, 0, 0, 0,
+		281, 282, 7, 19, 0, 0, 282, 42, 1, 0, 0, 0, 283, 284, 7, 20, 0, 0, 284,
+		44, 1, 0, 0, 0, 285, 286, 7, 21, 0, 0, 286, 46, 1, 0, 0, 0, 287, 288, 7,
+		22, 0, 0, 288, 48, 1, 0, 0, 0, 289, 290, 7, 23, 0, 0, 290, 50, 1, 0, 0,
+		0, 291, 292, 7, 24, 0, 0, 292, 52, 1, 0, 0, 0, 293, 294, 7, 25, 0, 0, 294,
+		54, 1, 0, 0, 0, 295, 296, 7, 26, 0, 0, 296, 56, 1, 0, 0, 0, 297, 300, 3,
+		55, 27, 0, 298, 300, 7, 27, 0, 0, 299, 297, 1, 0, 0, 0, 299, 298, 1, 0,
+		0, 0, 300, 58, 1, 0, 0, 0, 301, 302, 5, 43, 0, 0, 302, 60, 1, 0, 0, 0,
+		303, 304, 5, 45, 0, 0, 304, 62, 1, 0, 0, 0, 305, 306, 5, 47, 0, 0, 306,
+		64, 1, 0, 0, 0, 307, 308, 5, 42, 0, 0, 308, 309, 5, 42, 0, 0, 309, 66,
+		1, 0, 0, 0, 310, 311, 5, 126, 0, 0, 311, 312, 5, 47, 0, 0, 312, 68, 1,
+		0, 0, 0, 313, 314, 5, 42, 0, 0, 314, 70, 1, 0, 0, 0, 315, 316, 5, 37, 0,
+		0, 316, 72, 1, 0, 0, 0, 317, 318, 5, 46, 0, 0, 318, 74, 1, 0, 0, 0, 319,
+		320, 5, 59, 0, 0, 320, 76, 1, 0, 0, 0, 321, 322, 5, 58, 0, 0, 322, 78,
+		1, 0, 0, 0, 323, 324, 5, 63, 0, 0, 324, 80, 1, 0, 0, 0, 325, 326, 5, 63,
+		0, 0, 326, 327, 5, 46, 0, 0, 327, 82, 1, 0, 0, 0, 328, 329, 5, 63, 0, 0,
+		329, 330, 5, 63, 0, 0, 330, 84, 1, 0, 0, 0, 331, 332, 5, 64, 0, 0, 332,
+		86, 1, 0, 0, 0, 333, 334, 5, 123, 0, 0, 334, 88, 1, 0, 0, 0, 335, 336,
+		5, 125, 0, 0, 336, 90, 1, 0, 0, 0, 337, 338, 5, 40, 0, 0, 338, 92, 1, 0,
+		0, 0, 339, 340, 5, 41, 0, 0, 340, 94, 1, 0, 0, 0, 341, 342, 5, 91, 0, 0,
+		342, 96, 1, 0, 0, 0, 343, 344, 5, 93, 0, 0, 344, 98, 1, 0, 0, 0, 345, 346,
+		3, 37, 18, 0, 346, 347, 3, 43, 21, 0, 347, 348, 3, 25, 12, 0, 348, 349,
+		3, 11, 5, 0, 349, 100, 1, 0, 0, 0, 350, 351, 3, 47, 23, 0, 351, 352, 3,
+		17, 8, 0, 352, 353, 3, 11, 5, 0, 353, 354, 3, 29, 14, 0, 354, 102, 1, 0,
+		0, 0, 355, 356, 3, 41, 20, 0, 356, 357, 3, 17, 8, 0, 357, 358, 3, 11, 5,
+		0, 358, 359, 3, 29, 14, 0, 359, 104, 1, 0, 0, 0, 360, 361, 3, 19, 9, 0,
+		361, 362, 3, 13, 6, 0, 362, 106, 1, 0, 0, 0, 363, 364, 3, 11, 5, 0, 364,
+		365, 3, 25, 12, 0, 365, 366, 3, 39, 19, 0, 366, 367, 3, 11, 5, 0, 367,
+		108, 1, 0, 0, 0, 368, 369, 3, 25, 12, 0, 369, 370, 3, 11, 5, 0, 370, 371,
+		3, 41, 20, 0, 371, 110, 1, 0, 0, 0, 372, 373, 3, 19, 9, 0, 373, 374, 3,
+		29, 14, 0, 374, 112, 1, 0, 0, 0, 375, 376, 3, 13, 6, 0, 376, 377, 3, 31,
+		15, 0, 377, 378, 3, 37, 18, 0, 378, 114, 1, 0, 0, 0, 379, 380, 3, 29, 14,
+		0, 380, 381, 3, 31, 15, 0, 381, 382, 3, 41, 20, 0, 382, 116, 1, 0, 0, 0,
+		383, 384, 3, 27, 13, 0, 384, 385, 3, 3, 1, 0, 385, 386, 3, 41, 20, 0, 386,
+		387, 3, 7, 3, 0, 387, 388, 3, 17, 8, 0, 388, 389, 3, 11, 5, 0, 389, 390,
+		3, 39, 19, 0, 390, 118, 1, 0, 0, 0, 391, 392, 3, 5, 2, 0, 392, 393, 3,
+		11, 5, 0, 393, 394, 3, 41, 20, 0, 394, 395, 3, 47, 23, 0, 395, 396, 3,
+		11, 5, 0, 396, 397, 3, 11, 5, 0, 397, 398, 3, 29, 14, 0, 398, 120, 1, 0,
+		0, 0, 399, 400, 3, 3, 1, 0, 400, 401, 3, 29, 14, 0, 401, 402, 3, 9, 4,
+		0, 402, 122, 1, 0, 0, 0, 403, 404, 3, 13, 6, 0, 404, 405, 3, 43, 21, 0,
+		405, 406, 3, 29, 14, 0, 406, 407, 3, 7, 3, 0, 407, 408, 3, 41, 20, 0, 408,
+		409, 3, 19, 9, 0, 409, 410, 3, 31, 15, 0, 410, 411, 3, 29, 14, 0, 411,
+		124, 1, 0, 0, 0, 412, 413, 3, 37, 18, 0, 413, 414, 3, 11, 5, 0, 414, 415,
+		3, 41, 20, 0, 415, 416, 3, 43, 21, 0, 416, 417, 3, 37, 18, 0, 417, 418,
+		3, 29, 14, 0, 418, 126, 1, 0, 0, 0, 419, 420, 3, 7, 3, 0, 420, 421, 3,
+		31, 15, 0, 421, 422, 3, 29, 14, 0, 422, 423, 3, 39, 19, 0, 423, 424, 3,
+		41, 20, 0, 424, 128, 1, 0, 0, 0, 425, 426, 3, 15, 7, 0, 426, 427, 3, 25,
+		12, 0, 427, 428, 3, 31, 15, 0, 428, 429, 3, 5, 2, 0, 429, 430, 3, 3, 1,
+		0, 430, 431, 3, 25, 12, 0, 431, 130, 1, 0, 0, 0, 432, 433, 3, 11, 5, 0,
+		433, 434, 3, 49, 24, 0, 434, 435, 3, 41, 20, 0, 435, 436, 3, 11, 5, 0,
+		436, 437, 3, 29, 14, 0, 437, 438, 3, 9, 4, 0, 438, 439, 3, 39, 19, 0, 439,
+		132, 1, 0, 0, 0, 440, 441, 3, 33, 16, 0, 441, 442, 3, 3, 1, 0, 442, 443,
+		3, 7, 3, 0, 443, 444, 3, 23, 11, 0, 444, 445, 3, 3, 1, 0, 445, 446, 3,
+		15, 7, 0, 446, 447, 3, 11, 5, 0, 447, 134, 1, 0, 0, 0, 448, 449, 3, 19,
+		9, 0, 449, 450, 3, 27, 13, 0, 450, 451, 3, 33, 16, 0, 451, 452, 3, 31,
+		15, 0, 452, 453, 3, 37, 18, 0, 453, 454, 3, 41, 20, 0, 454, 136, 1, 0,
+		0, 0, 455, 456, 5, 38, 0, 0, 456, 457, 5, 38, 0, 0, 457, 138, 1, 0, 0,
+		0, 458, 459, 5, 124, 0, 0, 459, 460, 5, 124, 0, 0, 460, 140, 1, 0, 0, 0,
+		461, 462, 3, 41, 20, 0, 462, 463, 3, 37, 18, 0, 463, 464, 3, 43, 21, 0,
+		464, 465, 3, 11, 5, 0, 465, 142, 1, 0, 0, 0, 466, 467, 3, 13, 6, 0, 467,
+		468, 3, 3, 1, 0, 468, 469, 3, 25, 12, 0, 469, 470, 3, 39, 19, 0, 470, 471,
+		3, 11, 5, 0, 471, 144, 1, 0, 0, 0, 472, 473, 3, 29, 14, 0, 473, 474, 3,
+		19, 9, 0, 474, 475, 3, 25, 12, 0, 475, 146, 1, 0, 0, 0, 476, 477, 5, 33,
+		0, 0, 477, 148, 1, 0, 0, 0, 478, 479, 3, 39, 19, 0, 479, 480, 3, 3, 1,
+		0, 480, 481, 3, 25, 12, 0, 481, 482, 3, 19, 9, 0, 482, 483, 3, 11, 5, 0,
+		483, 484, 3, 29, 14, 0, 484, 485, 3, 7, 3, 0, 485, 486, 3, 11, 5, 0, 486,
+		150, 1, 0, 0, 0, 487, 488, 3, 3, 1, 0, 488, 489, 3, 15, 7, 0, 489, 490,
+		3, 11, 5, 0, 490, 491, 3, 29, 14, 0, 491, 492, 3, 9, 4, 0, 492, 493, 3,
+		3, 1, 0, 493, 494, 5, 45, 0, 0, 494, 495, 3, 15, 7, 0, 495, 496, 3, 37,
+		18, 0, 496, 497, 3, 31, 15, 0, 497, 498, 3, 43, 21, 0, 498, 499, 3, 33,
+		16, 0, 499, 152, 1, 0, 0, 0, 500, 501, 3, 3, 1, 0, 501, 502, 3, 7, 3, 0,
+		502, 503, 3, 41, 20, 0, 503, 504, 3, 19, 9, 0, 504, 505, 3, 45, 22, 0,
+		505, 506, 3, 3, 1, 0, 506, 507, 3, 41, 20, 0, 507, 508, 3, 19, 9, 0, 508,
+		509, 3, 31, 15, 0, 509, 510, 3, 29, 14, 0, 510, 511, 5, 45, 0, 0, 511,
+		512, 3, 15, 7, 0, 512, 513, 3, 37, 18, 0, 513, 514, 3, 31, 15, 0, 514,
+		515, 3, 43, 21, 0, 515, 516, 3, 33, 16, 0, 516, 154, 1, 0, 0, 0, 517, 518,
+		3, 29, 14, 0, 518, 519, 3, 31, 15, 0, 519, 520, 5, 45, 0, 0, 520, 521,
+		3, 25, 12, 0, 521, 522, 3, 31, 15, 0, 522, 523, 3, 31, 15, 0, 523, 524,
+		3, 33, 16, 0, 524, 156, 1, 0, 0, 0, 525, 526, 3, 25, 12, 0, 526, 527, 3,
+		31, 15, 0, 527, 528, 3, 7, 3, 0, 528, 529, 3, 23, 11, 0, 529, 530, 5, 45,
+		0, 0, 530, 531, 3, 31, 15, 0, 531, 532, 3, 29, 14, 0, 532, 533, 5, 45,
+		0, 0, 533, 534, 3, 3, 1, 0, 534, 535, 3, 7, 3, 0, 535, 536, 3, 41, 20,
+		0, 536, 537, 3, 19, 9, 0, 537, 538, 3, 45, 22, 0, 538, 539, 3, 11, 5, 0,
+		539, 158, 1, 0, 0, 0, 540, 541, 3, 9, 4, 0, 541, 542, 3, 3, 1, 0, 542,
+		543, 3, 41, 20, 0, 543, 544, 3, 11, 5, 0, 544, 545, 5, 45, 0, 0, 545, 546,
+		3, 11, 5, 0, 546, 547, 3, 13, 6, 0, 547, 548, 3, 13, 6, 0, 548, 549, 3,
+		11, 5, 0, 549, 550, 3, 7, 3, 0, 550, 551, 3, 41, 20, 0, 551, 552, 3, 19,
+		9, 0, 552, 553, 3, 45, 22, 0, 553, 554, 3, 11, 5, 0, 554, 160, 1, 0, 0,
+		0, 555, 556, 3, 9, 4, 0, 556, 557, 3, 3, 1, 0, 557, 558, 3, 41, 20, 0,
+		558, 559, 3, 11, 5, 0, 559, 560, 5, 45, 0, 0, 560, 561, 3, 11, 5, 0, 561,
+		562, 3, 49, 24, 0, 562, 563, 3, 33, 16, 0, 563, 564, 3, 19, 9, 0, 564,
+		565, 3, 37, 18, 0, 565, 566, 3, 11, 5, 0, 566, 567, 3, 39, 19, 0, 567,
+		162, 1, 0, 0, 0, 568, 569, 3, 11, 5, 0, 569, 570, 3, 29, 14, 0, 570, 571,
+		3, 3, 1, 0, 571, 572, 3, 5, 2, 0, 572, 573, 3, 25, 12, 0, 573, 574, 3,
+		11, 5, 0, 574, 575, 3, 9, 4, 0, 575, 164, 1, 0, 0, 0, 576, 577, 5, 61,
+		0, 0, 577, 578, 5, 61, 0, 0, 578, 166, 1, 0, 0, 0, 579, 580, 5, 61, 0,
+		0, 580, 168, 1, 0, 0, 0, 581, 582, 5, 43, 0, 0, 582, 583, 5, 61, 0, 0,
+		583, 170, 1, 0, 0, 0, 584, 585, 5, 45, 0, 0, 585, 586, 5, 61, 0, 0, 586,
+		172, 1, 0, 0, 0, 587, 588, 5, 47, 0, 0, 588, 589, 5, 61, 0, 0, 589, 174,
+		1, 0, 0, 0, 590, 591, 5, 42, 0, 0, 591, 592, 5, 61, 0, 0, 592, 176, 1,
+		0, 0, 0, 593, 594, 5, 62, 0, 0, 594, 178, 1, 0, 0, 0, 595, 596, 5, 60,
+		0, 0, 596, 180, 1, 0, 0, 0, 597, 598, 5, 62, 0, 0, 598, 599, 5, 61, 0,
+		0, 599, 182, 1, 0, 0, 0, 600, 601, 5, 60, 0, 0, 601, 602, 5, 61, 0, 0,
+		602, 184, 1, 0, 0, 0, 603, 604, 5, 33, 0, 0, 604, 605, 5, 61, 0, 0, 605,
+		186, 1, 0, 0, 0, 606, 607, 5, 38, 0, 0, 607, 188, 1, 0, 0, 0, 608, 609,
+		5, 124, 0, 0, 609, 190, 1, 0, 0, 0, 610, 614, 5, 80, 0, 0, 611, 612, 3,
+		221, 110, 0, 612, 613, 7, 28, 0, 0, 613, 615, 1, 0, 0, 0, 614, 611, 1,
+		0, 0, 0, 615, 616, 1, 0, 0, 0, 616, 614, 1, 0, 0, 0, 616, 617, 1, 0, 0,
+		0, 617, 626, 1, 0, 0, 0, 618, 622, 5, 84, 0, 0, 619, 620, 3, 221, 110,
+		0, 620, 621, 7, 29, 0, 0, 621, 623, 1, 0, 0, 0, 622, 619, 1, 0, 0, 0, 623,
+		624, 1, 0, 0, 0, 624, 622, 1, 0, 0, 0, 624, 625, 1, 0, 0, 0, 625, 627,
+		1, 0, 0, 0, 626, 618, 1, 0, 0, 0, 626, 627, 1, 0, 0, 0, 627, 639, 1, 0,
+		0, 0, 628, 629, 5, 80, 0, 0, 629, 630, 5, 84, 0, 0, 630, 634, 1, 0, 0,
+		0, 631, 632, 3, 221, 110, 0, 632, 633, 7, 29, 0, 0, 633, 635, 1, 0, 0,
+		0, 634, 631, 1, 0, 0, 0, 635, 636, 1, 0, 0, 0, 636, 634, 1, 0, 0, 0, 636,
+		637, 1, 0, 0, 0, 637, 639, 1, 0, 0, 0, 638, 610, 1, 0, 0, 0, 638, 628,
+		1, 0, 0, 0, 639, 192, 1, 0, 0, 0, 640, 644, 3, 55, 27, 0, 641, 643, 3,
+		57, 28, 0, 642, 641, 1, 0, 0, 0, 643, 646, 1, 0, 0, 0, 644, 642, 1, 0,
+		0, 0, 644, 645, 1, 0, 0, 0, 645, 194, 1, 0, 0, 0, 646, 644, 1, 0, 0, 0,
+		647, 655, 5, 34, 0, 0, 648, 649, 5, 92, 0, 0, 649, 654, 9, 0, 0, 0, 650,
+		651, 5, 34, 0, 0, 651, 654, 5, 34, 0, 0, 652, 654, 8, 30, 0, 0, 653, 648,
+		1, 0, 0, 0, 653, 650, 1, 0, 0, 0, 653, 652, 1, 0, 0, 0, 654, 657, 1, 0,
+		0, 0, 655, 653, 1, 0, 0, 0, 655, 656, 1, 0, 0, 0, 656, 658, 1, 0, 0, 0,
+		657, 655, 1, 0, 0, 0, 658, 659, 5, 34, 0, 0, 659, 196, 1, 0, 0, 0, 660,
+		668, 5, 39, 0, 0, 661, 662, 5, 92, 0, 0, 662, 667, 9, 0, 0, 0, 663, 664,
+		5, 39, 0, 0, 664, 667, 5, 39, 0, 0, 665, 667, 8, 31, 0, 0, 666, 661, 1,
+		0, 0, 0, 666, 663, 1, 0, 0, 0, 666, 665, 1, 0, 0, 0, 667, 670, 1, 0, 0,
+		0, 668, 666, 1, 0, 0, 0, 668, 669, 1, 0, 0, 0, 669, 671, 1, 0, 0, 0, 670,
+		668, 1, 0, 0, 0, 671, 672, 5, 39, 0, 0, 672, 198, 1, 0, 0, 0, 673, 674,
+		3, 209, 104, 0, 674, 675, 3, 73, 36, 0, 675, 677, 3, 221, 110, 0, 676,
+		678, 3, 201, 100, 0, 677, 676, 1, 0, 0, 0, 677, 678, 1, 0, 0, 0, 678, 688,
+		1, 0, 0, 0, 679, 680, 3, 209, 104, 0, 680, 681, 3, 201, 100, 0, 681, 688,
+		1, 0, 0, 0, 682, 683, 3, 73, 36, 0, 683, 685, 3, 221, 110, 0, 684, 686,
+		3, 201, 100, 0, 685, 684, 1, 0, 0, 0, 685, 686, 1, 0, 0, 0, 686, 688, 1,
+		0, 0, 0, 687, 673, 1, 0, 0, 0, 687, 679, 1, 0, 0, 0, 687, 682, 1, 0, 0,
+		0, 688, 200, 1, 0, 0, 0, 689, 692, 3, 11, 5, 0, 690, 693, 3, 59, 29, 0,
+		691, 693, 3, 61, 30, 0, 692, 690, 1, 0, 0, 0, 692, 691, 1, 0, 0, 0, 692,
+		693, 1, 0, 0, 0, 693, 694, 1, 0, 0, 0, 694, 695, 3, 221, 110, 0, 695, 202,
+		1, 0, 0, 0, 696, 697, 5, 48, 0, 0, 697, 698, 3, 49, 24, 0, 698, 699, 3,
+		205, 102, 0, 699, 700, 3, 207, 103, 0, 700, 204, 1, 0, 0, 0, 701, 702,
+		3, 219, 109, 0, 702, 704, 3, 73, 36, 0, 703, 705, 3, 219, 109, 0, 704,
+		703, 1, 0, 0, 0, 704, 705, 1, 0, 0, 0, 705, 711, 1, 0, 0, 0, 706, 711,
+		3, 219, 109, 0, 707, 708, 3, 73, 36, 0, 708, 709, 3, 219, 109, 0, 709,
+		711, 1, 0, 0, 0, 710, 701, 1, 0, 0, 0, 710, 706, 1, 0, 0, 0, 710, 707,
+		1, 0, 0, 0, 711, 206, 1, 0, 0, 0, 712, 715, 3, 33, 16, 0, 713, 716, 3,
+		59, 29, 0, 714, 716, 3, 61, 30, 0, 715, 713, 1, 0, 0, 0, 715, 714, 1, 0,
+		0, 0, 715, 716, 1, 0, 0, 0, 716, 717, 1, 0, 0, 0, 717, 718, 3, 221, 110,
+		0, 718, 208, 1, 0, 0, 0, 719, 725, 5, 48, 0, 0, 720, 722, 7, 32, 0, 0,
+		721, 723, 3, 221, 110, 0, 722, 721, 1, 0, 0, 0, 722, 723, 1, 0, 0, 0, 723,
+		725, 1, 0, 0, 0, 724, 719, 1, 0, 0, 0, 724, 720, 1, 0, 0, 0, 725, 210,
+		1, 0, 0, 0, 726, 727, 5, 48, 0, 0, 727, 728, 3, 49, 24, 0, 728, 729, 3,
+		219, 109, 0, 729, 212, 1, 0, 0, 0, 730, 738, 3, 221, 110, 0, 731, 732,
+		5, 110, 0, 0, 732, 739, 5, 115, 0, 0, 733, 734, 5, 117, 0, 0, 734, 739,
+		5, 115, 0, 0, 735, 736, 5, 109, 0, 0, 736, 739, 5, 115, 0, 0, 737, 739,
+		7, 33, 0, 0, 738, 731, 1, 0, 0, 0, 738, 733, 1, 0, 0, 0, 738, 735, 1, 0,
+		0, 0, 738, 737, 1, 0, 0, 0, 739, 741, 1, 0, 0, 0, 740, 730, 1, 0, 0, 0,
+		741, 742, 1, 0, 0, 0, 742, 740, 1, 0, 0, 0, 742, 743, 1, 0, 0, 0, 743,
+		214, 1, 0, 0, 0, 744, 745, 5, 64, 0, 0, 745, 746, 3, 227, 113, 0, 746,
+		747, 3, 227, 113, 0, 747, 748, 5, 45, 0, 0, 748, 749, 3, 227, 113, 0, 749,
+		750, 5, 45, 0, 0, 750, 767, 3, 227, 113, 0, 751, 752, 5, 84, 0, 0, 752,
+		753, 3, 227, 113, 0, 753, 754, 5, 58, 0, 0, 754, 762, 3, 227, 113, 0, 755,
+		756, 5, 58, 0, 0, 756, 760, 3, 227, 113, 0, 757, 758, 3, 73, 36, 0, 758,
+		759, 3, 221, 110, 0, 759, 761, 1, 0, 0, 0, 760, 757, 1, 0, 0, 0, 760, 761,
+		1, 0, 0, 0, 761, 763, 1, 0, 0, 0, 762, 755, 1, 0, 0, 0, 762, 763, 1, 0,
+		0, 0, 763, 765, 1, 0, 0, 0, 764, 766, 3, 229, 114, 0, 765, 764, 1, 0, 0,
+		0, 765, 766, 1, 0, 0, 0, 766, 768, 1, 0, 0, 0, 767, 751, 1, 0, 0, 0, 767,
+		768, 1, 0, 0, 0, 768, 216, 1, 0, 0, 0, 769, 770, 5, 48, 0, 0, 770, 771,
+		3, 223, 111, 0, 771, 218, 1, 0, 0, 0, 772, 774, 3, 233, 116, 0, 773, 772,
+		1, 0, 0, 0, 774, 775, 1, 0, 0, 0, 775, 773, 1, 0, 0, 0, 775, 776, 1, 0,
+		0, 0, 776, 220, 1, 0, 0, 0, 777, 779, 3, 225, 112, 0, 778, 777, 1, 0, 0,
+		0, 779, 780, 1, 0, 0, 0, 780, 778, 1, 0, 0, 0, 780, 781, 1, 0, 0, 0, 781,
+		222, 1, 0, 0, 0, 782, 784, 3, 231, 115, 0, 783, 782, 1, 0, 0, 0, 784, 785,
+		1, 0, 0, 0, 785, 783, 1, 0, 0, 0, 785, 786, 1, 0, 0, 0, 786, 224, 1, 0,
+		0, 0, 787, 788, 7, 34, 0, 0, 788, 226, 1, 0, 0, 0, 789, 790, 3, 225, 112,
+		0, 790, 791, 3, 225, 112, 0, 791, 228, 1, 0, 0, 0, 792, 802, 5, 90, 0,
+		0, 793, 796, 3, 59, 29, 0, 794, 796, 3, 61, 30, 0, 795, 793, 1, 0, 0, 0,
+		795, 794, 1, 0, 0, 0, 796, 797, 1, 0, 0, 0, 797, 798, 3, 227, 113, 0, 798,
+		799, 5, 58, 0, 0, 799, 800, 3, 227, 113, 0, 800, 802, 1, 0, 0, 0, 801,
+		792, 1, 0, 0, 0, 801, 795, 1, 0, 0, 0, 802, 230, 1, 0, 0, 0, 803, 804,
+		7, 35, 0, 0, 804, 232, 1, 0, 0, 0, 805, 806, 7, 36, 0, 0, 806, 234, 1,
+		0, 0, 0, 807, 809, 7, 37, 0, 0, 808, 807, 1, 0, 0, 0, 809, 810, 1, 0, 0,
+		0, 810, 808, 1, 0, 0, 0, 810, 811, 1, 0, 0, 0, 811, 812, 1, 0, 0, 0, 812,
+		813, 6, 117, 0, 0, 813, 236, 1, 0, 0, 0, 814, 815, 5, 47, 0, 0, 815, 816,
+		5, 42, 0, 0, 816, 820, 1, 0, 0, 0, 817, 819, 9, 0, 0, 0, 818, 817, 1, 0,
+		0, 0, 819, 822, 1, 0, 0, 0, 820, 821, 1, 0, 0, 0, 820, 818, 1, 0, 0, 0,
+		821, 823, 1, 0, 0, 0, 822, 820, 1, 0, 0, 0, 823, 824, 5, 42, 0, 0, 824,
+		825, 5, 47, 0, 0, 825, 826, 1, 0, 0, 0, 826, 827, 6, 118, 0, 0, 827, 238,
+		1, 0, 0, 0, 828, 829, 5, 47, 0, 0, 829, 830, 5, 47, 0, 0, 830, 834, 1,
+		0, 0, 0, 831, 833, 8, 38, 0, 0, 832, 831, 1, 0, 0, 0, 833, 836, 1, 0, 0,
+		0, 834, 832, 1, 0, 0, 0, 834, 835, 1, 0, 0, 0, 835, 837, 1, 0, 0, 0, 836,
+		834, 1, 0, 0, 0, 837, 838, 6, 119, 0, 0, 838, 240, 1, 0, 0, 0, 35, 0, 299,
+		616, 624, 626, 636, 638, 644, 653, 655, 666, 668, 677, 685, 687, 692, 704,
+		710, 715, 722, 724, 738, 742, 760, 762, 765, 767, 775, 780, 785, 795, 801,
+		810, 820, 834, 1, 6, 0, 0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -335,50 +509,83 @@ const (
 	grulev3LexerPLUS              = 2
 	grulev3LexerMINUS             = 3
 	grulev3LexerDIV               = 4
-	grulev3LexerMUL               = 5
-	grulev3LexerMOD               = 6
-	grulev3LexerDOT               = 7
-	grulev3LexerSEMICOLON         = 8
-	grulev3LexerLR_BRACE          = 9
-	grulev3LexerRR_BRACE          = 10
-	grulev3LexerLR_BRACKET        = 11
-	grulev3LexerRR_BRACKET        = 12
-	grulev3LexerLS_BRACKET        = 13
-	grulev3LexerRS_BRACKET        = 14
-	grulev3LexerRULE              = 15
-	grulev3LexerWHEN              = 16
-	grulev3LexerTHEN              = 17
-	grulev3LexerAND               = 18
-	grulev3LexerOR                = 19
-	grulev3LexerTRUE              = 20
-	grulev3LexerFALSE             = 21
-	grulev3LexerNIL_LITERAL       = 22
-	grulev3LexerNEGATION          = 23
-	grulev3LexerSALIENCE          = 24
-	grulev3LexerEQUALS            = 25
-	grulev3LexerASSIGN            = 26
-	grulev3LexerPLUS_ASIGN        = 27
-	grulev3LexerMINUS_ASIGN       = 28
-	grulev3LexerDIV_ASIGN         = 29
-	grulev3LexerMUL_ASIGN         = 30
-	grulev3LexerGT                = 31
-	grulev3LexerLT                = 32
-	grulev3LexerGTE               = 33
-	grulev3LexerLTE               = 34
-	grulev3LexerNOTEQUALS         = 35
-	grulev3LexerBITAND            = 36
-	grulev3LexerBITOR             = 37
-	grulev3LexerSIMPLENAME        = 38
-	grulev3LexerDQUOTA_STRING     = 39
-	grulev3LexerSQUOTA_STRING     = 40
-	grulev3LexerDECIMAL_FLOAT_LIT = 41
-	grulev3LexerDECIMAL_EXPONENT  = 42
-	grulev3LexerHEX_FLOAT_LIT     = 43
-	grulev3LexerHEX_EXPONENT      = 44
-	grulev3LexerDEC_LIT           = 45
-	grulev3LexerHEX_LIT           = 46
-	grulev3LexerOCT_LIT           = 47
-	grulev3LexerSPACE             = 48
-	grulev3LexerCOMMENT           = 49
-	grulev3LexerLINE_COMMENT      = 50
+	grulev3LexerPOW               = 5
+	grulev3LexerINT_DIV           = 6
+	grulev3LexerMUL               = 7
+	grulev3LexerMOD               = 8
+	grulev3LexerDOT               = 9
+	grulev3LexerSEMICOLON         = 10
+	grulev3LexerCOLON             = 11
+	grulev3LexerQUESTION          = 12
+	grulev3LexerSAFE_DOT          = 13
+	grulev3LexerNULL_COALESCE     = 14
+	grulev3LexerAT                = 15
+	grulev3LexerLR_BRACE          = 16
+	grulev3LexerRR_BRACE          = 17
+	grulev3LexerLR_BRACKET        = 18
+	grulev3LexerRR_BRACKET        = 19
+	grulev3LexerLS_BRACKET        = 20
+	grulev3LexerRS_BRACKET        = 21
+	grulev3LexerRULE              = 22
+	grulev3LexerWHEN              = 23
+	grulev3LexerTHEN              = 24
+	grulev3LexerIF                = 25
+	grulev3LexerELSE              = 26
+	grulev3LexerLET               = 27
+	grulev3LexerIN                = 28
+	grulev3LexerFOR               = 29
+	grulev3LexerNOT               = 30
+	grulev3LexerMATCHES           = 31
+	grulev3LexerBETWEEN           = 32
+	grulev3LexerAND_WORD          = 33
+	grulev3LexerFUNCTION          = 34
+	grulev3LexerRETURN            = 35
+	grulev3LexerCONST             = 36
+	grulev3LexerGLOBAL            = 37
+	grulev3LexerEXTENDS           = 38
+	grulev3LexerPACKAGE           = 39
+	grulev3LexerIMPORT            = 40
+	grulev3LexerAND               = 41
+	grulev3LexerOR                = 42
+	grulev3LexerTRUE              = 43
+	grulev3LexerFALSE             = 44
+	grulev3LexerNIL_LITERAL       = 45
+	grulev3LexerNEGATION          = 46
+	grulev3LexerSALIENCE          = 47
+	grulev3LexerAGENDA_GROUP      = 48
+	grulev3LexerACTIVATION_GROUP  = 49
+	grulev3LexerNO_LOOP           = 50
+	grulev3LexerLOCK_ON_ACTIVE    = 51
+	grulev3LexerDATE_EFFECTIVE    = 52
+	grulev3LexerDATE_EXPIRES      = 53
+	grulev3LexerENABLED           = 54
+	grulev3LexerEQUALS            = 55
+	grulev3LexerASSIGN            = 56
+	grulev3LexerPLUS_ASIGN        = 57
+	grulev3LexerMINUS_ASIGN       = 58
+	grulev3LexerDIV_ASIGN         = 59
+	grulev3LexerMUL_ASIGN         = 60
+	grulev3LexerGT                = 61
+	grulev3LexerLT                = 62
+	grulev3LexerGTE               = 63
+	grulev3LexerLTE               = 64
+	grulev3LexerNOTEQUALS         = 65
+	grulev3LexerBITAND            = 66
+	grulev3LexerBITOR             = 67
+	grulev3LexerISO_DURATION_LIT  = 68
+	grulev3LexerSIMPLENAME        = 69
+	grulev3LexerDQUOTA_STRING     = 70
+	grulev3LexerSQUOTA_STRING     = 71
+	grulev3LexerDECIMAL_FLOAT_LIT = 72
+	grulev3LexerDECIMAL_EXPONENT  = 73
+	grulev3LexerHEX_FLOAT_LIT     = 74
+	grulev3LexerHEX_EXPONENT      = 75
+	grulev3LexerDEC_LIT           = 76
+	grulev3LexerHEX_LIT           = 77
+	grulev3LexerDURATION_LIT      = 78
+	grulev3LexerDATE_LIT          = 79
+	grulev3LexerOCT_LIT           = 80
+	grulev3LexerSPACE             = 81
+	grulev3LexerCOMMENT           = 82
+	grulev3LexerLINE_COMMENT      = 83
 )
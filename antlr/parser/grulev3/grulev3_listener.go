@@ -10,12 +10,69 @@ type grulev3Listener interface {
 	// EnterGrl is called when entering the grl production.
 	EnterGrl(c *GrlContext)
 
+	// EnterPackageDeclaration is called when entering the packageDeclaration production.
+	EnterPackageDeclaration(c *PackageDeclarationContext)
+
+	// EnterImportDeclaration is called when entering the importDeclaration production.
+	EnterImportDeclaration(c *ImportDeclarationContext)
+
+	// EnterQualifiedName is called when entering the qualifiedName production.
+	EnterQualifiedName(c *QualifiedNameContext)
+
+	// EnterIdentifier is called when entering the identifier production.
+	EnterIdentifier(c *IdentifierContext)
+
+	// EnterFunctionDeclaration is called when entering the functionDeclaration production.
+	EnterFunctionDeclaration(c *FunctionDeclarationContext)
+
+	// EnterParameterList is called when entering the parameterList production.
+	EnterParameterList(c *ParameterListContext)
+
+	// EnterConstDeclaration is called when entering the constDeclaration production.
+	EnterConstDeclaration(c *ConstDeclarationContext)
+
+	// EnterGlobalDeclaration is called when entering the globalDeclaration production.
+	EnterGlobalDeclaration(c *GlobalDeclarationContext)
+
+	// EnterTypeName is called when entering the typeName production.
+	EnterTypeName(c *TypeNameContext)
+
 	// EnterRuleEntry is called when entering the ruleEntry production.
 	EnterRuleEntry(c *RuleEntryContext)
 
+	// EnterRuleExtends is called when entering the ruleExtends production.
+	EnterRuleExtends(c *RuleExtendsContext)
+
+	// EnterRuleAttribute is called when entering the ruleAttribute production.
+	EnterRuleAttribute(c *RuleAttributeContext)
+
 	// EnterSalience is called when entering the salience production.
 	EnterSalience(c *SalienceContext)
 
+	// EnterAgendaGroup is called when entering the agendaGroup production.
+	EnterAgendaGroup(c *AgendaGroupContext)
+
+	// EnterActivationGroup is called when entering the activationGroup production.
+	EnterActivationGroup(c *ActivationGroupContext)
+
+	// EnterNoLoop is called when entering the noLoop production.
+	EnterNoLoop(c *NoLoopContext)
+
+	// EnterLockOnActive is called when entering the lockOnActive production.
+	EnterLockOnActive(c *LockOnActiveContext)
+
+	// EnterDateEffective is called when entering the dateEffective production.
+	EnterDateEffective(c *DateEffectiveContext)
+
+	// EnterDateExpires is called when entering the dateExpires production.
+	EnterDateExpires(c *DateExpiresContext)
+
+	// EnterEnabled is called when entering the enabled production.
+	EnterEnabled(c *EnabledContext)
+
+	// EnterRuleMetadata is called when entering the ruleMetadata production.
+	EnterRuleMetadata(c *RuleMetadataContext)
+
 	// EnterRuleName is called when entering the ruleName production.
 	EnterRuleName(c *RuleNameContext)
 
@@ -31,6 +88,18 @@ type grulev3Listener interface {
 	// EnterThenExpressionList is called when entering the thenExpressionList production.
 	EnterThenExpressionList(c *ThenExpressionListContext)
 
+	// EnterThenStatement is called when entering the thenStatement production.
+	EnterThenStatement(c *ThenStatementContext)
+
+	// EnterLetStatement is called when entering the letStatement production.
+	EnterLetStatement(c *LetStatementContext)
+
+	// EnterIfStatement is called when entering the ifStatement production.
+	EnterIfStatement(c *IfStatementContext)
+
+	// EnterThenBlock is called when entering the thenBlock production.
+	EnterThenBlock(c *ThenBlockContext)
+
 	// EnterThenExpression is called when entering the thenExpression production.
 	EnterThenExpression(c *ThenExpressionContext)
 
@@ -61,6 +130,15 @@ type grulev3Listener interface {
 	// EnterConstant is called when entering the constant production.
 	EnterConstant(c *ConstantContext)
 
+	// EnterListLiteral is called when entering the listLiteral production.
+	EnterListLiteral(c *ListLiteralContext)
+
+	// EnterMapLiteral is called when entering the mapLiteral production.
+	EnterMapLiteral(c *MapLiteralContext)
+
+	// EnterMapEntry is called when entering the mapEntry production.
+	EnterMapEntry(c *MapEntryContext)
+
 	// EnterVariable is called when entering the variable production.
 	EnterVariable(c *VariableContext)
 
@@ -73,6 +151,12 @@ type grulev3Listener interface {
 	// EnterFunctionCall is called when entering the functionCall production.
 	EnterFunctionCall(c *FunctionCallContext)
 
+	// EnterQuantifier is called when entering the quantifier production.
+	EnterQuantifier(c *QuantifierContext)
+
+	// EnterAggregate is called when entering the aggregate production.
+	EnterAggregate(c *AggregateContext)
+
 	// EnterMethodCall is called when entering the methodCall production.
 	EnterMethodCall(c *MethodCallContext)
 
@@ -103,18 +187,81 @@ type grulev3Listener interface {
 	// EnterStringLiteral is called when entering the stringLiteral production.
 	EnterStringLiteral(c *StringLiteralContext)
 
+	// EnterDurationLiteral is called when entering the durationLiteral production.
+	EnterDurationLiteral(c *DurationLiteralContext)
+
+	// EnterDateLiteral is called when entering the dateLiteral production.
+	EnterDateLiteral(c *DateLiteralContext)
+
 	// EnterBooleanLiteral is called when entering the booleanLiteral production.
 	EnterBooleanLiteral(c *BooleanLiteralContext)
 
 	// ExitGrl is called when exiting the grl production.
 	ExitGrl(c *GrlContext)
 
+	// ExitPackageDeclaration is called when exiting the packageDeclaration production.
+	ExitPackageDeclaration(c *PackageDeclarationContext)
+
+	// ExitImportDeclaration is called when exiting the importDeclaration production.
+	ExitImportDeclaration(c *ImportDeclarationContext)
+
+	// ExitQualifiedName is called when exiting the qualifiedName production.
+	ExitQualifiedName(c *QualifiedNameContext)
+
+	// ExitIdentifier is called when exiting the identifier production.
+	ExitIdentifier(c *IdentifierContext)
+
+	// ExitFunctionDeclaration is called when exiting the functionDeclaration production.
+	ExitFunctionDeclaration(c *FunctionDeclarationContext)
+
+	// ExitParameterList is called when exiting the parameterList production.
+	ExitParameterList(c *ParameterListContext)
+
+	// ExitConstDeclaration is called when exiting the constDeclaration production.
+	ExitConstDeclaration(c *ConstDeclarationContext)
+
+	// ExitGlobalDeclaration is called when exiting the globalDeclaration production.
+	ExitGlobalDeclaration(c *GlobalDeclarationContext)
+
+	// ExitTypeName is called when exiting the typeName production.
+	ExitTypeName(c *TypeNameContext)
+
 	// ExitRuleEntry is called when exiting the ruleEntry production.
 	ExitRuleEntry(c *RuleEntryContext)
 
+	// ExitRuleExtends is called when exiting the ruleExtends production.
+	ExitRuleExtends(c *RuleExtendsContext)
+
+	// ExitRuleAttribute is called when exiting the ruleAttribute production.
+	ExitRuleAttribute(c *RuleAttributeContext)
+
 	// ExitSalience is called when exiting the salience production.
 	ExitSalience(c *SalienceContext)
 
+	// ExitAgendaGroup is called when exiting the agendaGroup production.
+	ExitAgendaGroup(c *AgendaGroupContext)
+
+	// ExitActivationGroup is called when exiting the activationGroup production.
+	ExitActivationGroup(c *ActivationGroupContext)
+
+	// ExitNoLoop is called when exiting the noLoop production.
+	ExitNoLoop(c *NoLoopContext)
+
+	// ExitLockOnActive is called when exiting the lockOnActive production.
+	ExitLockOnActive(c *LockOnActiveContext)
+
+	// ExitDateEffective is called when exiting the dateEffective production.
+	ExitDateEffective(c *DateEffectiveContext)
+
+	// ExitDateExpires is called when exiting the dateExpires production.
+	ExitDateExpires(c *DateExpiresContext)
+
+	// ExitEnabled is called when exiting the enabled production.
+	ExitEnabled(c *EnabledContext)
+
+	// ExitRuleMetadata is called when exiting the ruleMetadata production.
+	ExitRuleMetadata(c *RuleMetadataContext)
+
 	// ExitRuleName is called when exiting the ruleName production.
 	ExitRuleName(c *RuleNameContext)
 
@@ -130,6 +277,18 @@ type grulev3Listener interface {
 	// ExitThenExpressionList is called when exiting the thenExpressionList production.
 	ExitThenExpressionList(c *ThenExpressionListContext)
 
+	// ExitThenStatement is called when exiting the thenStatement production.
+	ExitThenStatement(c *ThenStatementContext)
+
+	// ExitLetStatement is called when exiting the letStatement production.
+	ExitLetStatement(c *LetStatementContext)
+
+	// ExitIfStatement is called when exiting the ifStatement production.
+	ExitIfStatement(c *IfStatementContext)
+
+	// ExitThenBlock is called when exiting the thenBlock production.
+	ExitThenBlock(c *ThenBlockContext)
+
 	// ExitThenExpression is called when exiting the thenExpression production.
 	ExitThenExpression(c *ThenExpressionContext)
 
@@ -160,6 +319,15 @@ type grulev3Listener interface {
 	// ExitConstant is called when exiting the constant production.
 	ExitConstant(c *ConstantContext)
 
+	// ExitListLiteral is called when exiting the listLiteral production.
+	ExitListLiteral(c *ListLiteralContext)
+
+	// ExitMapLiteral is called when exiting the mapLiteral production.
+	ExitMapLiteral(c *MapLiteralContext)
+
+	// ExitMapEntry is called when exiting the mapEntry production.
+	ExitMapEntry(c *MapEntryContext)
+
 	// ExitVariable is called when exiting the variable production.
 	ExitVariable(c *VariableContext)
 
@@ -172,6 +340,12 @@ type grulev3Listener interface {
 	// ExitFunctionCall is called when exiting the functionCall production.
 	ExitFunctionCall(c *FunctionCallContext)
 
+	// ExitQuantifier is called when exiting the quantifier production.
+	ExitQuantifier(c *QuantifierContext)
+
+	// ExitAggregate is called when exiting the aggregate production.
+	ExitAggregate(c *AggregateContext)
+
 	// ExitMethodCall is called when exiting the methodCall production.
 	ExitMethodCall(c *MethodCallContext)
 
@@ -202,6 +376,12 @@ type grulev3Listener interface {
 	// ExitStringLiteral is called when exiting the stringLiteral production.
 	ExitStringLiteral(c *StringLiteralContext)
 
+	// ExitDurationLiteral is called when exiting the durationLiteral production.
+	ExitDurationLiteral(c *DurationLiteralContext)
+
+	// ExitDateLiteral is called when exiting the dateLiteral production.
+	ExitDateLiteral(c *DateLiteralContext)
+
 	// ExitBooleanLiteral is called when exiting the booleanLiteral production.
 	ExitBooleanLiteral(c *BooleanLiteralContext)
 }
@@ -32,145 +32,312 @@ var Grulev3ParserStaticData struct {
 func grulev3ParserInit() {
 	staticData := &Grulev3ParserStaticData
 	staticData.LiteralNames = []string{
-		"", "','", "'+'", "'-'", "'/'", "'*'", "'%'", "'.'", "';'", "'{'", "'}'",
-		"'('", "')'", "'['", "']'", "", "", "", "'&&'", "'||'", "", "", "",
-		"'!'", "", "'=='", "'='", "'+='", "'-='", "'/='", "'*='", "'>'", "'<'",
-		"'>='", "'<='", "'!='", "'&'", "'|'",
+		"", "','", "'+'", "'-'", "'/'", "'**'", "'~/'", "'*'", "'%'", "'.'",
+		"';'", "':'", "'?'", "'?.'", "'??'", "'@'", "'{'", "'}'", "'('", "')'",
+		"'['", "']'", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "'&&'", "'||'", "", "", "", "'!'", "", "", "", "",
+		"", "", "", "", "'=='", "'='", "'+='", "'-='", "'/='", "'*='", "'>'",
+		"'<'", "'>='", "'<='", "'!='", "'&'", "'|'",
 	}
 	staticData.SymbolicNames = []string{
-		"", "", "PLUS", "MINUS", "DIV", "MUL", "MOD", "DOT", "SEMICOLON", "LR_BRACE",
-		"RR_BRACE", "LR_BRACKET", "RR_BRACKET", "LS_BRACKET", "RS_BRACKET",
-		"RULE", "WHEN", "THEN", "AND", "OR", "TRUE", "FALSE", "NIL_LITERAL",
-		"NEGATION", "SALIENCE", "EQUALS", "ASSIGN", "PLUS_ASIGN", "MINUS_ASIGN",
-		"DIV_ASIGN", "MUL_ASIGN", "GT", "LT", "GTE", "LTE", "NOTEQUALS", "BITAND",
-		"BITOR", "SIMPLENAME", "DQUOTA_STRING", "SQUOTA_STRING", "DECIMAL_FLOAT_LIT",
-		"DECIMAL_EXPONENT", "HEX_FLOAT_LIT", "HEX_EXPONENT", "DEC_LIT", "HEX_LIT",
-		"OCT_LIT", "SPACE", "COMMENT", "LINE_COMMENT",
+		"", "", "PLUS", "MINUS", "DIV", "POW", "INT_DIV", "MUL", "MOD", "DOT",
+		"SEMICOLON", "COLON", "QUESTION", "SAFE_DOT", "NULL_COALESCE", "AT",
+		"LR_BRACE", "RR_BRACE", "LR_BRACKET", "RR_BRACKET", "LS_BRACKET", "RS_BRACKET",
+		"RULE", "WHEN", "THEN", "IF", "ELSE", "LET", "IN", "FOR", "NOT", "MATCHES",
+		"BETWEEN", "AND_WORD", "FUNCTION", "RETURN", "CONST", "GLOBAL", "EXTENDS",
+		"PACKAGE", "IMPORT", "AND", "OR", "TRUE", "FALSE", "NIL_LITERAL", "NEGATION",
+		"SALIENCE", "AGENDA_GROUP", "ACTIVATION_GROUP", "NO_LOOP", "LOCK_ON_ACTIVE",
+		"DATE_EFFECTIVE", "DATE_EXPIRES", "ENABLED", "EQUALS", "ASSIGN", "PLUS_ASIGN",
+		"MINUS_ASIGN", "DIV_ASIGN", "MUL_ASIGN", "GT", "LT", "GTE", "LTE", "NOTEQUALS",
+		"BITAND", "BITOR", "ISO_DURATION_LIT", "SIMPLENAME", "DQUOTA_STRING",
+		"SQUOTA_STRING", "DECIMAL_FLOAT_LIT", "DECIMAL_EXPONENT", "HEX_FLOAT_LIT",
+		"HEX_EXPONENT", "DEC_LIT", "HEX_LIT", "DURATION_LIT", "DATE_LIT", "OCT_LIT",
+		"SPACE", "COMMENT", "LINE_COMMENT",
 	}
 	staticData.RuleNames = []string{
-		"grl", "ruleEntry", "salience", "ruleName", "ruleDescription", "whenScope",
-		"thenScope", "thenExpressionList", "thenExpression", "assignment", "expression",
+		"grl", "packageDeclaration", "importDeclaration", "qualifiedName", "identifier",
+		"functionDeclaration", "parameterList", "constDeclaration", "globalDeclaration",
+		"typeName", "ruleEntry", "ruleExtends", "ruleAttribute", "salience",
+		"agendaGroup", "activationGroup", "noLoop", "lockOnActive", "dateEffective",
+		"dateExpires", "enabled", "ruleMetadata", "ruleName", "ruleDescription",
+		"whenScope", "thenScope", "thenExpressionList", "thenStatement", "letStatement",
+		"ifStatement", "thenBlock", "thenExpression", "assignment", "expression",
 		"mulDivOperators", "addMinusOperators", "comparisonOperator", "andLogicOperator",
-		"orLogicOperator", "expressionAtom", "constant", "variable", "arrayMapSelector",
-		"memberVariable", "functionCall", "methodCall", "argumentList", "floatLiteral",
+		"orLogicOperator", "expressionAtom", "constant", "listLiteral", "mapLiteral",
+		"mapEntry", "variable", "arrayMapSelector", "memberVariable", "functionCall",
+		"quantifier", "aggregate", "methodCall", "argumentList", "floatLiteral",
 		"decimalFloatLiteral", "hexadecimalFloatLiteral", "integerLiteral",
 		"decimalLiteral", "hexadecimalLiteral", "octalLiteral", "stringLiteral",
-		"booleanLiteral",
+		"durationLiteral", "dateLiteral", "booleanLiteral",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 50, 263, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 1, 83, 600, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
 		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
 		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
 		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
 		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26,
 		7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7,
-		31, 2, 32, 7, 32, 1, 0, 5, 0, 68, 8, 0, 10, 0, 12, 0, 71, 9, 0, 1, 0, 1,
-		0, 1, 1, 1, 1, 1, 1, 3, 1, 78, 8, 1, 1, 1, 3, 1, 81, 8, 1, 1, 1, 1, 1,
-		1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 4, 1, 4, 1, 5, 1, 5,
-		1, 5, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 4, 7, 104, 8, 7, 11, 7, 12, 7,
-		105, 1, 8, 1, 8, 3, 8, 110, 8, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10,
-		3, 10, 118, 8, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 3, 10, 125, 8, 10,
-		1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1,
-		10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 5, 10,
-		147, 8, 10, 10, 10, 12, 10, 150, 9, 10, 1, 11, 1, 11, 1, 12, 1, 12, 1,
-		13, 1, 13, 1, 14, 1, 14, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16,
-		1, 16, 3, 16, 168, 8, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 5,
-		16, 176, 8, 16, 10, 16, 12, 16, 179, 9, 16, 1, 17, 1, 17, 1, 17, 1, 17,
-		1, 17, 3, 17, 186, 8, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1,
-		18, 5, 18, 195, 8, 18, 10, 18, 12, 18, 198, 9, 18, 1, 19, 1, 19, 1, 19,
-		1, 19, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 3, 21, 210, 8, 21, 1,
-		21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 5, 23, 220, 8, 23,
-		10, 23, 12, 23, 223, 9, 23, 1, 24, 1, 24, 3, 24, 227, 8, 24, 1, 25, 3,
-		25, 230, 8, 25, 1, 25, 1, 25, 1, 26, 3, 26, 235, 8, 26, 1, 26, 1, 26, 1,
-		27, 1, 27, 1, 27, 3, 27, 242, 8, 27, 1, 28, 3, 28, 245, 8, 28, 1, 28, 1,
-		28, 1, 29, 3, 29, 250, 8, 29, 1, 29, 1, 29, 1, 30, 3, 30, 255, 8, 30, 1,
-		30, 1, 30, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 0, 3, 20, 32, 36, 33, 0,
-		2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38,
-		40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 0, 6, 1, 0, 39, 40,
-		1, 0, 26, 30, 1, 0, 4, 6, 2, 0, 2, 3, 36, 37, 2, 0, 25, 25, 31, 35, 1,
-		0, 20, 21, 263, 0, 69, 1, 0, 0, 0, 2, 74, 1, 0, 0, 0, 4, 87, 1, 0, 0, 0,
-		6, 90, 1, 0, 0, 0, 8, 92, 1, 0, 0, 0, 10, 94, 1, 0, 0, 0, 12, 97, 1, 0,
-		0, 0, 14, 103, 1, 0, 0, 0, 16, 109, 1, 0, 0, 0, 18, 111, 1, 0, 0, 0, 20,
-		124, 1, 0, 0, 0, 22, 151, 1, 0, 0, 0, 24, 153, 1, 0, 0, 0, 26, 155, 1,
-		0, 0, 0, 28, 157, 1, 0, 0, 0, 30, 159, 1, 0, 0, 0, 32, 167, 1, 0, 0, 0,
-		34, 185, 1, 0, 0, 0, 36, 187, 1, 0, 0, 0, 38, 199, 1, 0, 0, 0, 40, 203,
-		1, 0, 0, 0, 42, 206, 1, 0, 0, 0, 44, 213, 1, 0, 0, 0, 46, 216, 1, 0, 0,
-		0, 48, 226, 1, 0, 0, 0, 50, 229, 1, 0, 0, 0, 52, 234, 1, 0, 0, 0, 54, 241,
-		1, 0, 0, 0, 56, 244, 1, 0, 0, 0, 58, 249, 1, 0, 0, 0, 60, 254, 1, 0, 0,
-		0, 62, 258, 1, 0, 0, 0, 64, 260, 1, 0, 0, 0, 66, 68, 3, 2, 1, 0, 67, 66,
-		1, 0, 0, 0, 68, 71, 1, 0, 0, 0, 69, 67, 1, 0, 0, 0, 69, 70, 1, 0, 0, 0,
-		70, 72, 1, 0, 0, 0, 71, 69, 1, 0, 0, 0, 72, 73, 5, 0, 0, 1, 73, 1, 1, 0,
-		0, 0, 74, 75, 5, 15, 0, 0, 75, 77, 3, 6, 3, 0, 76, 78, 3, 8, 4, 0, 77,
-		76, 1, 0, 0, 0, 77, 78, 1, 0, 0, 0, 78, 80, 1, 0, 0, 0, 79, 81, 3, 4, 2,
-		0, 80, 79, 1, 0, 0, 0, 80, 81, 1, 0, 0, 0, 81, 82, 1, 0, 0, 0, 82, 83,
-		5, 9, 0, 0, 83, 84, 3, 10, 5, 0, 84, 85, 3, 12, 6, 0, 85, 86, 5, 10, 0,
-		0, 86, 3, 1, 0, 0, 0, 87, 88, 5, 24, 0, 0, 88, 89, 3, 54, 27, 0, 89, 5,
-		1, 0, 0, 0, 90, 91, 5, 38, 0, 0, 91, 7, 1, 0, 0, 0, 92, 93, 7, 0, 0, 0,
-		93, 9, 1, 0, 0, 0, 94, 95, 5, 16, 0, 0, 95, 96, 3, 20, 10, 0, 96, 11, 1,
-		0, 0, 0, 97, 98, 5, 17, 0, 0, 98, 99, 3, 14, 7, 0, 99, 13, 1, 0, 0, 0,
-		100, 101, 3, 16, 8, 0, 101, 102, 5, 8, 0, 0, 102, 104, 1, 0, 0, 0, 103,
-		100, 1, 0, 0, 0, 104, 105, 1, 0, 0, 0, 105, 103, 1, 0, 0, 0, 105, 106,
-		1, 0, 0, 0, 106, 15, 1, 0, 0, 0, 107, 110, 3, 18, 9, 0, 108, 110, 3, 32,
-		16, 0, 109, 107, 1, 0, 0, 0, 109, 108, 1, 0, 0, 0, 110, 17, 1, 0, 0, 0,
-		111, 112, 3, 36, 18, 0, 112, 113, 7, 1, 0, 0, 113, 114, 3, 20, 10, 0, 114,
-		19, 1, 0, 0, 0, 115, 117, 6, 10, -1, 0, 116, 118, 5, 23, 0, 0, 117, 116,
-		1, 0, 0, 0, 117, 118, 1, 0, 0, 0, 118, 119, 1, 0, 0, 0, 119, 120, 5, 11,
-		0, 0, 120, 121, 3, 20, 10, 0, 121, 122, 5, 12, 0, 0, 122, 125, 1, 0, 0,
-		0, 123, 125, 3, 32, 16, 0, 124, 115, 1, 0, 0, 0, 124, 123, 1, 0, 0, 0,
-		125, 148, 1, 0, 0, 0, 126, 127, 10, 7, 0, 0, 127, 128, 3, 22, 11, 0, 128,
-		129, 3, 20, 10, 8, 129, 147, 1, 0, 0, 0, 130, 131, 10, 6, 0, 0, 131, 132,
-		3, 24, 12, 0, 132, 133, 3, 20, 10, 7, 133, 147, 1, 0, 0, 0, 134, 135, 10,
-		5, 0, 0, 135, 136, 3, 26, 13, 0, 136, 137, 3, 20, 10, 6, 137, 147, 1, 0,
-		0, 0, 138, 139, 10, 4, 0, 0, 139, 140, 3, 28, 14, 0, 140, 141, 3, 20, 10,
-		5, 141, 147, 1, 0, 0, 0, 142, 143, 10, 3, 0, 0, 143, 144, 3, 30, 15, 0,
-		144, 145, 3, 20, 10, 4, 145, 147, 1, 0, 0, 0, 146, 126, 1, 0, 0, 0, 146,
-		130, 1, 0, 0, 0, 146, 134, 1, 0, 0, 0, 146, 138, 1, 0, 0, 0, 146, 142,
-		1, 0, 0, 0, 147, 150, 1, 0, 0, 0, 148, 146, 1, 0, 0, 0, 148, 149, 1, 0,
-		0, 0, 149, 21, 1, 0, 0, 0, 150, 148, 1, 0, 0, 0, 151, 152, 7, 2, 0, 0,
-		152, 23, 1, 0, 0, 0, 153, 154, 7, 3, 0, 0, 154, 25, 1, 0, 0, 0, 155, 156,
-		7, 4, 0, 0, 156, 27, 1, 0, 0, 0, 157, 158, 5, 18, 0, 0, 158, 29, 1, 0,
-		0, 0, 159, 160, 5, 19, 0, 0, 160, 31, 1, 0, 0, 0, 161, 162, 6, 16, -1,
-		0, 162, 168, 3, 34, 17, 0, 163, 168, 3, 36, 18, 0, 164, 168, 3, 42, 21,
-		0, 165, 166, 5, 23, 0, 0, 166, 168, 3, 32, 16, 1, 167, 161, 1, 0, 0, 0,
-		167, 163, 1, 0, 0, 0, 167, 164, 1, 0, 0, 0, 167, 165, 1, 0, 0, 0, 168,
-		177, 1, 0, 0, 0, 169, 170, 10, 4, 0, 0, 170, 176, 3, 44, 22, 0, 171, 172,
-		10, 3, 0, 0, 172, 176, 3, 40, 20, 0, 173, 174, 10, 2, 0, 0, 174, 176, 3,
-		38, 19, 0, 175, 169, 1, 0, 0, 0, 175, 171, 1, 0, 0, 0, 175, 173, 1, 0,
-		0, 0, 176, 179, 1, 0, 0, 0, 177, 175, 1, 0, 0, 0, 177, 178, 1, 0, 0, 0,
-		178, 33, 1, 0, 0, 0, 179, 177, 1, 0, 0, 0, 180, 186, 3, 62, 31, 0, 181,
-		186, 3, 54, 27, 0, 182, 186, 3, 48, 24, 0, 183, 186, 3, 64, 32, 0, 184,
-		186, 5, 22, 0, 0, 185, 180, 1, 0, 0, 0, 185, 181, 1, 0, 0, 0, 185, 182,
-		1, 0, 0, 0, 185, 183, 1, 0, 0, 0, 185, 184, 1, 0, 0, 0, 186, 35, 1, 0,
-		0, 0, 187, 188, 6, 18, -1, 0, 188, 189, 5, 38, 0, 0, 189, 196, 1, 0, 0,
-		0, 190, 191, 10, 3, 0, 0, 191, 195, 3, 40, 20, 0, 192, 193, 10, 2, 0, 0,
-		193, 195, 3, 38, 19, 0, 194, 190, 1, 0, 0, 0, 194, 192, 1, 0, 0, 0, 195,
-		198, 1, 0, 0, 0, 196, 194, 1, 0, 0, 0, 196, 197, 1, 0, 0, 0, 197, 37, 1,
-		0, 0, 0, 198, 196, 1, 0, 0, 0, 199, 200, 5, 13, 0, 0, 200, 201, 3, 20,
-		10, 0, 201, 202, 5, 14, 0, 0, 202, 39, 1, 0, 0, 0, 203, 204, 5, 7, 0, 0,
-		204, 205, 5, 38, 0, 0, 205, 41, 1, 0, 0, 0, 206, 207, 5, 38, 0, 0, 207,
-		209, 5, 11, 0, 0, 208, 210, 3, 46, 23, 0, 209, 208, 1, 0, 0, 0, 209, 210,
-		1, 0, 0, 0, 210, 211, 1, 0, 0, 0, 211, 212, 5, 12, 0, 0, 212, 43, 1, 0,
-		0, 0, 213, 214, 5, 7, 0, 0, 214, 215, 3, 42, 21, 0, 215, 45, 1, 0, 0, 0,
-		216, 221, 3, 20, 10, 0, 217, 218, 5, 1, 0, 0, 218, 220, 3, 20, 10, 0, 219,
-		217, 1, 0, 0, 0, 220, 223, 1, 0, 0, 0, 221, 219, 1, 0, 0, 0, 221, 222,
-		1, 0, 0, 0, 222, 47, 1, 0, 0, 0, 223, 221, 1, 0, 0, 0, 224, 227, 3, 50,
-		25, 0, 225, 227, 3, 52, 26, 0, 226, 224, 1, 0, 0, 0, 226, 225, 1, 0, 0,
-		0, 227, 49, 1, 0, 0, 0, 228, 230, 5, 3, 0, 0, 229, 228, 1, 0, 0, 0, 229,
-		230, 1, 0, 0, 0, 230, 231, 1, 0, 0, 0, 231, 232, 5, 41, 0, 0, 232, 51,
-		1, 0, 0, 0, 233, 235, 5, 3, 0, 0, 234, 233, 1, 0, 0, 0, 234, 235, 1, 0,
-		0, 0, 235, 236, 1, 0, 0, 0, 236, 237, 5, 43, 0, 0, 237, 53, 1, 0, 0, 0,
-		238, 242, 3, 56, 28, 0, 239, 242, 3, 58, 29, 0, 240, 242, 3, 60, 30, 0,
-		241, 238, 1, 0, 0, 0, 241, 239, 1, 0, 0, 0, 241, 240, 1, 0, 0, 0, 242,
-		55, 1, 0, 0, 0, 243, 245, 5, 3, 0, 0, 244, 243, 1, 0, 0, 0, 244, 245, 1,
-		0, 0, 0, 245, 246, 1, 0, 0, 0, 246, 247, 5, 45, 0, 0, 247, 57, 1, 0, 0,
-		0, 248, 250, 5, 3, 0, 0, 249, 248, 1, 0, 0, 0, 249, 250, 1, 0, 0, 0, 250,
-		251, 1, 0, 0, 0, 251, 252, 5, 46, 0, 0, 252, 59, 1, 0, 0, 0, 253, 255,
-		5, 3, 0, 0, 254, 253, 1, 0, 0, 0, 254, 255, 1, 0, 0, 0, 255, 256, 1, 0,
-		0, 0, 256, 257, 5, 47, 0, 0, 257, 61, 1, 0, 0, 0, 258, 259, 7, 0, 0, 0,
-		259, 63, 1, 0, 0, 0, 260, 261, 7, 5, 0, 0, 261, 65, 1, 0, 0, 0, 24, 69,
-		77, 80, 105, 109, 117, 124, 146, 148, 167, 175, 177, 185, 194, 196, 209,
-		221, 226, 229, 234, 241, 244, 249, 254,
+		31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36,
+		2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41, 2,
+		42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2, 47,
+		7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52, 7,
+		52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7, 57,
+		2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62, 1,
+		0, 3, 0, 128, 8, 0, 1, 0, 5, 0, 131, 8, 0, 10, 0, 12, 0, 134, 9, 0, 1,
+		0, 1, 0, 1, 0, 1, 0, 5, 0, 140, 8, 0, 10, 0, 12, 0, 143, 9, 0, 1, 0, 1,
+		0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 3, 2, 155, 8, 2, 1,
+		2, 1, 2, 1, 3, 1, 3, 1, 3, 5, 3, 162, 8, 3, 10, 3, 12, 3, 165, 9, 3, 1,
+		4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 5, 3, 5, 173, 8, 5, 1, 5, 1, 5, 1, 5, 1,
+		5, 1, 5, 5, 5, 180, 8, 5, 10, 5, 12, 5, 183, 9, 5, 1, 5, 1, 5, 1, 5, 1,
+		5, 1, 5, 1, 6, 1, 6, 1, 6, 5, 6, 193, 8, 6, 10, 6, 12, 6, 196, 9, 6, 1,
+		7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 3,
+		9, 210, 8, 9, 1, 9, 1, 9, 1, 9, 3, 9, 215, 8, 9, 1, 10, 1, 10, 1, 10, 3,
+		10, 220, 8, 10, 1, 10, 3, 10, 223, 8, 10, 1, 10, 5, 10, 226, 8, 10, 10,
+		10, 12, 10, 229, 9, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11,
+		1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 3,
+		12, 248, 8, 12, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15,
+		1, 15, 1, 16, 1, 16, 3, 16, 261, 8, 16, 1, 17, 1, 17, 3, 17, 265, 8, 17,
+		1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 21, 1,
+		21, 1, 21, 1, 21, 1, 21, 1, 21, 5, 21, 282, 8, 21, 10, 21, 12, 21, 285,
+		9, 21, 3, 21, 287, 8, 21, 1, 21, 3, 21, 290, 8, 21, 1, 22, 1, 22, 1, 23,
+		1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 5, 24, 300, 8, 24, 10, 24, 12, 24, 303,
+		9, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 26, 4, 26, 311, 8, 26, 11,
+		26, 12, 26, 312, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 3, 27,
+		322, 8, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1,
+		29, 1, 29, 1, 29, 1, 29, 1, 29, 3, 29, 337, 8, 29, 3, 29, 339, 8, 29, 1,
+		30, 1, 30, 5, 30, 343, 8, 30, 10, 30, 12, 30, 346, 9, 30, 1, 30, 1, 30,
+		1, 31, 1, 31, 3, 31, 352, 8, 31, 1, 32, 1, 32, 1, 32, 1, 32, 1, 33, 1,
+		33, 1, 33, 1, 33, 3, 33, 362, 8, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33,
+		3, 33, 369, 8, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1,
+		33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33,
+		1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1,
+		33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 5, 33,
+		409, 8, 33, 10, 33, 12, 33, 412, 9, 33, 1, 34, 1, 34, 1, 35, 1, 35, 1,
+		36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 3, 36,
+		428, 8, 36, 1, 37, 1, 37, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1,
+		39, 1, 39, 1, 39, 1, 39, 3, 39, 442, 8, 39, 1, 39, 1, 39, 1, 39, 1, 39,
+		1, 39, 1, 39, 5, 39, 450, 8, 39, 10, 39, 12, 39, 453, 9, 39, 1, 40, 1,
+		40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 3, 40, 464, 8, 40,
+		1, 41, 1, 41, 1, 41, 1, 41, 5, 41, 470, 8, 41, 10, 41, 12, 41, 473, 9,
+		41, 3, 41, 475, 8, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 5, 42,
+		483, 8, 42, 10, 42, 12, 42, 486, 9, 42, 3, 42, 488, 8, 42, 1, 42, 1, 42,
+		1, 43, 1, 43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1,
+		44, 5, 44, 503, 8, 44, 10, 44, 12, 44, 506, 9, 44, 1, 45, 1, 45, 1, 45,
+		1, 45, 1, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 3, 47, 518, 8, 47, 1,
+		47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48,
+		1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 3, 49, 540,
+		8, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 51, 1, 51, 1, 51, 5, 51, 550,
+		8, 51, 10, 51, 12, 51, 553, 9, 51, 1, 52, 1, 52, 3, 52, 557, 8, 52, 1,
+		53, 3, 53, 560, 8, 53, 1, 53, 1, 53, 1, 54, 3, 54, 565, 8, 54, 1, 54, 1,
+		54, 1, 55, 1, 55, 1, 55, 3, 55, 572, 8, 55, 1, 56, 3, 56, 575, 8, 56, 1,
+		56, 1, 56, 1, 57, 3, 57, 580, 8, 57, 1, 57, 1, 57, 1, 58, 3, 58, 585, 8,
+		58, 1, 58, 1, 58, 1, 59, 1, 59, 1, 60, 3, 60, 592, 8, 60, 1, 60, 1, 60,
+		1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 0, 3, 66, 78, 88, 63, 0, 2, 4, 6, 8,
+		10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44,
+		46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80,
+		82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112,
+		114, 116, 118, 120, 122, 124, 0, 8, 3, 0, 25, 40, 54, 54, 69, 69, 1, 0,
+		70, 71, 1, 0, 56, 60, 2, 0, 4, 4, 6, 8, 2, 0, 2, 3, 66, 67, 2, 0, 9, 9,
+		13, 13, 2, 0, 68, 68, 78, 78, 1, 0, 43, 44, 627, 0, 127, 1, 0, 0, 0, 2,
+		146, 1, 0, 0, 0, 4, 150, 1, 0, 0, 0, 6, 158, 1, 0, 0, 0, 8, 166, 1, 0,
+		0, 0, 10, 168, 1, 0, 0, 0, 12, 189, 1, 0, 0, 0, 14, 197, 1, 0, 0, 0, 16,
+		203, 1, 0, 0, 0, 18, 209, 1, 0, 0, 0, 20, 216, 1, 0, 0, 0, 22, 235, 1,
+		0, 0, 0, 24, 247, 1, 0, 0, 0, 26, 249, 1, 0, 0, 0, 28, 252, 1, 0, 0, 0,
+		30, 255, 1, 0, 0, 0, 32, 258, 1, 0, 0, 0, 34, 262, 1, 0, 0, 0, 36, 266,
+		1, 0, 0, 0, 38, 269, 1, 0, 0, 0, 40, 272, 1, 0, 0, 0, 42, 275, 1, 0, 0,
+		0, 44, 291, 1, 0, 0, 0, 46, 293, 1, 0, 0, 0, 48, 295, 1, 0, 0, 0, 50, 306,
+		1, 0, 0, 0, 52, 310, 1, 0, 0, 0, 54, 321, 1, 0, 0, 0, 56, 323, 1, 0, 0,
+		0, 58, 328, 1, 0, 0, 0, 60, 340, 1, 0, 0, 0, 62, 351, 1, 0, 0, 0, 64, 353,
+		1, 0, 0, 0, 66, 368, 1, 0, 0, 0, 68, 413, 1, 0, 0, 0, 70, 415, 1, 0, 0,
+		0, 72, 427, 1, 0, 0, 0, 74, 429, 1, 0, 0, 0, 76, 431, 1, 0, 0, 0, 78, 441,
+		1, 0, 0, 0, 80, 463, 1, 0, 0, 0, 82, 465, 1, 0, 0, 0, 84, 478, 1, 0, 0,
+		0, 86, 491, 1, 0, 0, 0, 88, 495, 1, 0, 0, 0, 90, 507, 1, 0, 0, 0, 92, 511,
+		1, 0, 0, 0, 94, 514, 1, 0, 0, 0, 96, 521, 1, 0, 0, 0, 98, 530, 1, 0, 0,
+		0, 100, 543, 1, 0, 0, 0, 102, 546, 1, 0, 0, 0, 104, 556, 1, 0, 0, 0, 106,
+		559, 1, 0, 0, 0, 108, 564, 1, 0, 0, 0, 110, 571, 1, 0, 0, 0, 112, 574,
+		1, 0, 0, 0, 114, 579, 1, 0, 0, 0, 116, 584, 1, 0, 0, 0, 118, 588, 1, 0,
+		0, 0, 120, 591, 1, 0, 0, 0, 122, 595, 1, 0, 0, 0, 124, 597, 1, 0, 0, 0,
+		126, 128, 3, 2, 1, 0, 127, 126, 1, 0, 0, 0, 127, 128, 1, 0, 0, 0, 128,
+		132, 1, 0, 0, 0, 129, 131, 3, 4, 2, 0, 130, 129, 1, 0, 0, 0, 131, 134,
+		1, 0, 0, 0, 132, 130, 1, 0, 0, 0, 132, 133, 1, 0, 0, 0, 133, 141, 1, 0,
+		0, 0, 134, 132, 1, 0, 0, 0, 135, 140, 3, 20, 10, 0, 136, 140, 3, 10, 5,
+		0, 137, 140, 3, 14, 7, 0, 138, 140, 3, 16, 8, 0, 139, 135, 1, 0, 0, 0,
+		139, 136, 1, 0, 0, 0, 139, 137, 1, 0, 0, 0, 139, 138, 1, 0, 0, 0, 140,
+		143, 1, 0, 0, 0, 141, 139, 1, 0, 0, 0, 141, 142, 1, 0, 0, 0, 142, 144,
+		1, 0, 0, 0, 143, 141, 1, 0, 0, 0, 144, 145, 5, 0, 0, 1, 145, 1, 1, 0, 0,
+		0, 146, 147, 5, 39, 0, 0, 147, 148, 3, 6, 3, 0, 148, 149, 5, 10, 0, 0,
+		149, 3, 1, 0, 0, 0, 150, 151, 5, 40, 0, 0, 151, 154, 3, 6, 3, 0, 152, 153,
+		5, 9, 0, 0, 153, 155, 5, 7, 0, 0, 154, 152, 1, 0, 0, 0, 154, 155, 1, 0,
+		0, 0, 155, 156, 1, 0, 0, 0, 156, 157, 5, 10, 0, 0, 157, 5, 1, 0, 0, 0,
+		158, 163, 3, 8, 4, 0, 159, 160, 5, 9, 0, 0, 160, 162, 3, 8, 4, 0, 161,
+		159, 1, 0, 0, 0, 162, 165, 1, 0, 0, 0, 163, 161, 1, 0, 0, 0, 163, 164,
+		1, 0, 0, 0, 164, 7, 1, 0, 0, 0, 165, 163, 1, 0, 0, 0, 166, 167, 7, 0, 0,
+		0, 167, 9, 1, 0, 0, 0, 168, 169, 5, 34, 0, 0, 169, 170, 5, 69, 0, 0, 170,
+		172, 5, 18, 0, 0, 171, 173, 3, 12, 6, 0, 172, 171, 1, 0, 0, 0, 172, 173,
+		1, 0, 0, 0, 173, 174, 1, 0, 0, 0, 174, 175, 5, 19, 0, 0, 175, 181, 5, 16,
+		0, 0, 176, 177, 3, 56, 28, 0, 177, 178, 5, 10, 0, 0, 178, 180, 1, 0, 0,
+		0, 179, 176, 1, 0, 0, 0, 180, 183, 1, 0, 0, 0, 181, 179, 1, 0, 0, 0, 181,
+		182, 1, 0, 0, 0, 182, 184, 1, 0, 0, 0, 183, 181, 1, 0, 0, 0, 184, 185,
+		5, 35, 0, 0, 185, 186, 3, 66, 33, 0, 186, 187, 5, 10, 0, 0, 187, 188, 5,
+		17, 0, 0, 188, 11, 1, 0, 0, 0, 189, 194, 3, 8, 4, 0, 190, 191, 5, 1, 0,
+		0, 191, 193, 3, 8, 4, 0, 192, 190, 1, 0, 0, 0, 193, 196, 1, 0, 0, 0, 194,
+		192, 1, 0, 0, 0, 194, 195, 1, 0, 0, 0, 195, 13, 1, 0, 0, 0, 196, 194, 1,
+		0, 0, 0, 197, 198, 5, 36, 0, 0, 198, 199, 5, 69, 0, 0, 199, 200, 5, 56,
+		0, 0, 200, 201, 3, 80, 40, 0, 201, 202, 5, 10, 0, 0, 202, 15, 1, 0, 0,
+		0, 203, 204, 5, 37, 0, 0, 204, 205, 3, 18, 9, 0, 205, 206, 5, 69, 0, 0,
+		206, 207, 5, 10, 0, 0, 207, 17, 1, 0, 0, 0, 208, 210, 5, 7, 0, 0, 209,
+		208, 1, 0, 0, 0, 209, 210, 1, 0, 0, 0, 210, 211, 1, 0, 0, 0, 211, 214,
+		5, 69, 0, 0, 212, 213, 5, 9, 0, 0, 213, 215, 5, 69, 0, 0, 214, 212, 1,
+		0, 0, 0, 214, 215, 1, 0, 0, 0, 215, 19, 1, 0, 0, 0, 216, 217, 5, 22, 0,
+		0, 217, 219, 3, 44, 22, 0, 218, 220, 3, 22, 11, 0, 219, 218, 1, 0, 0, 0,
+		219, 220, 1, 0, 0, 0, 220, 222, 1, 0, 0, 0, 221, 223, 3, 46, 23, 0, 222,
+		221, 1, 0, 0, 0, 222, 223, 1, 0, 0, 0, 223, 227, 1, 0, 0, 0, 224, 226,
+		3, 24, 12, 0, 225, 224, 1, 0, 0, 0, 226, 229, 1, 0, 0, 0, 227, 225, 1,
+		0, 0, 0, 227, 228, 1, 0, 0, 0, 228, 230, 1, 0, 0, 0, 229, 227, 1, 0, 0,
+		0, 230, 231, 5, 16, 0, 0, 231, 232, 3, 48, 24, 0, 232, 233, 3, 50, 25,
+		0, 233, 234, 5, 17, 0, 0, 234, 21, 1, 0, 0, 0, 235, 236, 5, 38, 0, 0, 236,
+		237, 3, 6, 3, 0, 237, 23, 1, 0, 0, 0, 238, 248, 3, 26, 13, 0, 239, 248,
+		3, 28, 14, 0, 240, 248, 3, 30, 15, 0, 241, 248, 3, 32, 16, 0, 242, 248,
+		3, 34, 17, 0, 243, 248, 3, 36, 18, 0, 244, 248, 3, 38, 19, 0, 245, 248,
+		3, 40, 20, 0, 246, 248, 3, 42, 21, 0, 247, 238, 1, 0, 0, 0, 247, 239, 1,
+		0, 0, 0, 247, 240, 1, 0, 0, 0, 247, 241, 1, 0, 0, 0, 247, 242, 1, 0, 0,
+		0, 247, 243, 1, 0, 0, 0, 247, 244, 1, 0, 0, 0, 247, 245, 1, 0, 0, 0, 247,
+		246, 1, 0, 0, 0, 248, 25, 1, 0, 0, 0, 249, 250, 5, 47, 0, 0, 250, 251,
+		3, 110, 55, 0, 251, 27, 1, 0, 0, 0, 252, 253, 5, 48, 0, 0, 253, 254, 3,
+		118, 59, 0, 254, 29, 1, 0, 0, 0, 255, 256, 5, 49, 0, 0, 256, 257, 3, 118,
+		59, 0, 257, 31, 1, 0, 0, 0, 258, 260, 5, 50, 0, 0, 259, 261, 3, 124, 62,
+		0, 260, 259, 1, 0, 0, 0, 260, 261, 1, 0, 0, 0, 261, 33, 1, 0, 0, 0, 262,
+		264, 5, 51, 0, 0, 263, 265, 3, 124, 62, 0, 264, 263, 1, 0, 0, 0, 264, 265,
+		1, 0, 0, 0, 265, 35, 1, 0, 0, 0, 266, 267, 5, 52, 0, 0, 267, 268, 3, 118,
+		59, 0, 268, 37, 1, 0, 0, 0, 269, 270, 5, 53, 0, 0, 270, 271, 3, 118, 59,
+		0, 271, 39, 1, 0, 0, 0, 272, 273, 5, 54, 0, 0, 273, 274, 3, 124, 62, 0,
+		274, 41, 1, 0, 0, 0, 275, 276, 5, 15, 0, 0, 276, 289, 5, 69, 0, 0, 277,
+		286, 5, 18, 0, 0, 278, 283, 3, 118, 59, 0, 279, 280, 5, 1, 0, 0, 280, 282,
+		3, 118, 59, 0, 281, 279, 1, 0, 0, 0, 282, 285, 1, 0, 0, 0, 283, 281, 1,
+		0, 0, 0, 283, 284, 1, 0, 0, 0, 284, 287, 1, 0, 0, 0, 285, 283, 1, 0, 0,
+		0, 286, 278, 1, 0, 0, 0, 286, 287, 1, 0, 0, 0, 287, 288, 1, 0, 0, 0, 288,
+		290, 5, 19, 0, 0, 289, 277, 1, 0, 0, 0, 289, 290, 1, 0, 0, 0, 290, 43,
+		1, 0, 0, 0, 291, 292, 3, 8, 4, 0, 292, 45, 1, 0, 0, 0, 293, 294, 7, 1,
+		0, 0, 294, 47, 1, 0, 0, 0, 295, 301, 5, 23, 0, 0, 296, 297, 3, 56, 28,
+		0, 297, 298, 5, 10, 0, 0, 298, 300, 1, 0, 0, 0, 299, 296, 1, 0, 0, 0, 300,
+		303, 1, 0, 0, 0, 301, 299, 1, 0, 0, 0, 301, 302, 1, 0, 0, 0, 302, 304,
+		1, 0, 0, 0, 303, 301, 1, 0, 0, 0, 304, 305, 3, 66, 33, 0, 305, 49, 1, 0,
+		0, 0, 306, 307, 5, 24, 0, 0, 307, 308, 3, 52, 26, 0, 308, 51, 1, 0, 0,
+		0, 309, 311, 3, 54, 27, 0, 310, 309, 1, 0, 0, 0, 311, 312, 1, 0, 0, 0,
+		312, 310, 1, 0, 0, 0, 312, 313, 1, 0, 0, 0, 313, 53, 1, 0, 0, 0, 314, 315,
+		3, 62, 31, 0, 315, 316, 5, 10, 0, 0, 316, 322, 1, 0, 0, 0, 317, 318, 3,
+		56, 28, 0, 318, 319, 5, 10, 0, 0, 319, 322, 1, 0, 0, 0, 320, 322, 3, 58,
+		29, 0, 321, 314, 1, 0, 0, 0, 321, 317, 1, 0, 0, 0, 321, 320, 1, 0, 0, 0,
+		322, 55, 1, 0, 0, 0, 323, 324, 5, 27, 0, 0, 324, 325, 3, 8, 4, 0, 325,
+		326, 5, 56, 0, 0, 326, 327, 3, 66, 33, 0, 327, 57, 1, 0, 0, 0, 328, 329,
+		5, 25, 0, 0, 329, 330, 5, 18, 0, 0, 330, 331, 3, 66, 33, 0, 331, 332, 5,
+		19, 0, 0, 332, 338, 3, 60, 30, 0, 333, 336, 5, 26, 0, 0, 334, 337, 3, 58,
+		29, 0, 335, 337, 3, 60, 30, 0, 336, 334, 1, 0, 0, 0, 336, 335, 1, 0, 0,
+		0, 337, 339, 1, 0, 0, 0, 338, 333, 1, 0, 0, 0, 338, 339, 1, 0, 0, 0, 339,
+		59, 1, 0, 0, 0, 340, 344, 5, 16, 0, 0, 341, 343, 3, 54, 27, 0, 342, 341,
+		1, 0, 0, 0, 343, 346, 1, 0, 0, 0, 344, 342, 1, 0, 0, 0, 344, 345, 1, 0,
+		0, 0, 345, 347, 1, 0, 0, 0, 346, 344, 1, 0, 0, 0, 347, 348, 5, 17, 0, 0,
+		348, 61, 1, 0, 0, 0, 349, 352, 3, 64, 32, 0, 350, 352, 3, 78, 39, 0, 351,
+		349, 1, 0, 0, 0, 351, 350, 1, 0, 0, 0, 352, 63, 1, 0, 0, 0, 353, 354, 3,
+		88, 44, 0, 354, 355, 7, 2, 0, 0, 355, 356, 3, 66, 33, 0, 356, 65, 1, 0,
+		0, 0, 357, 358, 6, 33, -1, 0, 358, 359, 5, 3, 0, 0, 359, 369, 3, 66, 33,
+		11, 360, 362, 5, 46, 0, 0, 361, 360, 1, 0, 0, 0, 361, 362, 1, 0, 0, 0,
+		362, 363, 1, 0, 0, 0, 363, 364, 5, 18, 0, 0, 364, 365, 3, 66, 33, 0, 365,
+		366, 5, 19, 0, 0, 366, 369, 1, 0, 0, 0, 367, 369, 3, 78, 39, 0, 368, 357,
+		1, 0, 0, 0, 368, 361, 1, 0, 0, 0, 368, 367, 1, 0, 0, 0, 369, 410, 1, 0,
+		0, 0, 370, 371, 10, 12, 0, 0, 371, 372, 5, 5, 0, 0, 372, 409, 3, 66, 33,
+		12, 373, 374, 10, 10, 0, 0, 374, 375, 3, 68, 34, 0, 375, 376, 3, 66, 33,
+		11, 376, 409, 1, 0, 0, 0, 377, 378, 10, 9, 0, 0, 378, 379, 3, 70, 35, 0,
+		379, 380, 3, 66, 33, 10, 380, 409, 1, 0, 0, 0, 381, 382, 10, 8, 0, 0, 382,
+		383, 3, 72, 36, 0, 383, 384, 3, 66, 33, 9, 384, 409, 1, 0, 0, 0, 385, 386,
+		10, 7, 0, 0, 386, 387, 5, 32, 0, 0, 387, 388, 3, 66, 33, 0, 388, 389, 5,
+		33, 0, 0, 389, 390, 3, 66, 33, 8, 390, 409, 1, 0, 0, 0, 391, 392, 10, 6,
+		0, 0, 392, 393, 3, 74, 37, 0, 393, 394, 3, 66, 33, 7, 394, 409, 1, 0, 0,
+		0, 395, 396, 10, 5, 0, 0, 396, 397, 3, 76, 38, 0, 397, 398, 3, 66, 33,
+		6, 398, 409, 1, 0, 0, 0, 399, 400, 10, 4, 0, 0, 400, 401, 5, 14, 0, 0,
+		401, 409, 3, 66, 33, 4, 402, 403, 10, 3, 0, 0, 403, 404, 5, 12, 0, 0, 404,
+		405, 3, 66, 33, 0, 405, 406, 5, 11, 0, 0, 406, 407, 3, 66, 33, 3, 407,
+		409, 1, 0, 0, 0, 408, 370, 1, 0, 0, 0, 408, 373, 1, 0, 0, 0, 408, 377,
+		1, 0, 0, 0, 408, 381, 1, 0, 0, 0, 408, 385, 1, 0, 0, 0, 408, 391, 1, 0,
+		0, 0, 408, 395, 1, 0, 0, 0, 408, 399, 1, 0, 0, 0, 408, 402, 1, 0, 0, 0,
+		409, 412, 1, 0, 0, 0, 410, 408, 1, 0, 0, 0, 410, 411, 1, 0, 0, 0, 411,
+		67, 1, 0, 0, 0, 412, 410, 1, 0, 0, 0, 413, 414, 7, 3, 0, 0, 414, 69, 1,
+		0, 0, 0, 415, 416, 7, 4, 0, 0, 416, 71, 1, 0, 0, 0, 417, 428, 5, 61, 0,
+		0, 418, 428, 5, 62, 0, 0, 419, 428, 5, 63, 0, 0, 420, 428, 5, 64, 0, 0,
+		421, 428, 5, 55, 0, 0, 422, 428, 5, 65, 0, 0, 423, 428, 5, 28, 0, 0, 424,
+		425, 5, 30, 0, 0, 425, 428, 5, 28, 0, 0, 426, 428, 5, 31, 0, 0, 427, 417,
+		1, 0, 0, 0, 427, 418, 1, 0, 0, 0, 427, 419, 1, 0, 0, 0, 427, 420, 1, 0,
+		0, 0, 427, 421, 1, 0, 0, 0, 427, 422, 1, 0, 0, 0, 427, 423, 1, 0, 0, 0,
+		427, 424, 1, 0, 0, 0, 427, 426, 1, 0, 0, 0, 428, 73, 1, 0, 0, 0, 429, 430,
+		5, 41, 0, 0, 430, 75, 1, 0, 0, 0, 431, 432, 5, 42, 0, 0, 432, 77, 1, 0,
+		0, 0, 433, 434, 6, 39, -1, 0, 434, 442, 3, 80, 40, 0, 435, 442, 3, 88,
+		44, 0, 436, 442, 3, 94, 47, 0, 437, 442, 3, 96, 48, 0, 438, 442, 3, 98,
+		49, 0, 439, 440, 5, 46, 0, 0, 440, 442, 3, 78, 39, 1, 441, 433, 1, 0, 0,
+		0, 441, 435, 1, 0, 0, 0, 441, 436, 1, 0, 0, 0, 441, 437, 1, 0, 0, 0, 441,
+		438, 1, 0, 0, 0, 441, 439, 1, 0, 0, 0, 442, 451, 1, 0, 0, 0, 443, 444,
+		10, 4, 0, 0, 444, 450, 3, 100, 50, 0, 445, 446, 10, 3, 0, 0, 446, 450,
+		3, 92, 46, 0, 447, 448, 10, 2, 0, 0, 448, 450, 3, 90, 45, 0, 449, 443,
+		1, 0, 0, 0, 449, 445, 1, 0, 0, 0, 449, 447, 1, 0, 0, 0, 450, 453, 1, 0,
+		0, 0, 451, 449, 1, 0, 0, 0, 451, 452, 1, 0, 0, 0, 452, 79, 1, 0, 0, 0,
+		453, 451, 1, 0, 0, 0, 454, 464, 3, 118, 59, 0, 455, 464, 3, 110, 55, 0,
+		456, 464, 3, 104, 52, 0, 457, 464, 3, 124, 62, 0, 458, 464, 5, 45, 0, 0,
+		459, 464, 3, 120, 60, 0, 460, 464, 3, 122, 61, 0, 461, 464, 3, 82, 41,
+		0, 462, 464, 3, 84, 42, 0, 463, 454, 1, 0, 0, 0, 463, 455, 1, 0, 0, 0,
+		463, 456, 1, 0, 0, 0, 463, 457, 1, 0, 0, 0, 463, 458, 1, 0, 0, 0, 463,
+		459, 1, 0, 0, 0, 463, 460, 1, 0, 0, 0, 463, 461, 1, 0, 0, 0, 463, 462,
+		1, 0, 0, 0, 464, 81, 1, 0, 0, 0, 465, 474, 5, 20, 0, 0, 466, 471, 3, 80,
+		40, 0, 467, 468, 5, 1, 0, 0, 468, 470, 3, 80, 40, 0, 469, 467, 1, 0, 0,
+		0, 470, 473, 1, 0, 0, 0, 471, 469, 1, 0, 0, 0, 471, 472, 1, 0, 0, 0, 472,
+		475, 1, 0, 0, 0, 473, 471, 1, 0, 0, 0, 474, 466, 1, 0, 0, 0, 474, 475,
+		1, 0, 0, 0, 475, 476, 1, 0, 0, 0, 476, 477, 5, 21, 0, 0, 477, 83, 1, 0,
+		0, 0, 478, 487, 5, 16, 0, 0, 479, 484, 3, 86, 43, 0, 480, 481, 5, 1, 0,
+		0, 481, 483, 3, 86, 43, 0, 482, 480, 1, 0, 0, 0, 483, 486, 1, 0, 0, 0,
+		484, 482, 1, 0, 0, 0, 484, 485, 1, 0, 0, 0, 485, 488, 1, 0, 0, 0, 486,
+		484, 1, 0, 0, 0, 487, 479, 1, 0, 0, 0, 487, 488, 1, 0, 0, 0, 488, 489,
+		1, 0, 0, 0, 489, 490, 5, 17, 0, 0, 490, 85, 1, 0, 0, 0, 491, 492, 3, 80,
+		40, 0, 492, 493, 5, 11, 0, 0, 493, 494, 3, 80, 40, 0, 494, 87, 1, 0, 0,
+		0, 495, 496, 6, 44, -1, 0, 496, 497, 3, 8, 4, 0, 497, 504, 1, 0, 0, 0,
+		498, 499, 10, 3, 0, 0, 499, 503, 3, 92, 46, 0, 500, 501, 10, 2, 0, 0, 501,
+		503, 3, 90, 45, 0, 502, 498, 1, 0, 0, 0, 502, 500, 1, 0, 0, 0, 503, 506,
+		1, 0, 0, 0, 504, 502, 1, 0, 0, 0, 504, 505, 1, 0, 0, 0, 505, 89, 1, 0,
+		0, 0, 506, 504, 1, 0, 0, 0, 507, 508, 5, 20, 0, 0, 508, 509, 3, 66, 33,
+		0, 509, 510, 5, 21, 0, 0, 510, 91, 1, 0, 0, 0, 511, 512, 7, 5, 0, 0, 512,
+		513, 3, 8, 4, 0, 513, 93, 1, 0, 0, 0, 514, 515, 3, 8, 4, 0, 515, 517, 5,
+		18, 0, 0, 516, 518, 3, 102, 51, 0, 517, 516, 1, 0, 0, 0, 517, 518, 1, 0,
+		0, 0, 518, 519, 1, 0, 0, 0, 519, 520, 5, 19, 0, 0, 520, 95, 1, 0, 0, 0,
+		521, 522, 5, 69, 0, 0, 522, 523, 5, 18, 0, 0, 523, 524, 3, 8, 4, 0, 524,
+		525, 5, 28, 0, 0, 525, 526, 3, 78, 39, 0, 526, 527, 5, 11, 0, 0, 527, 528,
+		3, 66, 33, 0, 528, 529, 5, 19, 0, 0, 529, 97, 1, 0, 0, 0, 530, 531, 5,
+		69, 0, 0, 531, 532, 5, 18, 0, 0, 532, 533, 3, 66, 33, 0, 533, 534, 5, 29,
+		0, 0, 534, 535, 3, 8, 4, 0, 535, 536, 5, 28, 0, 0, 536, 539, 3, 78, 39,
+		0, 537, 538, 5, 25, 0, 0, 538, 540, 3, 66, 33, 0, 539, 537, 1, 0, 0, 0,
+		539, 540, 1, 0, 0, 0, 540, 541, 1, 0, 0, 0, 541, 542, 5, 19, 0, 0, 542,
+		99, 1, 0, 0, 0, 543, 544, 7, 5, 0, 0, 544, 545, 3, 94, 47, 0, 545, 101,
+		1, 0, 0, 0, 546, 551, 3, 66, 33, 0, 547, 548, 5, 1, 0, 0, 548, 550, 3,
+		66, 33, 0, 549, 547, 1, 0, 0, 0, 550, 553, 1, 0, 0, 0, 551, 549, 1, 0,
+		0, 0, 551, 552, 1, 0, 0, 0, 552, 103, 1, 0, 0, 0, 553, 551, 1, 0, 0, 0,
+		554, 557, 3, 106, 53, 0, 555, 557, 3, 108, 54, 0, 556, 554, 1, 0, 0, 0,
+		556, 555, 1, 0, 0, 0, 557, 105, 1, 0, 0, 0, 558, 560, 5, 3, 0, 0, 559,
+		558, 1, 0, 0, 0, 559, 560, 1, 0, 0, 0, 560, 561, 1, 0, 0, 0, 561, 562,
+		5, 72, 0, 0, 562, 107, 1, 0, 0, 0, 563, 565, 5, 3, 0, 0, 564, 563, 1, 0,
+		0, 0, 564, 565, 1, 0, 0, 0, 565, 566, 1, 0, 0, 0, 566, 567, 5, 74, 0, 0,
+		567, 109, 1, 0, 0, 0, 568, 572, 3, 112, 56, 0, 569, 572, 3, 114, 57, 0,
+		570, 572, 3, 116, 58, 0, 571, 568, 1, 0, 0, 0, 571, 569, 1, 0, 0, 0, 571,
+		570, 1, 0, 0, 0, 572, 111, 1, 0, 0, 0, 573, 575, 5, 3, 0, 0, 574, 573,
+		1, 0, 0, 0, 574, 575, 1, 0, 0, 0, 575, 576, 1, 0, 0, 0, 576, 577, 5, 76,
+		0, 0, 577, 113, 1, 0, 0, 0, 578, 580, 5, 3, 0, 0, 579, 578, 1, 0, 0, 0,
+		579, 580, 1, 0, 0, 0, 580, 581, 1, 0, 0, 0, 581, 582, 5, 77, 0, 0, 582,
+		115, 1, 0, 0, 0, 583, 585, 5, 3, 0, 0, 584, 583, 1, 0, 0, 0, 584, 585,
+		1, 0, 0, 0, 585, 586, 1, 0, 0, 0, 586, 587, 5, 80, 0, 0, 587, 117, 1, 0,
+		0, 0, 588, 589, 7, 1, 0, 0, 589, 119, 1, 0, 0, 0, 590, 592, 5, 3, 0, 0,
+		591, 590, 1, 0, 0, 0, 591, 592, 1, 0, 0, 0, 592, 593, 1, 0, 0, 0, 593,
+		594, 7, 6, 0, 0, 594, 121, 1, 0, 0, 0, 595, 596, 5, 79, 0, 0, 596, 123,
+		1, 0, 0, 0, 597, 598, 7, 7, 0, 0, 598, 125, 1, 0, 0, 0, 53, 127, 132, 139,
+		141, 154, 163, 172, 181, 194, 209, 214, 219, 222, 227, 247, 260, 264, 283,
+		286, 289, 301, 312, 321, 336, 338, 344, 351, 361, 368, 408, 410, 427, 441,
+		449, 451, 463, 471, 474, 484, 487, 502, 504, 517, 539, 551, 556, 559, 564,
+		571, 574, 579, 584, 591,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -213,89 +380,152 @@ const (
 	grulev3ParserPLUS              = 2
 	grulev3ParserMINUS             = 3
 	grulev3ParserDIV               = 4
-	grulev3ParserMUL               = 5
-	grulev3ParserMOD               = 6
-	grulev3ParserDOT               = 7
-	grulev3ParserSEMICOLON         = 8
-	grulev3ParserLR_BRACE          = 9
-	grulev3ParserRR_BRACE          = 10
-	grulev3ParserLR_BRACKET        = 11
-	grulev3ParserRR_BRACKET        = 12
-	grulev3ParserLS_BRACKET        = 13
-	grulev3ParserRS_BRACKET        = 14
-	grulev3ParserRULE              = 15
-	grulev3ParserWHEN              = 16
-	grulev3ParserTHEN              = 17
-	grulev3ParserAND               = 18
-	grulev3ParserOR                = 19
-	grulev3ParserTRUE              = 20
-	grulev3ParserFALSE             = 21
-	grulev3ParserNIL_LITERAL       = 22
-	grulev3ParserNEGATION          = 23
-	grulev3ParserSALIENCE          = 24
-	grulev3ParserEQUALS            = 25
-	grulev3ParserASSIGN            = 26
-	grulev3ParserPLUS_ASIGN        = 27
-	grulev3ParserMINUS_ASIGN       = 28
-	grulev3ParserDIV_ASIGN         = 29
-	grulev3ParserMUL_ASIGN         = 30
-	grulev3ParserGT                = 31
-	grulev3ParserLT                = 32
-	grulev3ParserGTE               = 33
-	grulev3ParserLTE               = 34
-	grulev3ParserNOTEQUALS         = 35
-	grulev3ParserBITAND            = 36
-	grulev3ParserBITOR             = 37
-	grulev3ParserSIMPLENAME        = 38
-	grulev3ParserDQUOTA_STRING     = 39
-	grulev3ParserSQUOTA_STRING     = 40
-	grulev3ParserDECIMAL_FLOAT_LIT = 41
-	grulev3ParserDECIMAL_EXPONENT  = 42
-	grulev3ParserHEX_FLOAT_LIT     = 43
-	grulev3ParserHEX_EXPONENT      = 44
-	grulev3ParserDEC_LIT           = 45
-	grulev3ParserHEX_LIT           = 46
-	grulev3ParserOCT_LIT           = 47
-	grulev3ParserSPACE             = 48
-	grulev3ParserCOMMENT           = 49
-	grulev3ParserLINE_COMMENT      = 50
+	grulev3ParserPOW               = 5
+	grulev3ParserINT_DIV           = 6
+	grulev3ParserMUL               = 7
+	grulev3ParserMOD               = 8
+	grulev3ParserDOT               = 9
+	grulev3ParserSEMICOLON         = 10
+	grulev3ParserCOLON             = 11
+	grulev3ParserQUESTION          = 12
+	grulev3ParserSAFE_DOT          = 13
+	grulev3ParserNULL_COALESCE     = 14
+	grulev3ParserAT                = 15
+	grulev3ParserLR_BRACE          = 16
+	grulev3ParserRR_BRACE          = 17
+	grulev3ParserLR_BRACKET        = 18
+	grulev3ParserRR_BRACKET        = 19
+	grulev3ParserLS_BRACKET        = 20
+	grulev3ParserRS_BRACKET        = 21
+	grulev3ParserRULE              = 22
+	grulev3ParserWHEN              = 23
+	grulev3ParserTHEN              = 24
+	grulev3ParserIF                = 25
+	grulev3ParserELSE              = 26
+	grulev3ParserLET               = 27
+	grulev3ParserIN                = 28
+	grulev3ParserFOR               = 29
+	grulev3ParserNOT               = 30
+	grulev3ParserMATCHES           = 31
+	grulev3ParserBETWEEN           = 32
+	grulev3ParserAND_WORD          = 33
+	grulev3ParserFUNCTION          = 34
+	grulev3ParserRETURN            = 35
+	grulev3ParserCONST             = 36
+	grulev3ParserGLOBAL            = 37
+	grulev3ParserEXTENDS           = 38
+	grulev3ParserPACKAGE           = 39
+	grulev3ParserIMPORT            = 40
+	grulev3ParserAND               = 41
+	grulev3ParserOR                = 42
+	grulev3ParserTRUE              = 43
+	grulev3ParserFALSE             = 44
+	grulev3ParserNIL_LITERAL       = 45
+	grulev3ParserNEGATION          = 46
+	grulev3ParserSALIENCE          = 47
+	grulev3ParserAGENDA_GROUP      = 48
+	grulev3ParserACTIVATION_GROUP  = 49
+	grulev3ParserNO_LOOP           = 50
+	grulev3ParserLOCK_ON_ACTIVE    = 51
+	grulev3ParserDATE_EFFECTIVE    = 52
+	grulev3ParserDATE_EXPIRES      = 53
+	grulev3ParserENABLED           = 54
+	grulev3ParserEQUALS            = 55
+	grulev3ParserASSIGN            = 56
+	grulev3ParserPLUS_ASIGN        = 57
+	grulev3ParserMINUS_ASIGN       = 58
+	grulev3ParserDIV_ASIGN         = 59
+	grulev3ParserMUL_ASIGN         = 60
+	grulev3ParserGT                = 61
+	grulev3ParserLT                = 62
+	grulev3ParserGTE               = 63
+	grulev3ParserLTE               = 64
+	grulev3ParserNOTEQUALS         = 65
+	grulev3ParserBITAND            = 66
+	grulev3ParserBITOR             = 67
+	grulev3ParserISO_DURATION_LIT  = 68
+	grulev3ParserSIMPLENAME        = 69
+	grulev3ParserDQUOTA_STRING     = 70
+	grulev3ParserSQUOTA_STRING     = 71
+	grulev3ParserDECIMAL_FLOAT_LIT = 72
+	grulev3ParserDECIMAL_EXPONENT  = 73
+	grulev3ParserHEX_FLOAT_LIT     = 74
+	grulev3ParserHEX_EXPONENT      = 75
+	grulev3ParserDEC_LIT           = 76
+	grulev3ParserHEX_LIT           = 77
+	grulev3ParserDURATION_LIT      = 78
+	grulev3ParserDATE_LIT          = 79
+	grulev3ParserOCT_LIT           = 80
+	grulev3ParserSPACE             = 81
+	grulev3ParserCOMMENT           = 82
+	grulev3ParserLINE_COMMENT      = 83
 )
 
 // grulev3Parser rules.
 const (
 	grulev3ParserRULE_grl                     = 0
-	grulev3ParserRULE_ruleEntry               = 1
-	grulev3ParserRULE_salience                = 2
-	grulev3ParserRULE_ruleName                = 3
-	grulev3ParserRULE_ruleDescription         = 4
-	grulev3ParserRULE_whenScope               = 5
-	grulev3ParserRULE_thenScope               = 6
-	grulev3ParserRULE_thenExpressionList      = 7
-	grulev3ParserRULE_thenExpression          = 8
-	grulev3ParserRULE_assignment              = 9
-	grulev3ParserRULE_expression              = 10
-	grulev3ParserRULE_mulDivOperators         = 11
-	grulev3ParserRULE_addMinusOperators       = 12
-	grulev3ParserRULE_comparisonOperator      = 13
-	grulev3ParserRULE_andLogicOperator        = 14
-	grulev3ParserRULE_orLogicOperator         = 15
-	grulev3ParserRULE_expressionAtom          = 16
-	grulev3ParserRULE_constant                = 17
-	grulev3ParserRULE_variable                = 18
-	grulev3ParserRULE_arrayMapSelector        = 19
-	grulev3ParserRULE_memberVariable          = 20
-	grulev3ParserRULE_functionCall            = 21
-	grulev3ParserRULE_methodCall              = 22
-	grulev3ParserRULE_argumentList            = 23
-	grulev3ParserRULE_floatLiteral            = 24
-	grulev3ParserRULE_decimalFloatLiteral     = 25
-	grulev3ParserRULE_hexadecimalFloatLiteral = 26
-	grulev3ParserRULE_integerLiteral          = 27
-	grulev3ParserRULE_decimalLiteral          = 28
-	grulev3ParserRULE_hexadecimalLiteral      = 29
-	grulev3ParserRULE_octalLiteral            = 30
-	grulev3ParserRULE_stringLiteral           = 31
-	grulev3ParserRULE_booleanLiteral          = 32
+	grulev3ParserRULE_packageDeclaration      = 1
+	grulev3ParserRULE_importDeclaration       = 2
+	grulev3ParserRULE_qualifiedName           = 3
+	grulev3ParserRULE_identifier              = 4
+	grulev3ParserRULE_functionDeclaration     = 5
+	grulev3ParserRULE_parameterList           = 6
+	grulev3ParserRULE_constDeclaration        = 7
+	grulev3ParserRULE_globalDeclaration       = 8
+	grulev3ParserRULE_typeName                = 9
+	grulev3ParserRULE_ruleEntry               = 10
+	grulev3ParserRULE_ruleExtends             = 11
+	grulev3ParserRULE_ruleAttribute           = 12
+	grulev3ParserRULE_salience                = 13
+	grulev3ParserRULE_agendaGroup             = 14
+	grulev3ParserRULE_activationGroup         = 15
+	grulev3ParserRULE_noLoop                  = 16
+	grulev3ParserRULE_lockOnActive            = 17
+	grulev3ParserRULE_dateEffective           = 18
+	grulev3ParserRULE_dateExpires             = 19
+	grulev3ParserRULE_enabled                 = 20
+	grulev3ParserRULE_ruleMetadata            = 21
+	grulev3ParserRULE_ruleName                = 22
+	grulev3ParserRULE_ruleDescription         = 23
+	grulev3ParserRULE_whenScope               = 24
+	grulev3ParserRULE_thenScope               = 25
+	grulev3ParserRULE_thenExpressionList      = 26
+	grulev3ParserRULE_thenStatement           = 27
+	grulev3ParserRULE_letStatement            = 28
+	grulev3ParserRULE_ifStatement             = 29
+	grulev3ParserRULE_thenBlock               = 30
+	grulev3ParserRULE_thenExpression          = 31
+	grulev3ParserRULE_assignment              = 32
+	grulev3ParserRULE_expression              = 33
+	grulev3ParserRULE_mulDivOperators         = 34
+	grulev3ParserRULE_addMinusOperators       = 35
+	grulev3ParserRULE_comparisonOperator      = 36
+	grulev3ParserRULE_andLogicOperator        = 37
+	grulev3ParserRULE_orLogicOperator         = 38
+	grulev3ParserRULE_expressionAtom          = 39
+	grulev3ParserRULE_constant                = 40
+	grulev3ParserRULE_listLiteral             = 41
+	grulev3ParserRULE_mapLiteral              = 42
+	grulev3ParserRULE_mapEntry                = 43
+	grulev3ParserRULE_variable                = 44
+	grulev3ParserRULE_arrayMapSelector        = 45
+	grulev3ParserRULE_memberVariable          = 46
+	grulev3ParserRULE_functionCall            = 47
+	grulev3ParserRULE_quantifier              = 48
+	grulev3ParserRULE_aggregate               = 49
+	grulev3ParserRULE_methodCall              = 50
+	grulev3ParserRULE_argumentList            = 51
+	grulev3ParserRULE_floatLiteral            = 52
+	grulev3ParserRULE_decimalFloatLiteral     = 53
+	grulev3ParserRULE_hexadecimalFloatLiteral = 54
+	grulev3ParserRULE_integerLiteral          = 55
+	grulev3ParserRULE_decimalLiteral          = 56
+	grulev3ParserRULE_hexadecimalLiteral      = 57
+	grulev3ParserRULE_octalLiteral            = 58
+	grulev3ParserRULE_stringLiteral           = 59
+	grulev3ParserRULE_durationLiteral         = 60
+	grulev3ParserRULE_dateLiteral             = 61
+	grulev3ParserRULE_booleanLiteral          = 62
 )
 
 // IGrlContext is an interface to support dynamic dispatch.
@@ -307,8 +537,17 @@ type IGrlContext interface {
 
 	// Getter signatures
 	EOF() antlr.TerminalNode
+	PackageDeclaration() IPackageDeclarationContext
+	AllImportDeclaration() []IImportDeclarationContext
+	ImportDeclaration(i int) IImportDeclarationContext
 	AllRuleEntry() []IRuleEntryContext
 	RuleEntry(i int) IRuleEntryContext
+	AllFunctionDeclaration() []IFunctionDeclarationContext
+	FunctionDeclaration(i int) IFunctionDeclarationContext
+	AllConstDeclaration() []IConstDeclarationContext
+	ConstDeclaration(i int) IConstDeclarationContext
+	AllGlobalDeclaration() []IGlobalDeclarationContext
+	GlobalDeclaration(i int) IGlobalDeclarationContext
 
 	// IsGrlContext differentiates from other interfaces.
 	IsGrlContext()
@@ -350,6 +589,63 @@ func (s *GrlContext) EOF() antlr.TerminalNode {
 	return s.GetToken(grulev3ParserEOF, 0)
 }
 
+func (s *GrlContext) PackageDeclaration() IPackageDeclarationContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPackageDeclarationContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPackageDeclarationContext)
+}
+
+func (s *GrlContext) AllImportDeclaration() []IImportDeclarationContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IImportDeclarationContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IImportDeclarationContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IImportDeclarationContext); ok {
+			tst[i] = t.(IImportDeclarationContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *GrlContext) ImportDeclaration(i int) IImportDeclarationContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IImportDeclarationContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IImportDeclarationContext)
+}
+
 func (s *GrlContext) AllRuleEntry() []IRuleEntryContext {
 	children := s.GetChildren()
 	len := 0
@@ -391,6 +687,129 @@ func (s *GrlContext) RuleEntry(i int) IRuleEntryContext {
 	return t.(IRuleEntryContext)
 }
 
+func (s *GrlContext) AllFunctionDeclaration() []IFunctionDeclarationContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFunctionDeclarationContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IFunctionDeclarationContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFunctionDeclarationContext); ok {
+			tst[i] = t.(IFunctionDeclarationContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *GrlContext) FunctionDeclaration(i int) IFunctionDeclarationContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionDeclarationContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionDeclarationContext)
+}
+
+func (s *GrlContext) AllConstDeclaration() []IConstDeclarationContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IConstDeclarationContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IConstDeclarationContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IConstDeclarationContext); ok {
+			tst[i] = t.(IConstDeclarationContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *GrlContext) ConstDeclaration(i int) IConstDeclarationContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IConstDeclarationContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConstDeclarationContext)
+}
+
+func (s *GrlContext) AllGlobalDeclaration() []IGlobalDeclarationContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IGlobalDeclarationContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IGlobalDeclarationContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IGlobalDeclarationContext); ok {
+			tst[i] = t.(IGlobalDeclarationContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *GrlContext) GlobalDeclaration(i int) IGlobalDeclarationContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGlobalDeclarationContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGlobalDeclarationContext)
+}
+
 func (s *GrlContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -427,20 +846,85 @@ func (p *grulev3Parser) Grl() (localctx IGrlContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(69)
+	p.SetState(127)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == grulev3ParserPACKAGE {
+		{
+			p.SetState(126)
+			p.PackageDeclaration()
+		}
+
+	}
+	p.SetState(132)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == grulev3ParserRULE {
+	for _la == grulev3ParserIMPORT {
 		{
-			p.SetState(66)
-			p.RuleEntry()
+			p.SetState(129)
+			p.ImportDeclaration()
+		}
+
+		p.SetState(134)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(141)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&223342493696) != 0 {
+		p.SetState(139)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetTokenStream().LA(1) {
+		case grulev3ParserRULE:
+			{
+				p.SetState(135)
+				p.RuleEntry()
+			}
+
+		case grulev3ParserFUNCTION:
+			{
+				p.SetState(136)
+				p.FunctionDeclaration()
+			}
+
+		case grulev3ParserCONST:
+			{
+				p.SetState(137)
+				p.ConstDeclaration()
+			}
+
+		case grulev3ParserGLOBAL:
+			{
+				p.SetState(138)
+				p.GlobalDeclaration()
+			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
 		}
 
-		p.SetState(71)
+		p.SetState(143)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -448,7 +932,7 @@ func (p *grulev3Parser) Grl() (localctx IGrlContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(72)
+		p.SetState(144)
 		p.Match(grulev3ParserEOF)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -469,67 +953,62 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IRuleEntryContext is an interface to support dynamic dispatch.
-type IRuleEntryContext interface {
+// IPackageDeclarationContext is an interface to support dynamic dispatch.
+type IPackageDeclarationContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	RULE() antlr.TerminalNode
-	RuleName() IRuleNameContext
-	LR_BRACE() antlr.TerminalNode
-	WhenScope() IWhenScopeContext
-	ThenScope() IThenScopeContext
-	RR_BRACE() antlr.TerminalNode
-	RuleDescription() IRuleDescriptionContext
-	Salience() ISalienceContext
+	PACKAGE() antlr.TerminalNode
+	QualifiedName() IQualifiedNameContext
+	SEMICOLON() antlr.TerminalNode
 
-	// IsRuleEntryContext differentiates from other interfaces.
-	IsRuleEntryContext()
+	// IsPackageDeclarationContext differentiates from other interfaces.
+	IsPackageDeclarationContext()
 }
 
-type RuleEntryContext struct {
+type PackageDeclarationContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRuleEntryContext() *RuleEntryContext {
-	var p = new(RuleEntryContext)
+func NewEmptyPackageDeclarationContext() *PackageDeclarationContext {
+	var p = new(PackageDeclarationContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_ruleEntry
+	p.RuleIndex = grulev3ParserRULE_packageDeclaration
 	return p
 }
 
-func InitEmptyRuleEntryContext(p *RuleEntryContext) {
+func InitEmptyPackageDeclarationContext(p *PackageDeclarationContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_ruleEntry
+	p.RuleIndex = grulev3ParserRULE_packageDeclaration
 }
 
-func (*RuleEntryContext) IsRuleEntryContext() {}
+func (*PackageDeclarationContext) IsPackageDeclarationContext() {}
 
-func NewRuleEntryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RuleEntryContext {
-	var p = new(RuleEntryContext)
+func NewPackageDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PackageDeclarationContext {
+	var p = new(PackageDeclarationContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_ruleEntry
+	p.RuleIndex = grulev3ParserRULE_packageDeclaration
 
 	return p
 }
 
-func (s *RuleEntryContext) GetParser() antlr.Parser { return s.parser }
+func (s *PackageDeclarationContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *RuleEntryContext) RULE() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserRULE, 0)
+func (s *PackageDeclarationContext) PACKAGE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserPACKAGE, 0)
 }
 
-func (s *RuleEntryContext) RuleName() IRuleNameContext {
+func (s *PackageDeclarationContext) QualifiedName() IQualifiedNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IRuleNameContext); ok {
+		if _, ok := ctx.(IQualifiedNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -539,69 +1018,139 @@ func (s *RuleEntryContext) RuleName() IRuleNameContext {
 		return nil
 	}
 
-	return t.(IRuleNameContext)
+	return t.(IQualifiedNameContext)
 }
 
-func (s *RuleEntryContext) LR_BRACE() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserLR_BRACE, 0)
+func (s *PackageDeclarationContext) SEMICOLON() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSEMICOLON, 0)
 }
 
-func (s *RuleEntryContext) WhenScope() IWhenScopeContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWhenScopeContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *PackageDeclarationContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	return t.(IWhenScopeContext)
+func (s *PackageDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RuleEntryContext) ThenScope() IThenScopeContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IThenScopeContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
+func (s *PackageDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterPackageDeclaration(s)
 	}
+}
 
-	if t == nil {
-		return nil
+func (s *PackageDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitPackageDeclaration(s)
 	}
-
-	return t.(IThenScopeContext)
 }
 
-func (s *RuleEntryContext) RR_BRACE() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserRR_BRACE, 0)
+func (s *PackageDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitPackageDeclaration(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
 }
 
-func (s *RuleEntryContext) RuleDescription() IRuleDescriptionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IRuleDescriptionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (p *grulev3Parser) PackageDeclaration() (localctx IPackageDeclarationContext) {
+	localctx = NewPackageDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 2, grulev3ParserRULE_packageDeclaration)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(146)
+		p.Match(grulev3ParserPACKAGE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(147)
+		p.QualifiedName()
+	}
+	{
+		p.SetState(148)
+		p.Match(grulev3ParserSEMICOLON)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
 
-	if t == nil {
-		return nil
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
 	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
 
-	return t.(IRuleDescriptionContext)
+// IImportDeclarationContext is an interface to support dynamic dispatch.
+type IImportDeclarationContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IMPORT() antlr.TerminalNode
+	QualifiedName() IQualifiedNameContext
+	SEMICOLON() antlr.TerminalNode
+	DOT() antlr.TerminalNode
+	MUL() antlr.TerminalNode
+
+	// IsImportDeclarationContext differentiates from other interfaces.
+	IsImportDeclarationContext()
+}
+
+type ImportDeclarationContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyImportDeclarationContext() *ImportDeclarationContext {
+	var p = new(ImportDeclarationContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_importDeclaration
+	return p
+}
+
+func InitEmptyImportDeclarationContext(p *ImportDeclarationContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_importDeclaration
+}
+
+func (*ImportDeclarationContext) IsImportDeclarationContext() {}
+
+func NewImportDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ImportDeclarationContext {
+	var p = new(ImportDeclarationContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_importDeclaration
+
+	return p
+}
+
+func (s *ImportDeclarationContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ImportDeclarationContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserIMPORT, 0)
 }
 
-func (s *RuleEntryContext) Salience() ISalienceContext {
+func (s *ImportDeclarationContext) QualifiedName() IQualifiedNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISalienceContext); ok {
+		if _, ok := ctx.(IQualifiedNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -611,104 +1160,98 @@ func (s *RuleEntryContext) Salience() ISalienceContext {
 		return nil
 	}
 
-	return t.(ISalienceContext)
+	return t.(IQualifiedNameContext)
 }
 
-func (s *RuleEntryContext) GetRuleContext() antlr.RuleContext {
+func (s *ImportDeclarationContext) SEMICOLON() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSEMICOLON, 0)
+}
+
+func (s *ImportDeclarationContext) DOT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserDOT, 0)
+}
+
+func (s *ImportDeclarationContext) MUL() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserMUL, 0)
+}
+
+func (s *ImportDeclarationContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RuleEntryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ImportDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RuleEntryContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ImportDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterRuleEntry(s)
+		listenerT.EnterImportDeclaration(s)
 	}
 }
 
-func (s *RuleEntryContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ImportDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitRuleEntry(s)
+		listenerT.ExitImportDeclaration(s)
 	}
 }
 
-func (s *RuleEntryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ImportDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitRuleEntry(s)
+		return t.VisitImportDeclaration(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) RuleEntry() (localctx IRuleEntryContext) {
-	localctx = NewRuleEntryContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 2, grulev3ParserRULE_ruleEntry)
+func (p *grulev3Parser) ImportDeclaration() (localctx IImportDeclarationContext) {
+	localctx = NewImportDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 4, grulev3ParserRULE_importDeclaration)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(74)
-		p.Match(grulev3ParserRULE)
+		p.SetState(150)
+		p.Match(grulev3ParserIMPORT)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(75)
-		p.RuleName()
+		p.SetState(151)
+		p.QualifiedName()
 	}
-	p.SetState(77)
+	p.SetState(154)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == grulev3ParserDQUOTA_STRING || _la == grulev3ParserSQUOTA_STRING {
+	if _la == grulev3ParserDOT {
 		{
-			p.SetState(76)
-			p.RuleDescription()
+			p.SetState(152)
+			p.Match(grulev3ParserDOT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-
-	}
-	p.SetState(80)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == grulev3ParserSALIENCE {
 		{
-			p.SetState(79)
-			p.Salience()
+			p.SetState(153)
+			p.Match(grulev3ParserMUL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
 	}
 	{
-		p.SetState(82)
-		p.Match(grulev3ParserLR_BRACE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(83)
-		p.WhenScope()
-	}
-	{
-		p.SetState(84)
-		p.ThenScope()
-	}
-	{
-		p.SetState(85)
-		p.Match(grulev3ParserRR_BRACE)
+		p.SetState(156)
+		p.Match(grulev3ParserSEMICOLON)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -728,63 +1271,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISalienceContext is an interface to support dynamic dispatch.
-type ISalienceContext interface {
+// IQualifiedNameContext is an interface to support dynamic dispatch.
+type IQualifiedNameContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	SALIENCE() antlr.TerminalNode
-	IntegerLiteral() IIntegerLiteralContext
+	AllIdentifier() []IIdentifierContext
+	Identifier(i int) IIdentifierContext
+	AllDOT() []antlr.TerminalNode
+	DOT(i int) antlr.TerminalNode
 
-	// IsSalienceContext differentiates from other interfaces.
-	IsSalienceContext()
+	// IsQualifiedNameContext differentiates from other interfaces.
+	IsQualifiedNameContext()
 }
 
-type SalienceContext struct {
+type QualifiedNameContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySalienceContext() *SalienceContext {
-	var p = new(SalienceContext)
+func NewEmptyQualifiedNameContext() *QualifiedNameContext {
+	var p = new(QualifiedNameContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_salience
+	p.RuleIndex = grulev3ParserRULE_qualifiedName
 	return p
 }
 
-func InitEmptySalienceContext(p *SalienceContext) {
+func InitEmptyQualifiedNameContext(p *QualifiedNameContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_salience
+	p.RuleIndex = grulev3ParserRULE_qualifiedName
 }
 
-func (*SalienceContext) IsSalienceContext() {}
+func (*QualifiedNameContext) IsQualifiedNameContext() {}
 
-func NewSalienceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SalienceContext {
-	var p = new(SalienceContext)
+func NewQualifiedNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QualifiedNameContext {
+	var p = new(QualifiedNameContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_salience
+	p.RuleIndex = grulev3ParserRULE_qualifiedName
 
 	return p
 }
 
-func (s *SalienceContext) GetParser() antlr.Parser { return s.parser }
+func (s *QualifiedNameContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SalienceContext) SALIENCE() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserSALIENCE, 0)
+func (s *QualifiedNameContext) AllIdentifier() []IIdentifierContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IIdentifierContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IIdentifierContext); ok {
+			tst[i] = t.(IIdentifierContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *SalienceContext) IntegerLiteral() IIntegerLiteralContext {
+func (s *QualifiedNameContext) Identifier(i int) IIdentifierContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIntegerLiteralContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IIdentifierContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -792,54 +1358,92 @@ func (s *SalienceContext) IntegerLiteral() IIntegerLiteralContext {
 		return nil
 	}
 
-	return t.(IIntegerLiteralContext)
+	return t.(IIdentifierContext)
 }
 
-func (s *SalienceContext) GetRuleContext() antlr.RuleContext {
+func (s *QualifiedNameContext) AllDOT() []antlr.TerminalNode {
+	return s.GetTokens(grulev3ParserDOT)
+}
+
+func (s *QualifiedNameContext) DOT(i int) antlr.TerminalNode {
+	return s.GetToken(grulev3ParserDOT, i)
+}
+
+func (s *QualifiedNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SalienceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *QualifiedNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SalienceContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *QualifiedNameContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterSalience(s)
+		listenerT.EnterQualifiedName(s)
 	}
 }
 
-func (s *SalienceContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *QualifiedNameContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitSalience(s)
+		listenerT.ExitQualifiedName(s)
 	}
 }
 
-func (s *SalienceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *QualifiedNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitSalience(s)
+		return t.VisitQualifiedName(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) Salience() (localctx ISalienceContext) {
-	localctx = NewSalienceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 4, grulev3ParserRULE_salience)
+func (p *grulev3Parser) QualifiedName() (localctx IQualifiedNameContext) {
+	localctx = NewQualifiedNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 6, grulev3ParserRULE_qualifiedName)
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(87)
-		p.Match(grulev3ParserSALIENCE)
+		p.SetState(158)
+		p.Identifier()
+	}
+	p.SetState(163)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 5, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+
+			{
+				p.SetState(159)
+				p.Match(grulev3ParserDOT)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(160)
+				p.Identifier()
+			}
+
+		}
+		p.SetState(165)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 5, p.GetParserRuleContext())
 		if p.HasError() {
-			// Recognition error - abort rule
 			goto errorExit
 		}
-	}
-	{
-		p.SetState(88)
-		p.IntegerLiteral()
 	}
 
 errorExit:
@@ -855,8 +1459,8 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IRuleNameContext is an interface to support dynamic dispatch.
-type IRuleNameContext interface {
+// IIdentifierContext is an interface to support dynamic dispatch.
+type IIdentifierContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -864,199 +1468,173 @@ type IRuleNameContext interface {
 
 	// Getter signatures
 	SIMPLENAME() antlr.TerminalNode
-
-	// IsRuleNameContext differentiates from other interfaces.
-	IsRuleNameContext()
-}
-
-type RuleNameContext struct {
+	IN() antlr.TerminalNode
+	FOR() antlr.TerminalNode
+	NOT() antlr.TerminalNode
+	MATCHES() antlr.TerminalNode
+	BETWEEN() antlr.TerminalNode
+	AND_WORD() antlr.TerminalNode
+	FUNCTION() antlr.TerminalNode
+	RETURN() antlr.TerminalNode
+	CONST() antlr.TerminalNode
+	GLOBAL() antlr.TerminalNode
+	EXTENDS() antlr.TerminalNode
+	PACKAGE() antlr.TerminalNode
+	IMPORT() antlr.TerminalNode
+	IF() antlr.TerminalNode
+	ELSE() antlr.TerminalNode
+	LET() antlr.TerminalNode
+	ENABLED() antlr.TerminalNode
+
+	// IsIdentifierContext differentiates from other interfaces.
+	IsIdentifierContext()
+}
+
+type IdentifierContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRuleNameContext() *RuleNameContext {
-	var p = new(RuleNameContext)
+func NewEmptyIdentifierContext() *IdentifierContext {
+	var p = new(IdentifierContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_ruleName
+	p.RuleIndex = grulev3ParserRULE_identifier
 	return p
 }
 
-func InitEmptyRuleNameContext(p *RuleNameContext) {
+func InitEmptyIdentifierContext(p *IdentifierContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_ruleName
+	p.RuleIndex = grulev3ParserRULE_identifier
 }
 
-func (*RuleNameContext) IsRuleNameContext() {}
+func (*IdentifierContext) IsIdentifierContext() {}
 
-func NewRuleNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RuleNameContext {
-	var p = new(RuleNameContext)
+func NewIdentifierContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IdentifierContext {
+	var p = new(IdentifierContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_ruleName
+	p.RuleIndex = grulev3ParserRULE_identifier
 
 	return p
 }
 
-func (s *RuleNameContext) GetParser() antlr.Parser { return s.parser }
+func (s *IdentifierContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *RuleNameContext) SIMPLENAME() antlr.TerminalNode {
+func (s *IdentifierContext) SIMPLENAME() antlr.TerminalNode {
 	return s.GetToken(grulev3ParserSIMPLENAME, 0)
 }
 
-func (s *RuleNameContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *IdentifierContext) IN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserIN, 0)
 }
 
-func (s *RuleNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *IdentifierContext) FOR() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserFOR, 0)
 }
 
-func (s *RuleNameContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterRuleName(s)
-	}
+func (s *IdentifierContext) NOT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserNOT, 0)
 }
 
-func (s *RuleNameContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitRuleName(s)
-	}
+func (s *IdentifierContext) MATCHES() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserMATCHES, 0)
 }
 
-func (s *RuleNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case grulev3Visitor:
-		return t.VisitRuleName(s)
+func (s *IdentifierContext) BETWEEN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserBETWEEN, 0)
+}
 
-	default:
-		return t.VisitChildren(s)
-	}
+func (s *IdentifierContext) AND_WORD() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserAND_WORD, 0)
 }
 
-func (p *grulev3Parser) RuleName() (localctx IRuleNameContext) {
-	localctx = NewRuleNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 6, grulev3ParserRULE_ruleName)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(90)
-		p.Match(grulev3ParserSIMPLENAME)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
+func (s *IdentifierContext) FUNCTION() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserFUNCTION, 0)
 }
 
-// IRuleDescriptionContext is an interface to support dynamic dispatch.
-type IRuleDescriptionContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// Getter signatures
-	DQUOTA_STRING() antlr.TerminalNode
-	SQUOTA_STRING() antlr.TerminalNode
-
-	// IsRuleDescriptionContext differentiates from other interfaces.
-	IsRuleDescriptionContext()
+func (s *IdentifierContext) RETURN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRETURN, 0)
 }
 
-type RuleDescriptionContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
+func (s *IdentifierContext) CONST() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserCONST, 0)
 }
 
-func NewEmptyRuleDescriptionContext() *RuleDescriptionContext {
-	var p = new(RuleDescriptionContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_ruleDescription
-	return p
+func (s *IdentifierContext) GLOBAL() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserGLOBAL, 0)
 }
 
-func InitEmptyRuleDescriptionContext(p *RuleDescriptionContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_ruleDescription
+func (s *IdentifierContext) EXTENDS() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserEXTENDS, 0)
 }
 
-func (*RuleDescriptionContext) IsRuleDescriptionContext() {}
-
-func NewRuleDescriptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RuleDescriptionContext {
-	var p = new(RuleDescriptionContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+func (s *IdentifierContext) PACKAGE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserPACKAGE, 0)
+}
 
-	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_ruleDescription
+func (s *IdentifierContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserIMPORT, 0)
+}
 
-	return p
+func (s *IdentifierContext) IF() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserIF, 0)
 }
 
-func (s *RuleDescriptionContext) GetParser() antlr.Parser { return s.parser }
+func (s *IdentifierContext) ELSE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserELSE, 0)
+}
 
-func (s *RuleDescriptionContext) DQUOTA_STRING() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserDQUOTA_STRING, 0)
+func (s *IdentifierContext) LET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLET, 0)
 }
 
-func (s *RuleDescriptionContext) SQUOTA_STRING() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserSQUOTA_STRING, 0)
+func (s *IdentifierContext) ENABLED() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserENABLED, 0)
 }
 
-func (s *RuleDescriptionContext) GetRuleContext() antlr.RuleContext {
+func (s *IdentifierContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RuleDescriptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IdentifierContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RuleDescriptionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IdentifierContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterRuleDescription(s)
+		listenerT.EnterIdentifier(s)
 	}
 }
 
-func (s *RuleDescriptionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IdentifierContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitRuleDescription(s)
+		listenerT.ExitIdentifier(s)
 	}
 }
 
-func (s *RuleDescriptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitRuleDescription(s)
+		return t.VisitIdentifier(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) RuleDescription() (localctx IRuleDescriptionContext) {
-	localctx = NewRuleDescriptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 8, grulev3ParserRULE_ruleDescription)
+func (p *grulev3Parser) Identifier() (localctx IIdentifierContext) {
+	localctx = NewIdentifierContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 8, grulev3ParserRULE_identifier)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(92)
+		p.SetState(166)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(_la == grulev3ParserDQUOTA_STRING || _la == grulev3ParserSQUOTA_STRING) {
+		if !((int64((_la-25)) & ^0x3f) == 0 && ((int64(1)<<(_la-25))&17592722980863) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -1077,58 +1655,89 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IWhenScopeContext is an interface to support dynamic dispatch.
-type IWhenScopeContext interface {
+// IFunctionDeclarationContext is an interface to support dynamic dispatch.
+type IFunctionDeclarationContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	WHEN() antlr.TerminalNode
+	FUNCTION() antlr.TerminalNode
+	SIMPLENAME() antlr.TerminalNode
+	LR_BRACKET() antlr.TerminalNode
+	RR_BRACKET() antlr.TerminalNode
+	LR_BRACE() antlr.TerminalNode
+	RETURN() antlr.TerminalNode
 	Expression() IExpressionContext
+	AllSEMICOLON() []antlr.TerminalNode
+	SEMICOLON(i int) antlr.TerminalNode
+	RR_BRACE() antlr.TerminalNode
+	ParameterList() IParameterListContext
+	AllLetStatement() []ILetStatementContext
+	LetStatement(i int) ILetStatementContext
 
-	// IsWhenScopeContext differentiates from other interfaces.
-	IsWhenScopeContext()
+	// IsFunctionDeclarationContext differentiates from other interfaces.
+	IsFunctionDeclarationContext()
 }
 
-type WhenScopeContext struct {
+type FunctionDeclarationContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyWhenScopeContext() *WhenScopeContext {
-	var p = new(WhenScopeContext)
+func NewEmptyFunctionDeclarationContext() *FunctionDeclarationContext {
+	var p = new(FunctionDeclarationContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_whenScope
+	p.RuleIndex = grulev3ParserRULE_functionDeclaration
 	return p
 }
 
-func InitEmptyWhenScopeContext(p *WhenScopeContext) {
+func InitEmptyFunctionDeclarationContext(p *FunctionDeclarationContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_whenScope
+	p.RuleIndex = grulev3ParserRULE_functionDeclaration
 }
 
-func (*WhenScopeContext) IsWhenScopeContext() {}
+func (*FunctionDeclarationContext) IsFunctionDeclarationContext() {}
 
-func NewWhenScopeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WhenScopeContext {
-	var p = new(WhenScopeContext)
+func NewFunctionDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionDeclarationContext {
+	var p = new(FunctionDeclarationContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_whenScope
+	p.RuleIndex = grulev3ParserRULE_functionDeclaration
 
 	return p
 }
 
-func (s *WhenScopeContext) GetParser() antlr.Parser { return s.parser }
+func (s *FunctionDeclarationContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *WhenScopeContext) WHEN() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserWHEN, 0)
+func (s *FunctionDeclarationContext) FUNCTION() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserFUNCTION, 0)
 }
 
-func (s *WhenScopeContext) Expression() IExpressionContext {
+func (s *FunctionDeclarationContext) SIMPLENAME() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSIMPLENAME, 0)
+}
+
+func (s *FunctionDeclarationContext) LR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLR_BRACKET, 0)
+}
+
+func (s *FunctionDeclarationContext) RR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRR_BRACKET, 0)
+}
+
+func (s *FunctionDeclarationContext) LR_BRACE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLR_BRACE, 0)
+}
+
+func (s *FunctionDeclarationContext) RETURN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRETURN, 0)
+}
+
+func (s *FunctionDeclarationContext) Expression() IExpressionContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IExpressionContext); ok {
@@ -1144,123 +1753,65 @@ func (s *WhenScopeContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *WhenScopeContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *FunctionDeclarationContext) AllSEMICOLON() []antlr.TerminalNode {
+	return s.GetTokens(grulev3ParserSEMICOLON)
 }
 
-func (s *WhenScopeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *FunctionDeclarationContext) SEMICOLON(i int) antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSEMICOLON, i)
 }
 
-func (s *WhenScopeContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterWhenScope(s)
-	}
+func (s *FunctionDeclarationContext) RR_BRACE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRR_BRACE, 0)
 }
 
-func (s *WhenScopeContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitWhenScope(s)
+func (s *FunctionDeclarationContext) ParameterList() IParameterListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IParameterListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
 	}
-}
 
-func (s *WhenScopeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case grulev3Visitor:
-		return t.VisitWhenScope(s)
-
-	default:
-		return t.VisitChildren(s)
+	if t == nil {
+		return nil
 	}
+
+	return t.(IParameterListContext)
 }
 
-func (p *grulev3Parser) WhenScope() (localctx IWhenScopeContext) {
-	localctx = NewWhenScopeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 10, grulev3ParserRULE_whenScope)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(94)
-		p.Match(grulev3ParserWHEN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+func (s *FunctionDeclarationContext) AllLetStatement() []ILetStatementContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ILetStatementContext); ok {
+			len++
 		}
 	}
-	{
-		p.SetState(95)
-		p.expression(0)
-	}
 
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+	tst := make([]ILetStatementContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ILetStatementContext); ok {
+			tst[i] = t.(ILetStatementContext)
+			i++
+		}
 	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// IThenScopeContext is an interface to support dynamic dispatch.
-type IThenScopeContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// Getter signatures
-	THEN() antlr.TerminalNode
-	ThenExpressionList() IThenExpressionListContext
-
-	// IsThenScopeContext differentiates from other interfaces.
-	IsThenScopeContext()
-}
-
-type ThenScopeContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyThenScopeContext() *ThenScopeContext {
-	var p = new(ThenScopeContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_thenScope
-	return p
-}
-
-func InitEmptyThenScopeContext(p *ThenScopeContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_thenScope
-}
-
-func (*ThenScopeContext) IsThenScopeContext() {}
-
-func NewThenScopeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ThenScopeContext {
-	var p = new(ThenScopeContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_thenScope
-
-	return p
-}
-
-func (s *ThenScopeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ThenScopeContext) THEN() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserTHEN, 0)
+	return tst
 }
 
-func (s *ThenScopeContext) ThenExpressionList() IThenExpressionListContext {
+func (s *FunctionDeclarationContext) LetStatement(i int) ILetStatementContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IThenExpressionListContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ILetStatementContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -1268,132 +1819,230 @@ func (s *ThenScopeContext) ThenExpressionList() IThenExpressionListContext {
 		return nil
 	}
 
-	return t.(IThenExpressionListContext)
+	return t.(ILetStatementContext)
 }
 
-func (s *ThenScopeContext) GetRuleContext() antlr.RuleContext {
+func (s *FunctionDeclarationContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ThenScopeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FunctionDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ThenScopeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FunctionDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterThenScope(s)
+		listenerT.EnterFunctionDeclaration(s)
 	}
 }
 
-func (s *ThenScopeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FunctionDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitThenScope(s)
+		listenerT.ExitFunctionDeclaration(s)
 	}
 }
 
-func (s *ThenScopeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FunctionDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitThenScope(s)
+		return t.VisitFunctionDeclaration(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) ThenScope() (localctx IThenScopeContext) {
-	localctx = NewThenScopeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, grulev3ParserRULE_thenScope)
+func (p *grulev3Parser) FunctionDeclaration() (localctx IFunctionDeclarationContext) {
+	localctx = NewFunctionDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 10, grulev3ParserRULE_functionDeclaration)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(97)
-		p.Match(grulev3ParserTHEN)
+		p.SetState(168)
+		p.Match(grulev3ParserFUNCTION)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(98)
-		p.ThenExpressionList()
+		p.SetState(169)
+		p.Match(grulev3ParserSIMPLENAME)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+	{
+		p.SetState(170)
+		p.Match(grulev3ParserLR_BRACKET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(172)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if (int64((_la-25)) & ^0x3f) == 0 && ((int64(1)<<(_la-25))&17592722980863) != 0 {
+		{
+			p.SetState(171)
+			p.ParameterList()
+		}
+
+	}
+	{
+		p.SetState(174)
+		p.Match(grulev3ParserRR_BRACKET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(175)
+		p.Match(grulev3ParserLR_BRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(181)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == grulev3ParserLET {
+		{
+			p.SetState(176)
+			p.LetStatement()
+		}
+		{
+			p.SetState(177)
+			p.Match(grulev3ParserSEMICOLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(183)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(184)
+		p.Match(grulev3ParserRETURN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(185)
+		p.expression(0)
+	}
+	{
+		p.SetState(186)
+		p.Match(grulev3ParserSEMICOLON)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(187)
+		p.Match(grulev3ParserRR_BRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
 	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IThenExpressionListContext is an interface to support dynamic dispatch.
-type IThenExpressionListContext interface {
+// IParameterListContext is an interface to support dynamic dispatch.
+type IParameterListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllThenExpression() []IThenExpressionContext
-	ThenExpression(i int) IThenExpressionContext
-	AllSEMICOLON() []antlr.TerminalNode
-	SEMICOLON(i int) antlr.TerminalNode
+	AllIdentifier() []IIdentifierContext
+	Identifier(i int) IIdentifierContext
 
-	// IsThenExpressionListContext differentiates from other interfaces.
-	IsThenExpressionListContext()
+	// IsParameterListContext differentiates from other interfaces.
+	IsParameterListContext()
 }
 
-type ThenExpressionListContext struct {
+type ParameterListContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyThenExpressionListContext() *ThenExpressionListContext {
-	var p = new(ThenExpressionListContext)
+func NewEmptyParameterListContext() *ParameterListContext {
+	var p = new(ParameterListContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_thenExpressionList
+	p.RuleIndex = grulev3ParserRULE_parameterList
 	return p
 }
 
-func InitEmptyThenExpressionListContext(p *ThenExpressionListContext) {
+func InitEmptyParameterListContext(p *ParameterListContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_thenExpressionList
+	p.RuleIndex = grulev3ParserRULE_parameterList
 }
 
-func (*ThenExpressionListContext) IsThenExpressionListContext() {}
+func (*ParameterListContext) IsParameterListContext() {}
 
-func NewThenExpressionListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ThenExpressionListContext {
-	var p = new(ThenExpressionListContext)
+func NewParameterListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParameterListContext {
+	var p = new(ParameterListContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_thenExpressionList
+	p.RuleIndex = grulev3ParserRULE_parameterList
 
 	return p
 }
 
-func (s *ThenExpressionListContext) GetParser() antlr.Parser { return s.parser }
+func (s *ParameterListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ThenExpressionListContext) AllThenExpression() []IThenExpressionContext {
+func (s *ParameterListContext) AllIdentifier() []IIdentifierContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IThenExpressionContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IThenExpressionContext, len)
+	tst := make([]IIdentifierContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IThenExpressionContext); ok {
-			tst[i] = t.(IThenExpressionContext)
+		if t, ok := ctx.(IIdentifierContext); ok {
+			tst[i] = t.(IIdentifierContext)
 			i++
 		}
 	}
@@ -1401,11 +2050,11 @@ func (s *ThenExpressionListContext) AllThenExpression() []IThenExpressionContext
 	return tst
 }
 
-func (s *ThenExpressionListContext) ThenExpression(i int) IThenExpressionContext {
+func (s *ParameterListContext) Identifier(i int) IIdentifierContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IThenExpressionContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -1418,75 +2067,71 @@ func (s *ThenExpressionListContext) ThenExpression(i int) IThenExpressionContext
 		return nil
 	}
 
-	return t.(IThenExpressionContext)
-}
-
-func (s *ThenExpressionListContext) AllSEMICOLON() []antlr.TerminalNode {
-	return s.GetTokens(grulev3ParserSEMICOLON)
-}
-
-func (s *ThenExpressionListContext) SEMICOLON(i int) antlr.TerminalNode {
-	return s.GetToken(grulev3ParserSEMICOLON, i)
+	return t.(IIdentifierContext)
 }
 
-func (s *ThenExpressionListContext) GetRuleContext() antlr.RuleContext {
+func (s *ParameterListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ThenExpressionListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ParameterListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ThenExpressionListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ParameterListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterThenExpressionList(s)
+		listenerT.EnterParameterList(s)
 	}
 }
 
-func (s *ThenExpressionListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ParameterListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitThenExpressionList(s)
+		listenerT.ExitParameterList(s)
 	}
 }
 
-func (s *ThenExpressionListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ParameterListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitThenExpressionList(s)
+		return t.VisitParameterList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) ThenExpressionList() (localctx IThenExpressionListContext) {
-	localctx = NewThenExpressionListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, grulev3ParserRULE_thenExpressionList)
+func (p *grulev3Parser) ParameterList() (localctx IParameterListContext) {
+	localctx = NewParameterListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 12, grulev3ParserRULE_parameterList)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(103)
+	{
+		p.SetState(189)
+		p.Identifier()
+	}
+	p.SetState(194)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&259209881976840) != 0) {
-		{
-			p.SetState(100)
-			p.ThenExpression()
-		}
+	for _la == grulev3ParserT__0 {
 		{
-			p.SetState(101)
-			p.Match(grulev3ParserSEMICOLON)
+			p.SetState(190)
+			p.Match(grulev3ParserT__0)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
+		{
+			p.SetState(191)
+			p.Identifier()
+		}
 
-		p.SetState(105)
+		p.SetState(196)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1507,73 +2152,72 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IThenExpressionContext is an interface to support dynamic dispatch.
-type IThenExpressionContext interface {
+// IConstDeclarationContext is an interface to support dynamic dispatch.
+type IConstDeclarationContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Assignment() IAssignmentContext
-	ExpressionAtom() IExpressionAtomContext
+	CONST() antlr.TerminalNode
+	SIMPLENAME() antlr.TerminalNode
+	ASSIGN() antlr.TerminalNode
+	Constant() IConstantContext
+	SEMICOLON() antlr.TerminalNode
 
-	// IsThenExpressionContext differentiates from other interfaces.
-	IsThenExpressionContext()
+	// IsConstDeclarationContext differentiates from other interfaces.
+	IsConstDeclarationContext()
 }
 
-type ThenExpressionContext struct {
+type ConstDeclarationContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyThenExpressionContext() *ThenExpressionContext {
-	var p = new(ThenExpressionContext)
+func NewEmptyConstDeclarationContext() *ConstDeclarationContext {
+	var p = new(ConstDeclarationContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_thenExpression
+	p.RuleIndex = grulev3ParserRULE_constDeclaration
 	return p
 }
 
-func InitEmptyThenExpressionContext(p *ThenExpressionContext) {
+func InitEmptyConstDeclarationContext(p *ConstDeclarationContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_thenExpression
+	p.RuleIndex = grulev3ParserRULE_constDeclaration
 }
 
-func (*ThenExpressionContext) IsThenExpressionContext() {}
+func (*ConstDeclarationContext) IsConstDeclarationContext() {}
 
-func NewThenExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ThenExpressionContext {
-	var p = new(ThenExpressionContext)
+func NewConstDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstDeclarationContext {
+	var p = new(ConstDeclarationContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_thenExpression
+	p.RuleIndex = grulev3ParserRULE_constDeclaration
 
 	return p
 }
 
-func (s *ThenExpressionContext) GetParser() antlr.Parser { return s.parser }
+func (s *ConstDeclarationContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ThenExpressionContext) Assignment() IAssignmentContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAssignmentContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *ConstDeclarationContext) CONST() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserCONST, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *ConstDeclarationContext) SIMPLENAME() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSIMPLENAME, 0)
+}
 
-	return t.(IAssignmentContext)
+func (s *ConstDeclarationContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserASSIGN, 0)
 }
 
-func (s *ThenExpressionContext) ExpressionAtom() IExpressionAtomContext {
+func (s *ConstDeclarationContext) Constant() IConstantContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionAtomContext); ok {
+		if _, ok := ctx.(IConstantContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1583,65 +2227,82 @@ func (s *ThenExpressionContext) ExpressionAtom() IExpressionAtomContext {
 		return nil
 	}
 
-	return t.(IExpressionAtomContext)
+	return t.(IConstantContext)
 }
 
-func (s *ThenExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *ConstDeclarationContext) SEMICOLON() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSEMICOLON, 0)
+}
+
+func (s *ConstDeclarationContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ThenExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConstDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ThenExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConstDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterThenExpression(s)
+		listenerT.EnterConstDeclaration(s)
 	}
 }
 
-func (s *ThenExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConstDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitThenExpression(s)
+		listenerT.ExitConstDeclaration(s)
 	}
 }
 
-func (s *ThenExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ConstDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitThenExpression(s)
+		return t.VisitConstDeclaration(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) ThenExpression() (localctx IThenExpressionContext) {
-	localctx = NewThenExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, grulev3ParserRULE_thenExpression)
-	p.SetState(109)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+func (p *grulev3Parser) ConstDeclaration() (localctx IConstDeclarationContext) {
+	localctx = NewConstDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 14, grulev3ParserRULE_constDeclaration)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(197)
+		p.Match(grulev3ParserCONST)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
-
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 4, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(107)
-			p.Assignment()
+	{
+		p.SetState(198)
+		p.Match(grulev3ParserSIMPLENAME)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(108)
-			p.expressionAtom(0)
+	}
+	{
+		p.SetState(199)
+		p.Match(grulev3ParserASSIGN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(200)
+		p.Constant()
+	}
+	{
+		p.SetState(201)
+		p.Match(grulev3ParserSEMICOLON)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -1657,62 +2318,63 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IAssignmentContext is an interface to support dynamic dispatch.
-type IAssignmentContext interface {
+// IGlobalDeclarationContext is an interface to support dynamic dispatch.
+type IGlobalDeclarationContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Variable() IVariableContext
-	Expression() IExpressionContext
-	ASSIGN() antlr.TerminalNode
-	PLUS_ASIGN() antlr.TerminalNode
-	MINUS_ASIGN() antlr.TerminalNode
-	DIV_ASIGN() antlr.TerminalNode
-	MUL_ASIGN() antlr.TerminalNode
+	GLOBAL() antlr.TerminalNode
+	TypeName() ITypeNameContext
+	SIMPLENAME() antlr.TerminalNode
+	SEMICOLON() antlr.TerminalNode
 
-	// IsAssignmentContext differentiates from other interfaces.
-	IsAssignmentContext()
+	// IsGlobalDeclarationContext differentiates from other interfaces.
+	IsGlobalDeclarationContext()
 }
 
-type AssignmentContext struct {
+type GlobalDeclarationContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyAssignmentContext() *AssignmentContext {
-	var p = new(AssignmentContext)
+func NewEmptyGlobalDeclarationContext() *GlobalDeclarationContext {
+	var p = new(GlobalDeclarationContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_assignment
+	p.RuleIndex = grulev3ParserRULE_globalDeclaration
 	return p
 }
 
-func InitEmptyAssignmentContext(p *AssignmentContext) {
+func InitEmptyGlobalDeclarationContext(p *GlobalDeclarationContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_assignment
+	p.RuleIndex = grulev3ParserRULE_globalDeclaration
 }
 
-func (*AssignmentContext) IsAssignmentContext() {}
+func (*GlobalDeclarationContext) IsGlobalDeclarationContext() {}
 
-func NewAssignmentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AssignmentContext {
-	var p = new(AssignmentContext)
+func NewGlobalDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GlobalDeclarationContext {
+	var p = new(GlobalDeclarationContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_assignment
+	p.RuleIndex = grulev3ParserRULE_globalDeclaration
 
 	return p
 }
 
-func (s *AssignmentContext) GetParser() antlr.Parser { return s.parser }
+func (s *GlobalDeclarationContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *AssignmentContext) Variable() IVariableContext {
+func (s *GlobalDeclarationContext) GLOBAL() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserGLOBAL, 0)
+}
+
+func (s *GlobalDeclarationContext) TypeName() ITypeNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IVariableContext); ok {
+		if _, ok := ctx.(ITypeNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1722,13 +2384,323 @@ func (s *AssignmentContext) Variable() IVariableContext {
 		return nil
 	}
 
-	return t.(IVariableContext)
+	return t.(ITypeNameContext)
 }
 
-func (s *AssignmentContext) Expression() IExpressionContext {
-	var t antlr.RuleContext
+func (s *GlobalDeclarationContext) SIMPLENAME() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSIMPLENAME, 0)
+}
+
+func (s *GlobalDeclarationContext) SEMICOLON() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSEMICOLON, 0)
+}
+
+func (s *GlobalDeclarationContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GlobalDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GlobalDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterGlobalDeclaration(s)
+	}
+}
+
+func (s *GlobalDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitGlobalDeclaration(s)
+	}
+}
+
+func (s *GlobalDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitGlobalDeclaration(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) GlobalDeclaration() (localctx IGlobalDeclarationContext) {
+	localctx = NewGlobalDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 16, grulev3ParserRULE_globalDeclaration)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(203)
+		p.Match(grulev3ParserGLOBAL)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(204)
+		p.TypeName()
+	}
+	{
+		p.SetState(205)
+		p.Match(grulev3ParserSIMPLENAME)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(206)
+		p.Match(grulev3ParserSEMICOLON)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITypeNameContext is an interface to support dynamic dispatch.
+type ITypeNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllSIMPLENAME() []antlr.TerminalNode
+	SIMPLENAME(i int) antlr.TerminalNode
+	MUL() antlr.TerminalNode
+	DOT() antlr.TerminalNode
+
+	// IsTypeNameContext differentiates from other interfaces.
+	IsTypeNameContext()
+}
+
+type TypeNameContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTypeNameContext() *TypeNameContext {
+	var p = new(TypeNameContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_typeName
+	return p
+}
+
+func InitEmptyTypeNameContext(p *TypeNameContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_typeName
+}
+
+func (*TypeNameContext) IsTypeNameContext() {}
+
+func NewTypeNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeNameContext {
+	var p = new(TypeNameContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_typeName
+
+	return p
+}
+
+func (s *TypeNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeNameContext) AllSIMPLENAME() []antlr.TerminalNode {
+	return s.GetTokens(grulev3ParserSIMPLENAME)
+}
+
+func (s *TypeNameContext) SIMPLENAME(i int) antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSIMPLENAME, i)
+}
+
+func (s *TypeNameContext) MUL() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserMUL, 0)
+}
+
+func (s *TypeNameContext) DOT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserDOT, 0)
+}
+
+func (s *TypeNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterTypeName(s)
+	}
+}
+
+func (s *TypeNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitTypeName(s)
+	}
+}
+
+func (s *TypeNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitTypeName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) TypeName() (localctx ITypeNameContext) {
+	localctx = NewTypeNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, grulev3ParserRULE_typeName)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(209)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == grulev3ParserMUL {
+		{
+			p.SetState(208)
+			p.Match(grulev3ParserMUL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+	{
+		p.SetState(211)
+		p.Match(grulev3ParserSIMPLENAME)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(214)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == grulev3ParserDOT {
+		{
+			p.SetState(212)
+			p.Match(grulev3ParserDOT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(213)
+			p.Match(grulev3ParserSIMPLENAME)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IRuleEntryContext is an interface to support dynamic dispatch.
+type IRuleEntryContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	RULE() antlr.TerminalNode
+	RuleName() IRuleNameContext
+	LR_BRACE() antlr.TerminalNode
+	WhenScope() IWhenScopeContext
+	ThenScope() IThenScopeContext
+	RR_BRACE() antlr.TerminalNode
+	RuleExtends() IRuleExtendsContext
+	RuleDescription() IRuleDescriptionContext
+	AllRuleAttribute() []IRuleAttributeContext
+	RuleAttribute(i int) IRuleAttributeContext
+
+	// IsRuleEntryContext differentiates from other interfaces.
+	IsRuleEntryContext()
+}
+
+type RuleEntryContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRuleEntryContext() *RuleEntryContext {
+	var p = new(RuleEntryContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ruleEntry
+	return p
+}
+
+func InitEmptyRuleEntryContext(p *RuleEntryContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ruleEntry
+}
+
+func (*RuleEntryContext) IsRuleEntryContext() {}
+
+func NewRuleEntryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RuleEntryContext {
+	var p = new(RuleEntryContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_ruleEntry
+
+	return p
+}
+
+func (s *RuleEntryContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RuleEntryContext) RULE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRULE, 0)
+}
+
+func (s *RuleEntryContext) RuleName() IRuleNameContext {
+	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IRuleNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1738,84 +2710,5158 @@ func (s *AssignmentContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IRuleNameContext)
 }
 
-func (s *AssignmentContext) ASSIGN() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserASSIGN, 0)
-}
+func (s *RuleEntryContext) LR_BRACE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLR_BRACE, 0)
+}
+
+func (s *RuleEntryContext) WhenScope() IWhenScopeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWhenScopeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWhenScopeContext)
+}
+
+func (s *RuleEntryContext) ThenScope() IThenScopeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IThenScopeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IThenScopeContext)
+}
+
+func (s *RuleEntryContext) RR_BRACE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRR_BRACE, 0)
+}
+
+func (s *RuleEntryContext) RuleExtends() IRuleExtendsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRuleExtendsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRuleExtendsContext)
+}
+
+func (s *RuleEntryContext) RuleDescription() IRuleDescriptionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRuleDescriptionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRuleDescriptionContext)
+}
+
+func (s *RuleEntryContext) AllRuleAttribute() []IRuleAttributeContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IRuleAttributeContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IRuleAttributeContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IRuleAttributeContext); ok {
+			tst[i] = t.(IRuleAttributeContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *RuleEntryContext) RuleAttribute(i int) IRuleAttributeContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRuleAttributeContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRuleAttributeContext)
+}
+
+func (s *RuleEntryContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RuleEntryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RuleEntryContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterRuleEntry(s)
+	}
+}
+
+func (s *RuleEntryContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitRuleEntry(s)
+	}
+}
+
+func (s *RuleEntryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitRuleEntry(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) RuleEntry() (localctx IRuleEntryContext) {
+	localctx = NewRuleEntryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, grulev3ParserRULE_ruleEntry)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(216)
+		p.Match(grulev3ParserRULE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(217)
+		p.RuleName()
+	}
+	p.SetState(219)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == grulev3ParserEXTENDS {
+		{
+			p.SetState(218)
+			p.RuleExtends()
+		}
+
+	}
+	p.SetState(222)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == grulev3ParserDQUOTA_STRING || _la == grulev3ParserSQUOTA_STRING {
+		{
+			p.SetState(221)
+			p.RuleDescription()
+		}
+
+	}
+	p.SetState(227)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&35888059530641408) != 0 {
+		{
+			p.SetState(224)
+			p.RuleAttribute()
+		}
+
+		p.SetState(229)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(230)
+		p.Match(grulev3ParserLR_BRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(231)
+		p.WhenScope()
+	}
+	{
+		p.SetState(232)
+		p.ThenScope()
+	}
+	{
+		p.SetState(233)
+		p.Match(grulev3ParserRR_BRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IRuleExtendsContext is an interface to support dynamic dispatch.
+type IRuleExtendsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	EXTENDS() antlr.TerminalNode
+	QualifiedName() IQualifiedNameContext
+
+	// IsRuleExtendsContext differentiates from other interfaces.
+	IsRuleExtendsContext()
+}
+
+type RuleExtendsContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRuleExtendsContext() *RuleExtendsContext {
+	var p = new(RuleExtendsContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ruleExtends
+	return p
+}
+
+func InitEmptyRuleExtendsContext(p *RuleExtendsContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ruleExtends
+}
+
+func (*RuleExtendsContext) IsRuleExtendsContext() {}
+
+func NewRuleExtendsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RuleExtendsContext {
+	var p = new(RuleExtendsContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_ruleExtends
+
+	return p
+}
+
+func (s *RuleExtendsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RuleExtendsContext) EXTENDS() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserEXTENDS, 0)
+}
+
+func (s *RuleExtendsContext) QualifiedName() IQualifiedNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IQualifiedNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQualifiedNameContext)
+}
+
+func (s *RuleExtendsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RuleExtendsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RuleExtendsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterRuleExtends(s)
+	}
+}
+
+func (s *RuleExtendsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitRuleExtends(s)
+	}
+}
+
+func (s *RuleExtendsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitRuleExtends(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) RuleExtends() (localctx IRuleExtendsContext) {
+	localctx = NewRuleExtendsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, grulev3ParserRULE_ruleExtends)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(235)
+		p.Match(grulev3ParserEXTENDS)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(236)
+		p.QualifiedName()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IRuleAttributeContext is an interface to support dynamic dispatch.
+type IRuleAttributeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Salience() ISalienceContext
+	AgendaGroup() IAgendaGroupContext
+	ActivationGroup() IActivationGroupContext
+	NoLoop() INoLoopContext
+	LockOnActive() ILockOnActiveContext
+	DateEffective() IDateEffectiveContext
+	DateExpires() IDateExpiresContext
+	Enabled() IEnabledContext
+	RuleMetadata() IRuleMetadataContext
+
+	// IsRuleAttributeContext differentiates from other interfaces.
+	IsRuleAttributeContext()
+}
+
+type RuleAttributeContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRuleAttributeContext() *RuleAttributeContext {
+	var p = new(RuleAttributeContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ruleAttribute
+	return p
+}
+
+func InitEmptyRuleAttributeContext(p *RuleAttributeContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ruleAttribute
+}
+
+func (*RuleAttributeContext) IsRuleAttributeContext() {}
+
+func NewRuleAttributeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RuleAttributeContext {
+	var p = new(RuleAttributeContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_ruleAttribute
+
+	return p
+}
+
+func (s *RuleAttributeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RuleAttributeContext) Salience() ISalienceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISalienceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISalienceContext)
+}
+
+func (s *RuleAttributeContext) AgendaGroup() IAgendaGroupContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAgendaGroupContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAgendaGroupContext)
+}
+
+func (s *RuleAttributeContext) ActivationGroup() IActivationGroupContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IActivationGroupContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IActivationGroupContext)
+}
+
+func (s *RuleAttributeContext) NoLoop() INoLoopContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INoLoopContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INoLoopContext)
+}
+
+func (s *RuleAttributeContext) LockOnActive() ILockOnActiveContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILockOnActiveContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILockOnActiveContext)
+}
+
+func (s *RuleAttributeContext) DateEffective() IDateEffectiveContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDateEffectiveContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDateEffectiveContext)
+}
+
+func (s *RuleAttributeContext) DateExpires() IDateExpiresContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDateExpiresContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDateExpiresContext)
+}
+
+func (s *RuleAttributeContext) Enabled() IEnabledContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEnabledContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnabledContext)
+}
+
+func (s *RuleAttributeContext) RuleMetadata() IRuleMetadataContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRuleMetadataContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRuleMetadataContext)
+}
+
+func (s *RuleAttributeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RuleAttributeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RuleAttributeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterRuleAttribute(s)
+	}
+}
+
+func (s *RuleAttributeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitRuleAttribute(s)
+	}
+}
+
+func (s *RuleAttributeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitRuleAttribute(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) RuleAttribute() (localctx IRuleAttributeContext) {
+	localctx = NewRuleAttributeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, grulev3ParserRULE_ruleAttribute)
+	p.SetState(247)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case grulev3ParserSALIENCE:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(238)
+			p.Salience()
+		}
+
+	case grulev3ParserAGENDA_GROUP:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(239)
+			p.AgendaGroup()
+		}
+
+	case grulev3ParserACTIVATION_GROUP:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(240)
+			p.ActivationGroup()
+		}
+
+	case grulev3ParserNO_LOOP:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(241)
+			p.NoLoop()
+		}
+
+	case grulev3ParserLOCK_ON_ACTIVE:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(242)
+			p.LockOnActive()
+		}
+
+	case grulev3ParserDATE_EFFECTIVE:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(243)
+			p.DateEffective()
+		}
+
+	case grulev3ParserDATE_EXPIRES:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(244)
+			p.DateExpires()
+		}
+
+	case grulev3ParserENABLED:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(245)
+			p.Enabled()
+		}
+
+	case grulev3ParserAT:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(246)
+			p.RuleMetadata()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISalienceContext is an interface to support dynamic dispatch.
+type ISalienceContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SALIENCE() antlr.TerminalNode
+	IntegerLiteral() IIntegerLiteralContext
+
+	// IsSalienceContext differentiates from other interfaces.
+	IsSalienceContext()
+}
+
+type SalienceContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySalienceContext() *SalienceContext {
+	var p = new(SalienceContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_salience
+	return p
+}
+
+func InitEmptySalienceContext(p *SalienceContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_salience
+}
+
+func (*SalienceContext) IsSalienceContext() {}
+
+func NewSalienceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SalienceContext {
+	var p = new(SalienceContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_salience
+
+	return p
+}
+
+func (s *SalienceContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SalienceContext) SALIENCE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSALIENCE, 0)
+}
+
+func (s *SalienceContext) IntegerLiteral() IIntegerLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIntegerLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIntegerLiteralContext)
+}
+
+func (s *SalienceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SalienceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SalienceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterSalience(s)
+	}
+}
+
+func (s *SalienceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitSalience(s)
+	}
+}
+
+func (s *SalienceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitSalience(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) Salience() (localctx ISalienceContext) {
+	localctx = NewSalienceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, grulev3ParserRULE_salience)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(249)
+		p.Match(grulev3ParserSALIENCE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(250)
+		p.IntegerLiteral()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IAgendaGroupContext is an interface to support dynamic dispatch.
+type IAgendaGroupContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AGENDA_GROUP() antlr.TerminalNode
+	StringLiteral() IStringLiteralContext
+
+	// IsAgendaGroupContext differentiates from other interfaces.
+	IsAgendaGroupContext()
+}
+
+type AgendaGroupContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAgendaGroupContext() *AgendaGroupContext {
+	var p = new(AgendaGroupContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_agendaGroup
+	return p
+}
+
+func InitEmptyAgendaGroupContext(p *AgendaGroupContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_agendaGroup
+}
+
+func (*AgendaGroupContext) IsAgendaGroupContext() {}
+
+func NewAgendaGroupContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AgendaGroupContext {
+	var p = new(AgendaGroupContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_agendaGroup
+
+	return p
+}
+
+func (s *AgendaGroupContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AgendaGroupContext) AGENDA_GROUP() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserAGENDA_GROUP, 0)
+}
+
+func (s *AgendaGroupContext) StringLiteral() IStringLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStringLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStringLiteralContext)
+}
+
+func (s *AgendaGroupContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AgendaGroupContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AgendaGroupContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterAgendaGroup(s)
+	}
+}
+
+func (s *AgendaGroupContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitAgendaGroup(s)
+	}
+}
+
+func (s *AgendaGroupContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitAgendaGroup(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) AgendaGroup() (localctx IAgendaGroupContext) {
+	localctx = NewAgendaGroupContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, grulev3ParserRULE_agendaGroup)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(252)
+		p.Match(grulev3ParserAGENDA_GROUP)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(253)
+		p.StringLiteral()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IActivationGroupContext is an interface to support dynamic dispatch.
+type IActivationGroupContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ACTIVATION_GROUP() antlr.TerminalNode
+	StringLiteral() IStringLiteralContext
+
+	// IsActivationGroupContext differentiates from other interfaces.
+	IsActivationGroupContext()
+}
+
+type ActivationGroupContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyActivationGroupContext() *ActivationGroupContext {
+	var p = new(ActivationGroupContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_activationGroup
+	return p
+}
+
+func InitEmptyActivationGroupContext(p *ActivationGroupContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_activationGroup
+}
+
+func (*ActivationGroupContext) IsActivationGroupContext() {}
+
+func NewActivationGroupContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ActivationGroupContext {
+	var p = new(ActivationGroupContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_activationGroup
+
+	return p
+}
+
+func (s *ActivationGroupContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ActivationGroupContext) ACTIVATION_GROUP() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserACTIVATION_GROUP, 0)
+}
+
+func (s *ActivationGroupContext) StringLiteral() IStringLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStringLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStringLiteralContext)
+}
+
+func (s *ActivationGroupContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ActivationGroupContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ActivationGroupContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterActivationGroup(s)
+	}
+}
+
+func (s *ActivationGroupContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitActivationGroup(s)
+	}
+}
+
+func (s *ActivationGroupContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitActivationGroup(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) ActivationGroup() (localctx IActivationGroupContext) {
+	localctx = NewActivationGroupContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, grulev3ParserRULE_activationGroup)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(255)
+		p.Match(grulev3ParserACTIVATION_GROUP)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(256)
+		p.StringLiteral()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// INoLoopContext is an interface to support dynamic dispatch.
+type INoLoopContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	NO_LOOP() antlr.TerminalNode
+	BooleanLiteral() IBooleanLiteralContext
+
+	// IsNoLoopContext differentiates from other interfaces.
+	IsNoLoopContext()
+}
+
+type NoLoopContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNoLoopContext() *NoLoopContext {
+	var p = new(NoLoopContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_noLoop
+	return p
+}
+
+func InitEmptyNoLoopContext(p *NoLoopContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_noLoop
+}
+
+func (*NoLoopContext) IsNoLoopContext() {}
+
+func NewNoLoopContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NoLoopContext {
+	var p = new(NoLoopContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_noLoop
+
+	return p
+}
+
+func (s *NoLoopContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NoLoopContext) NO_LOOP() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserNO_LOOP, 0)
+}
+
+func (s *NoLoopContext) BooleanLiteral() IBooleanLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBooleanLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBooleanLiteralContext)
+}
+
+func (s *NoLoopContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NoLoopContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *NoLoopContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterNoLoop(s)
+	}
+}
+
+func (s *NoLoopContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitNoLoop(s)
+	}
+}
+
+func (s *NoLoopContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitNoLoop(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) NoLoop() (localctx INoLoopContext) {
+	localctx = NewNoLoopContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, grulev3ParserRULE_noLoop)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(258)
+		p.Match(grulev3ParserNO_LOOP)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(260)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == grulev3ParserTRUE || _la == grulev3ParserFALSE {
+		{
+			p.SetState(259)
+			p.BooleanLiteral()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ILockOnActiveContext is an interface to support dynamic dispatch.
+type ILockOnActiveContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LOCK_ON_ACTIVE() antlr.TerminalNode
+	BooleanLiteral() IBooleanLiteralContext
+
+	// IsLockOnActiveContext differentiates from other interfaces.
+	IsLockOnActiveContext()
+}
+
+type LockOnActiveContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyLockOnActiveContext() *LockOnActiveContext {
+	var p = new(LockOnActiveContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_lockOnActive
+	return p
+}
+
+func InitEmptyLockOnActiveContext(p *LockOnActiveContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_lockOnActive
+}
+
+func (*LockOnActiveContext) IsLockOnActiveContext() {}
+
+func NewLockOnActiveContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LockOnActiveContext {
+	var p = new(LockOnActiveContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_lockOnActive
+
+	return p
+}
+
+func (s *LockOnActiveContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *LockOnActiveContext) LOCK_ON_ACTIVE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLOCK_ON_ACTIVE, 0)
+}
+
+func (s *LockOnActiveContext) BooleanLiteral() IBooleanLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBooleanLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBooleanLiteralContext)
+}
+
+func (s *LockOnActiveContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LockOnActiveContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *LockOnActiveContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterLockOnActive(s)
+	}
+}
+
+func (s *LockOnActiveContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitLockOnActive(s)
+	}
+}
+
+func (s *LockOnActiveContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitLockOnActive(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) LockOnActive() (localctx ILockOnActiveContext) {
+	localctx = NewLockOnActiveContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, grulev3ParserRULE_lockOnActive)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(262)
+		p.Match(grulev3ParserLOCK_ON_ACTIVE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(264)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == grulev3ParserTRUE || _la == grulev3ParserFALSE {
+		{
+			p.SetState(263)
+			p.BooleanLiteral()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IDateEffectiveContext is an interface to support dynamic dispatch.
+type IDateEffectiveContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	DATE_EFFECTIVE() antlr.TerminalNode
+	StringLiteral() IStringLiteralContext
+
+	// IsDateEffectiveContext differentiates from other interfaces.
+	IsDateEffectiveContext()
+}
+
+type DateEffectiveContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDateEffectiveContext() *DateEffectiveContext {
+	var p = new(DateEffectiveContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_dateEffective
+	return p
+}
+
+func InitEmptyDateEffectiveContext(p *DateEffectiveContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_dateEffective
+}
+
+func (*DateEffectiveContext) IsDateEffectiveContext() {}
+
+func NewDateEffectiveContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DateEffectiveContext {
+	var p = new(DateEffectiveContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_dateEffective
+
+	return p
+}
+
+func (s *DateEffectiveContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DateEffectiveContext) DATE_EFFECTIVE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserDATE_EFFECTIVE, 0)
+}
+
+func (s *DateEffectiveContext) StringLiteral() IStringLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStringLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStringLiteralContext)
+}
+
+func (s *DateEffectiveContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DateEffectiveContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DateEffectiveContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterDateEffective(s)
+	}
+}
+
+func (s *DateEffectiveContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitDateEffective(s)
+	}
+}
+
+func (s *DateEffectiveContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitDateEffective(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) DateEffective() (localctx IDateEffectiveContext) {
+	localctx = NewDateEffectiveContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, grulev3ParserRULE_dateEffective)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(266)
+		p.Match(grulev3ParserDATE_EFFECTIVE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(267)
+		p.StringLiteral()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IDateExpiresContext is an interface to support dynamic dispatch.
+type IDateExpiresContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	DATE_EXPIRES() antlr.TerminalNode
+	StringLiteral() IStringLiteralContext
+
+	// IsDateExpiresContext differentiates from other interfaces.
+	IsDateExpiresContext()
+}
+
+type DateExpiresContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDateExpiresContext() *DateExpiresContext {
+	var p = new(DateExpiresContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_dateExpires
+	return p
+}
+
+func InitEmptyDateExpiresContext(p *DateExpiresContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_dateExpires
+}
+
+func (*DateExpiresContext) IsDateExpiresContext() {}
+
+func NewDateExpiresContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DateExpiresContext {
+	var p = new(DateExpiresContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_dateExpires
+
+	return p
+}
+
+func (s *DateExpiresContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DateExpiresContext) DATE_EXPIRES() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserDATE_EXPIRES, 0)
+}
+
+func (s *DateExpiresContext) StringLiteral() IStringLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStringLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStringLiteralContext)
+}
+
+func (s *DateExpiresContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DateExpiresContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DateExpiresContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterDateExpires(s)
+	}
+}
+
+func (s *DateExpiresContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitDateExpires(s)
+	}
+}
+
+func (s *DateExpiresContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitDateExpires(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) DateExpires() (localctx IDateExpiresContext) {
+	localctx = NewDateExpiresContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, grulev3ParserRULE_dateExpires)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(269)
+		p.Match(grulev3ParserDATE_EXPIRES)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(270)
+		p.StringLiteral()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IEnabledContext is an interface to support dynamic dispatch.
+type IEnabledContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ENABLED() antlr.TerminalNode
+	BooleanLiteral() IBooleanLiteralContext
+
+	// IsEnabledContext differentiates from other interfaces.
+	IsEnabledContext()
+}
+
+type EnabledContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyEnabledContext() *EnabledContext {
+	var p = new(EnabledContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_enabled
+	return p
+}
+
+func InitEmptyEnabledContext(p *EnabledContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_enabled
+}
+
+func (*EnabledContext) IsEnabledContext() {}
+
+func NewEnabledContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnabledContext {
+	var p = new(EnabledContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_enabled
+
+	return p
+}
+
+func (s *EnabledContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *EnabledContext) ENABLED() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserENABLED, 0)
+}
+
+func (s *EnabledContext) BooleanLiteral() IBooleanLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBooleanLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBooleanLiteralContext)
+}
+
+func (s *EnabledContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EnabledContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *EnabledContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterEnabled(s)
+	}
+}
+
+func (s *EnabledContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitEnabled(s)
+	}
+}
+
+func (s *EnabledContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitEnabled(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) Enabled() (localctx IEnabledContext) {
+	localctx = NewEnabledContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, grulev3ParserRULE_enabled)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(272)
+		p.Match(grulev3ParserENABLED)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(273)
+		p.BooleanLiteral()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IRuleMetadataContext is an interface to support dynamic dispatch.
+type IRuleMetadataContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AT() antlr.TerminalNode
+	SIMPLENAME() antlr.TerminalNode
+	LR_BRACKET() antlr.TerminalNode
+	RR_BRACKET() antlr.TerminalNode
+	AllStringLiteral() []IStringLiteralContext
+	StringLiteral(i int) IStringLiteralContext
+
+	// IsRuleMetadataContext differentiates from other interfaces.
+	IsRuleMetadataContext()
+}
+
+type RuleMetadataContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRuleMetadataContext() *RuleMetadataContext {
+	var p = new(RuleMetadataContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ruleMetadata
+	return p
+}
+
+func InitEmptyRuleMetadataContext(p *RuleMetadataContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ruleMetadata
+}
+
+func (*RuleMetadataContext) IsRuleMetadataContext() {}
+
+func NewRuleMetadataContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RuleMetadataContext {
+	var p = new(RuleMetadataContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_ruleMetadata
+
+	return p
+}
+
+func (s *RuleMetadataContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RuleMetadataContext) AT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserAT, 0)
+}
+
+func (s *RuleMetadataContext) SIMPLENAME() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSIMPLENAME, 0)
+}
+
+func (s *RuleMetadataContext) LR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLR_BRACKET, 0)
+}
+
+func (s *RuleMetadataContext) RR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRR_BRACKET, 0)
+}
+
+func (s *RuleMetadataContext) AllStringLiteral() []IStringLiteralContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStringLiteralContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStringLiteralContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStringLiteralContext); ok {
+			tst[i] = t.(IStringLiteralContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *RuleMetadataContext) StringLiteral(i int) IStringLiteralContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStringLiteralContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStringLiteralContext)
+}
+
+func (s *RuleMetadataContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RuleMetadataContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RuleMetadataContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterRuleMetadata(s)
+	}
+}
+
+func (s *RuleMetadataContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitRuleMetadata(s)
+	}
+}
+
+func (s *RuleMetadataContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitRuleMetadata(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) RuleMetadata() (localctx IRuleMetadataContext) {
+	localctx = NewRuleMetadataContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, grulev3ParserRULE_ruleMetadata)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(275)
+		p.Match(grulev3ParserAT)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(276)
+		p.Match(grulev3ParserSIMPLENAME)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(289)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == grulev3ParserLR_BRACKET {
+		{
+			p.SetState(277)
+			p.Match(grulev3ParserLR_BRACKET)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(286)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == grulev3ParserDQUOTA_STRING || _la == grulev3ParserSQUOTA_STRING {
+			{
+				p.SetState(278)
+				p.StringLiteral()
+			}
+			p.SetState(283)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == grulev3ParserT__0 {
+				{
+					p.SetState(279)
+					p.Match(grulev3ParserT__0)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(280)
+					p.StringLiteral()
+				}
+
+				p.SetState(285)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
+
+		}
+		{
+			p.SetState(288)
+			p.Match(grulev3ParserRR_BRACKET)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IRuleNameContext is an interface to support dynamic dispatch.
+type IRuleNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Identifier() IIdentifierContext
+
+	// IsRuleNameContext differentiates from other interfaces.
+	IsRuleNameContext()
+}
+
+type RuleNameContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRuleNameContext() *RuleNameContext {
+	var p = new(RuleNameContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ruleName
+	return p
+}
+
+func InitEmptyRuleNameContext(p *RuleNameContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ruleName
+}
+
+func (*RuleNameContext) IsRuleNameContext() {}
+
+func NewRuleNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RuleNameContext {
+	var p = new(RuleNameContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_ruleName
+
+	return p
+}
+
+func (s *RuleNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RuleNameContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *RuleNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RuleNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RuleNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterRuleName(s)
+	}
+}
+
+func (s *RuleNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitRuleName(s)
+	}
+}
+
+func (s *RuleNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitRuleName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) RuleName() (localctx IRuleNameContext) {
+	localctx = NewRuleNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, grulev3ParserRULE_ruleName)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(291)
+		p.Identifier()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IRuleDescriptionContext is an interface to support dynamic dispatch.
+type IRuleDescriptionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	DQUOTA_STRING() antlr.TerminalNode
+	SQUOTA_STRING() antlr.TerminalNode
+
+	// IsRuleDescriptionContext differentiates from other interfaces.
+	IsRuleDescriptionContext()
+}
+
+type RuleDescriptionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRuleDescriptionContext() *RuleDescriptionContext {
+	var p = new(RuleDescriptionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ruleDescription
+	return p
+}
+
+func InitEmptyRuleDescriptionContext(p *RuleDescriptionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ruleDescription
+}
+
+func (*RuleDescriptionContext) IsRuleDescriptionContext() {}
+
+func NewRuleDescriptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RuleDescriptionContext {
+	var p = new(RuleDescriptionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_ruleDescription
+
+	return p
+}
+
+func (s *RuleDescriptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RuleDescriptionContext) DQUOTA_STRING() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserDQUOTA_STRING, 0)
+}
+
+func (s *RuleDescriptionContext) SQUOTA_STRING() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSQUOTA_STRING, 0)
+}
+
+func (s *RuleDescriptionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RuleDescriptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RuleDescriptionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterRuleDescription(s)
+	}
+}
+
+func (s *RuleDescriptionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitRuleDescription(s)
+	}
+}
+
+func (s *RuleDescriptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitRuleDescription(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) RuleDescription() (localctx IRuleDescriptionContext) {
+	localctx = NewRuleDescriptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, grulev3ParserRULE_ruleDescription)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(293)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == grulev3ParserDQUOTA_STRING || _la == grulev3ParserSQUOTA_STRING) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IWhenScopeContext is an interface to support dynamic dispatch.
+type IWhenScopeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	WHEN() antlr.TerminalNode
+	Expression() IExpressionContext
+	AllLetStatement() []ILetStatementContext
+	LetStatement(i int) ILetStatementContext
+	AllSEMICOLON() []antlr.TerminalNode
+	SEMICOLON(i int) antlr.TerminalNode
+
+	// IsWhenScopeContext differentiates from other interfaces.
+	IsWhenScopeContext()
+}
+
+type WhenScopeContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyWhenScopeContext() *WhenScopeContext {
+	var p = new(WhenScopeContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_whenScope
+	return p
+}
+
+func InitEmptyWhenScopeContext(p *WhenScopeContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_whenScope
+}
+
+func (*WhenScopeContext) IsWhenScopeContext() {}
+
+func NewWhenScopeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WhenScopeContext {
+	var p = new(WhenScopeContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_whenScope
+
+	return p
+}
+
+func (s *WhenScopeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *WhenScopeContext) WHEN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserWHEN, 0)
+}
+
+func (s *WhenScopeContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *WhenScopeContext) AllLetStatement() []ILetStatementContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ILetStatementContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ILetStatementContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ILetStatementContext); ok {
+			tst[i] = t.(ILetStatementContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *WhenScopeContext) LetStatement(i int) ILetStatementContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILetStatementContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILetStatementContext)
+}
+
+func (s *WhenScopeContext) AllSEMICOLON() []antlr.TerminalNode {
+	return s.GetTokens(grulev3ParserSEMICOLON)
+}
+
+func (s *WhenScopeContext) SEMICOLON(i int) antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSEMICOLON, i)
+}
+
+func (s *WhenScopeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WhenScopeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *WhenScopeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterWhenScope(s)
+	}
+}
+
+func (s *WhenScopeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitWhenScope(s)
+	}
+}
+
+func (s *WhenScopeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitWhenScope(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) WhenScope() (localctx IWhenScopeContext) {
+	localctx = NewWhenScopeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, grulev3ParserRULE_whenScope)
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(295)
+		p.Match(grulev3ParserWHEN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(301)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+
+			{
+				p.SetState(296)
+				p.LetStatement()
+			}
+			{
+				p.SetState(297)
+				p.Match(grulev3ParserSEMICOLON)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+		p.SetState(303)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(304)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IThenScopeContext is an interface to support dynamic dispatch.
+type IThenScopeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	THEN() antlr.TerminalNode
+	ThenExpressionList() IThenExpressionListContext
+
+	// IsThenScopeContext differentiates from other interfaces.
+	IsThenScopeContext()
+}
+
+type ThenScopeContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyThenScopeContext() *ThenScopeContext {
+	var p = new(ThenScopeContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_thenScope
+	return p
+}
+
+func InitEmptyThenScopeContext(p *ThenScopeContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_thenScope
+}
+
+func (*ThenScopeContext) IsThenScopeContext() {}
+
+func NewThenScopeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ThenScopeContext {
+	var p = new(ThenScopeContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_thenScope
+
+	return p
+}
+
+func (s *ThenScopeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ThenScopeContext) THEN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserTHEN, 0)
+}
+
+func (s *ThenScopeContext) ThenExpressionList() IThenExpressionListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IThenExpressionListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IThenExpressionListContext)
+}
+
+func (s *ThenScopeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ThenScopeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ThenScopeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterThenScope(s)
+	}
+}
+
+func (s *ThenScopeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitThenScope(s)
+	}
+}
+
+func (s *ThenScopeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitThenScope(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) ThenScope() (localctx IThenScopeContext) {
+	localctx = NewThenScopeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, grulev3ParserRULE_thenScope)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(306)
+		p.Match(grulev3ParserTHEN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(307)
+		p.ThenExpressionList()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IThenExpressionListContext is an interface to support dynamic dispatch.
+type IThenExpressionListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllThenStatement() []IThenStatementContext
+	ThenStatement(i int) IThenStatementContext
+
+	// IsThenExpressionListContext differentiates from other interfaces.
+	IsThenExpressionListContext()
+}
+
+type ThenExpressionListContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyThenExpressionListContext() *ThenExpressionListContext {
+	var p = new(ThenExpressionListContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_thenExpressionList
+	return p
+}
+
+func InitEmptyThenExpressionListContext(p *ThenExpressionListContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_thenExpressionList
+}
+
+func (*ThenExpressionListContext) IsThenExpressionListContext() {}
+
+func NewThenExpressionListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ThenExpressionListContext {
+	var p = new(ThenExpressionListContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_thenExpressionList
+
+	return p
+}
+
+func (s *ThenExpressionListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ThenExpressionListContext) AllThenStatement() []IThenStatementContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IThenStatementContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IThenStatementContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IThenStatementContext); ok {
+			tst[i] = t.(IThenStatementContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ThenExpressionListContext) ThenStatement(i int) IThenStatementContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IThenStatementContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IThenStatementContext)
+}
+
+func (s *ThenExpressionListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ThenExpressionListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ThenExpressionListContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterThenExpressionList(s)
+	}
+}
+
+func (s *ThenExpressionListContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitThenExpressionList(s)
+	}
+}
+
+func (s *ThenExpressionListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitThenExpressionList(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) ThenExpressionList() (localctx IThenExpressionListContext) {
+	localctx = NewThenExpressionListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, grulev3ParserRULE_thenExpressionList)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(310)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&18148538895630344) != 0) || ((int64((_la-68)) & ^0x3f) == 0 && ((int64(1)<<(_la-68))&8031) != 0) {
+		{
+			p.SetState(309)
+			p.ThenStatement()
+		}
+
+		p.SetState(312)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IThenStatementContext is an interface to support dynamic dispatch.
+type IThenStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ThenExpression() IThenExpressionContext
+	SEMICOLON() antlr.TerminalNode
+	LetStatement() ILetStatementContext
+	IfStatement() IIfStatementContext
+
+	// IsThenStatementContext differentiates from other interfaces.
+	IsThenStatementContext()
+}
+
+type ThenStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyThenStatementContext() *ThenStatementContext {
+	var p = new(ThenStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_thenStatement
+	return p
+}
+
+func InitEmptyThenStatementContext(p *ThenStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_thenStatement
+}
+
+func (*ThenStatementContext) IsThenStatementContext() {}
+
+func NewThenStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ThenStatementContext {
+	var p = new(ThenStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_thenStatement
+
+	return p
+}
+
+func (s *ThenStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ThenStatementContext) ThenExpression() IThenExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IThenExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IThenExpressionContext)
+}
+
+func (s *ThenStatementContext) SEMICOLON() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSEMICOLON, 0)
+}
+
+func (s *ThenStatementContext) LetStatement() ILetStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILetStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILetStatementContext)
+}
+
+func (s *ThenStatementContext) IfStatement() IIfStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIfStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIfStatementContext)
+}
+
+func (s *ThenStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ThenStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ThenStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterThenStatement(s)
+	}
+}
+
+func (s *ThenStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitThenStatement(s)
+	}
+}
+
+func (s *ThenStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitThenStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) ThenStatement() (localctx IThenStatementContext) {
+	localctx = NewThenStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, grulev3ParserRULE_thenStatement)
+	p.SetState(321)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 22, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(314)
+			p.ThenExpression()
+		}
+		{
+			p.SetState(315)
+			p.Match(grulev3ParserSEMICOLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(317)
+			p.LetStatement()
+		}
+		{
+			p.SetState(318)
+			p.Match(grulev3ParserSEMICOLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(320)
+			p.IfStatement()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ILetStatementContext is an interface to support dynamic dispatch.
+type ILetStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LET() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	ASSIGN() antlr.TerminalNode
+	Expression() IExpressionContext
+
+	// IsLetStatementContext differentiates from other interfaces.
+	IsLetStatementContext()
+}
+
+type LetStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyLetStatementContext() *LetStatementContext {
+	var p = new(LetStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_letStatement
+	return p
+}
+
+func InitEmptyLetStatementContext(p *LetStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_letStatement
+}
+
+func (*LetStatementContext) IsLetStatementContext() {}
+
+func NewLetStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LetStatementContext {
+	var p = new(LetStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_letStatement
+
+	return p
+}
+
+func (s *LetStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *LetStatementContext) LET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLET, 0)
+}
+
+func (s *LetStatementContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *LetStatementContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserASSIGN, 0)
+}
+
+func (s *LetStatementContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *LetStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LetStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *LetStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterLetStatement(s)
+	}
+}
+
+func (s *LetStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitLetStatement(s)
+	}
+}
+
+func (s *LetStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitLetStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) LetStatement() (localctx ILetStatementContext) {
+	localctx = NewLetStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, grulev3ParserRULE_letStatement)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(323)
+		p.Match(grulev3ParserLET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(324)
+		p.Identifier()
+	}
+	{
+		p.SetState(325)
+		p.Match(grulev3ParserASSIGN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(326)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IIfStatementContext is an interface to support dynamic dispatch.
+type IIfStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IF() antlr.TerminalNode
+	LR_BRACKET() antlr.TerminalNode
+	Expression() IExpressionContext
+	RR_BRACKET() antlr.TerminalNode
+	AllThenBlock() []IThenBlockContext
+	ThenBlock(i int) IThenBlockContext
+	ELSE() antlr.TerminalNode
+	IfStatement() IIfStatementContext
+
+	// IsIfStatementContext differentiates from other interfaces.
+	IsIfStatementContext()
+}
+
+type IfStatementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyIfStatementContext() *IfStatementContext {
+	var p = new(IfStatementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ifStatement
+	return p
+}
+
+func InitEmptyIfStatementContext(p *IfStatementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ifStatement
+}
+
+func (*IfStatementContext) IsIfStatementContext() {}
+
+func NewIfStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IfStatementContext {
+	var p = new(IfStatementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_ifStatement
+
+	return p
+}
+
+func (s *IfStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *IfStatementContext) IF() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserIF, 0)
+}
+
+func (s *IfStatementContext) LR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLR_BRACKET, 0)
+}
+
+func (s *IfStatementContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *IfStatementContext) RR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRR_BRACKET, 0)
+}
+
+func (s *IfStatementContext) AllThenBlock() []IThenBlockContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IThenBlockContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IThenBlockContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IThenBlockContext); ok {
+			tst[i] = t.(IThenBlockContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *IfStatementContext) ThenBlock(i int) IThenBlockContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IThenBlockContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IThenBlockContext)
+}
+
+func (s *IfStatementContext) ELSE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserELSE, 0)
+}
+
+func (s *IfStatementContext) IfStatement() IIfStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIfStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIfStatementContext)
+}
+
+func (s *IfStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IfStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *IfStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterIfStatement(s)
+	}
+}
+
+func (s *IfStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitIfStatement(s)
+	}
+}
+
+func (s *IfStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitIfStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) IfStatement() (localctx IIfStatementContext) {
+	localctx = NewIfStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, grulev3ParserRULE_ifStatement)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(328)
+		p.Match(grulev3ParserIF)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(329)
+		p.Match(grulev3ParserLR_BRACKET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(330)
+		p.expression(0)
+	}
+	{
+		p.SetState(331)
+		p.Match(grulev3ParserRR_BRACKET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(332)
+		p.ThenBlock()
+	}
+	p.SetState(338)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 24, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(333)
+			p.Match(grulev3ParserELSE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(336)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetTokenStream().LA(1) {
+		case grulev3ParserIF:
+			{
+				p.SetState(334)
+				p.IfStatement()
+			}
+
+		case grulev3ParserLR_BRACE:
+			{
+				p.SetState(335)
+				p.ThenBlock()
+			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IThenBlockContext is an interface to support dynamic dispatch.
+type IThenBlockContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LR_BRACE() antlr.TerminalNode
+	RR_BRACE() antlr.TerminalNode
+	AllThenStatement() []IThenStatementContext
+	ThenStatement(i int) IThenStatementContext
+
+	// IsThenBlockContext differentiates from other interfaces.
+	IsThenBlockContext()
+}
+
+type ThenBlockContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyThenBlockContext() *ThenBlockContext {
+	var p = new(ThenBlockContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_thenBlock
+	return p
+}
+
+func InitEmptyThenBlockContext(p *ThenBlockContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_thenBlock
+}
+
+func (*ThenBlockContext) IsThenBlockContext() {}
+
+func NewThenBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ThenBlockContext {
+	var p = new(ThenBlockContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_thenBlock
+
+	return p
+}
+
+func (s *ThenBlockContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ThenBlockContext) LR_BRACE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLR_BRACE, 0)
+}
+
+func (s *ThenBlockContext) RR_BRACE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRR_BRACE, 0)
+}
+
+func (s *ThenBlockContext) AllThenStatement() []IThenStatementContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IThenStatementContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IThenStatementContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IThenStatementContext); ok {
+			tst[i] = t.(IThenStatementContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ThenBlockContext) ThenStatement(i int) IThenStatementContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IThenStatementContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IThenStatementContext)
+}
+
+func (s *ThenBlockContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ThenBlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ThenBlockContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterThenBlock(s)
+	}
+}
+
+func (s *ThenBlockContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitThenBlock(s)
+	}
+}
+
+func (s *ThenBlockContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitThenBlock(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) ThenBlock() (localctx IThenBlockContext) {
+	localctx = NewThenBlockContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, grulev3ParserRULE_thenBlock)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(340)
+		p.Match(grulev3ParserLR_BRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(344)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&18148538895630344) != 0) || ((int64((_la-68)) & ^0x3f) == 0 && ((int64(1)<<(_la-68))&8031) != 0) {
+		{
+			p.SetState(341)
+			p.ThenStatement()
+		}
+
+		p.SetState(346)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(347)
+		p.Match(grulev3ParserRR_BRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IThenExpressionContext is an interface to support dynamic dispatch.
+type IThenExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Assignment() IAssignmentContext
+	ExpressionAtom() IExpressionAtomContext
+
+	// IsThenExpressionContext differentiates from other interfaces.
+	IsThenExpressionContext()
+}
+
+type ThenExpressionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyThenExpressionContext() *ThenExpressionContext {
+	var p = new(ThenExpressionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_thenExpression
+	return p
+}
+
+func InitEmptyThenExpressionContext(p *ThenExpressionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_thenExpression
+}
+
+func (*ThenExpressionContext) IsThenExpressionContext() {}
+
+func NewThenExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ThenExpressionContext {
+	var p = new(ThenExpressionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_thenExpression
+
+	return p
+}
+
+func (s *ThenExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ThenExpressionContext) Assignment() IAssignmentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAssignmentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAssignmentContext)
+}
+
+func (s *ThenExpressionContext) ExpressionAtom() IExpressionAtomContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionAtomContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionAtomContext)
+}
+
+func (s *ThenExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ThenExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ThenExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterThenExpression(s)
+	}
+}
+
+func (s *ThenExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitThenExpression(s)
+	}
+}
+
+func (s *ThenExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitThenExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) ThenExpression() (localctx IThenExpressionContext) {
+	localctx = NewThenExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, grulev3ParserRULE_thenExpression)
+	p.SetState(351)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 26, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(349)
+			p.Assignment()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(350)
+			p.expressionAtom(0)
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IAssignmentContext is an interface to support dynamic dispatch.
+type IAssignmentContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Variable() IVariableContext
+	Expression() IExpressionContext
+	ASSIGN() antlr.TerminalNode
+	PLUS_ASIGN() antlr.TerminalNode
+	MINUS_ASIGN() antlr.TerminalNode
+	DIV_ASIGN() antlr.TerminalNode
+	MUL_ASIGN() antlr.TerminalNode
+
+	// IsAssignmentContext differentiates from other interfaces.
+	IsAssignmentContext()
+}
+
+type AssignmentContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAssignmentContext() *AssignmentContext {
+	var p = new(AssignmentContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_assignment
+	return p
+}
+
+func InitEmptyAssignmentContext(p *AssignmentContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_assignment
+}
+
+func (*AssignmentContext) IsAssignmentContext() {}
+
+func NewAssignmentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AssignmentContext {
+	var p = new(AssignmentContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_assignment
+
+	return p
+}
+
+func (s *AssignmentContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AssignmentContext) Variable() IVariableContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IVariableContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVariableContext)
+}
+
+func (s *AssignmentContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AssignmentContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserASSIGN, 0)
+}
+
+func (s *AssignmentContext) PLUS_ASIGN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserPLUS_ASIGN, 0)
+}
+
+func (s *AssignmentContext) MINUS_ASIGN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserMINUS_ASIGN, 0)
+}
+
+func (s *AssignmentContext) DIV_ASIGN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserDIV_ASIGN, 0)
+}
+
+func (s *AssignmentContext) MUL_ASIGN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserMUL_ASIGN, 0)
+}
+
+func (s *AssignmentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AssignmentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AssignmentContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterAssignment(s)
+	}
+}
+
+func (s *AssignmentContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitAssignment(s)
+	}
+}
+
+func (s *AssignmentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitAssignment(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) Assignment() (localctx IAssignmentContext) {
+	localctx = NewAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, grulev3ParserRULE_assignment)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(353)
+		p.variable(0)
+	}
+	{
+		p.SetState(354)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2233785415175766016) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	{
+		p.SetState(355)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IExpressionContext is an interface to support dynamic dispatch.
+type IExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	MINUS() antlr.TerminalNode
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	LR_BRACKET() antlr.TerminalNode
+	RR_BRACKET() antlr.TerminalNode
+	NEGATION() antlr.TerminalNode
+	ExpressionAtom() IExpressionAtomContext
+	POW() antlr.TerminalNode
+	MulDivOperators() IMulDivOperatorsContext
+	AddMinusOperators() IAddMinusOperatorsContext
+	ComparisonOperator() IComparisonOperatorContext
+	BETWEEN() antlr.TerminalNode
+	AND_WORD() antlr.TerminalNode
+	AndLogicOperator() IAndLogicOperatorContext
+	OrLogicOperator() IOrLogicOperatorContext
+	NULL_COALESCE() antlr.TerminalNode
+	QUESTION() antlr.TerminalNode
+	COLON() antlr.TerminalNode
+
+	// IsExpressionContext differentiates from other interfaces.
+	IsExpressionContext()
+}
+
+type ExpressionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExpressionContext() *ExpressionContext {
+	var p = new(ExpressionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_expression
+	return p
+}
+
+func InitEmptyExpressionContext(p *ExpressionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_expression
+}
+
+func (*ExpressionContext) IsExpressionContext() {}
+
+func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
+	var p = new(ExpressionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_expression
+
+	return p
+}
+
+func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExpressionContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserMINUS, 0)
+}
+
+func (s *ExpressionContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ExpressionContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ExpressionContext) LR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLR_BRACKET, 0)
+}
+
+func (s *ExpressionContext) RR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRR_BRACKET, 0)
+}
+
+func (s *ExpressionContext) NEGATION() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserNEGATION, 0)
+}
+
+func (s *ExpressionContext) ExpressionAtom() IExpressionAtomContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionAtomContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionAtomContext)
+}
+
+func (s *ExpressionContext) POW() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserPOW, 0)
+}
+
+func (s *ExpressionContext) MulDivOperators() IMulDivOperatorsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMulDivOperatorsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMulDivOperatorsContext)
+}
+
+func (s *ExpressionContext) AddMinusOperators() IAddMinusOperatorsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAddMinusOperatorsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAddMinusOperatorsContext)
+}
+
+func (s *ExpressionContext) ComparisonOperator() IComparisonOperatorContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IComparisonOperatorContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IComparisonOperatorContext)
+}
+
+func (s *ExpressionContext) BETWEEN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserBETWEEN, 0)
+}
+
+func (s *ExpressionContext) AND_WORD() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserAND_WORD, 0)
+}
+
+func (s *ExpressionContext) AndLogicOperator() IAndLogicOperatorContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAndLogicOperatorContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAndLogicOperatorContext)
+}
+
+func (s *ExpressionContext) OrLogicOperator() IOrLogicOperatorContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOrLogicOperatorContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrLogicOperatorContext)
+}
+
+func (s *ExpressionContext) NULL_COALESCE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserNULL_COALESCE, 0)
+}
+
+func (s *ExpressionContext) QUESTION() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserQUESTION, 0)
+}
+
+func (s *ExpressionContext) COLON() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserCOLON, 0)
+}
+
+func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterExpression(s)
+	}
+}
+
+func (s *ExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitExpression(s)
+	}
+}
+
+func (s *ExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) Expression() (localctx IExpressionContext) {
+	return p.expression(0)
+}
+
+func (p *grulev3Parser) expression(_p int) (localctx IExpressionContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+
+	_parentState := p.GetState()
+	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IExpressionContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 66
+	p.EnterRecursionRule(localctx, 66, grulev3ParserRULE_expression, _p)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(368)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 28, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(358)
+			p.Match(grulev3ParserMINUS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(359)
+			p.expression(11)
+		}
+
+	case 2:
+		p.SetState(361)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == grulev3ParserNEGATION {
+			{
+				p.SetState(360)
+				p.Match(grulev3ParserNEGATION)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+		{
+			p.SetState(363)
+			p.Match(grulev3ParserLR_BRACKET)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(364)
+			p.expression(0)
+		}
+		{
+			p.SetState(365)
+			p.Match(grulev3ParserRR_BRACKET)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 3:
+		{
+			p.SetState(367)
+			p.expressionAtom(0)
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(410)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 30, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			p.SetState(408)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 29, p.GetParserRuleContext()) {
+			case 1:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
+				p.SetState(370)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(371)
+					p.Match(grulev3ParserPOW)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(372)
+					p.expression(12)
+				}
+
+			case 2:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
+				p.SetState(373)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(374)
+					p.MulDivOperators()
+				}
+				{
+					p.SetState(375)
+					p.expression(11)
+				}
+
+			case 3:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
+				p.SetState(377)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(378)
+					p.AddMinusOperators()
+				}
+				{
+					p.SetState(379)
+					p.expression(10)
+				}
+
+			case 4:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
+				p.SetState(381)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(382)
+					p.ComparisonOperator()
+				}
+				{
+					p.SetState(383)
+					p.expression(9)
+				}
+
+			case 5:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
+				p.SetState(385)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(386)
+					p.Match(grulev3ParserBETWEEN)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(387)
+					p.expression(0)
+				}
+				{
+					p.SetState(388)
+					p.Match(grulev3ParserAND_WORD)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(389)
+					p.expression(8)
+				}
+
+			case 6:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
+				p.SetState(391)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(392)
+					p.AndLogicOperator()
+				}
+				{
+					p.SetState(393)
+					p.expression(7)
+				}
+
+			case 7:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
+				p.SetState(395)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(396)
+					p.OrLogicOperator()
+				}
+				{
+					p.SetState(397)
+					p.expression(6)
+				}
+
+			case 8:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
+				p.SetState(399)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(400)
+					p.Match(grulev3ParserNULL_COALESCE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(401)
+					p.expression(4)
+				}
+
+			case 9:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
+				p.SetState(402)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(403)
+					p.Match(grulev3ParserQUESTION)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(404)
+					p.expression(0)
+				}
+				{
+					p.SetState(405)
+					p.Match(grulev3ParserCOLON)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(406)
+					p.expression(3)
+				}
+
+			case antlr.ATNInvalidAltNumber:
+				goto errorExit
+			}
+
+		}
+		p.SetState(412)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 30, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.UnrollRecursionContexts(_parentctx)
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IMulDivOperatorsContext is an interface to support dynamic dispatch.
+type IMulDivOperatorsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	MUL() antlr.TerminalNode
+	DIV() antlr.TerminalNode
+	MOD() antlr.TerminalNode
+	INT_DIV() antlr.TerminalNode
+
+	// IsMulDivOperatorsContext differentiates from other interfaces.
+	IsMulDivOperatorsContext()
+}
+
+type MulDivOperatorsContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMulDivOperatorsContext() *MulDivOperatorsContext {
+	var p = new(MulDivOperatorsContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_mulDivOperators
+	return p
+}
+
+func InitEmptyMulDivOperatorsContext(p *MulDivOperatorsContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_mulDivOperators
+}
+
+func (*MulDivOperatorsContext) IsMulDivOperatorsContext() {}
+
+func NewMulDivOperatorsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MulDivOperatorsContext {
+	var p = new(MulDivOperatorsContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_mulDivOperators
+
+	return p
+}
+
+func (s *MulDivOperatorsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MulDivOperatorsContext) MUL() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserMUL, 0)
+}
+
+func (s *MulDivOperatorsContext) DIV() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserDIV, 0)
+}
+
+func (s *MulDivOperatorsContext) MOD() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserMOD, 0)
+}
+
+func (s *MulDivOperatorsContext) INT_DIV() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserINT_DIV, 0)
+}
+
+func (s *MulDivOperatorsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MulDivOperatorsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MulDivOperatorsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterMulDivOperators(s)
+	}
+}
+
+func (s *MulDivOperatorsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitMulDivOperators(s)
+	}
+}
+
+func (s *MulDivOperatorsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitMulDivOperators(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) MulDivOperators() (localctx IMulDivOperatorsContext) {
+	localctx = NewMulDivOperatorsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, grulev3ParserRULE_mulDivOperators)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(413)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&464) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IAddMinusOperatorsContext is an interface to support dynamic dispatch.
+type IAddMinusOperatorsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	PLUS() antlr.TerminalNode
+	MINUS() antlr.TerminalNode
+	BITAND() antlr.TerminalNode
+	BITOR() antlr.TerminalNode
+
+	// IsAddMinusOperatorsContext differentiates from other interfaces.
+	IsAddMinusOperatorsContext()
+}
+
+type AddMinusOperatorsContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAddMinusOperatorsContext() *AddMinusOperatorsContext {
+	var p = new(AddMinusOperatorsContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_addMinusOperators
+	return p
+}
+
+func InitEmptyAddMinusOperatorsContext(p *AddMinusOperatorsContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_addMinusOperators
+}
+
+func (*AddMinusOperatorsContext) IsAddMinusOperatorsContext() {}
+
+func NewAddMinusOperatorsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AddMinusOperatorsContext {
+	var p = new(AddMinusOperatorsContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_addMinusOperators
+
+	return p
+}
+
+func (s *AddMinusOperatorsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AddMinusOperatorsContext) PLUS() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserPLUS, 0)
+}
+
+func (s *AddMinusOperatorsContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserMINUS, 0)
+}
+
+func (s *AddMinusOperatorsContext) BITAND() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserBITAND, 0)
+}
+
+func (s *AddMinusOperatorsContext) BITOR() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserBITOR, 0)
+}
+
+func (s *AddMinusOperatorsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AddMinusOperatorsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AddMinusOperatorsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterAddMinusOperators(s)
+	}
+}
+
+func (s *AddMinusOperatorsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitAddMinusOperators(s)
+	}
+}
+
+func (s *AddMinusOperatorsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitAddMinusOperators(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) AddMinusOperators() (localctx IAddMinusOperatorsContext) {
+	localctx = NewAddMinusOperatorsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, grulev3ParserRULE_addMinusOperators)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(415)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == grulev3ParserPLUS || _la == grulev3ParserMINUS || _la == grulev3ParserBITAND || _la == grulev3ParserBITOR) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IComparisonOperatorContext is an interface to support dynamic dispatch.
+type IComparisonOperatorContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	GT() antlr.TerminalNode
+	LT() antlr.TerminalNode
+	GTE() antlr.TerminalNode
+	LTE() antlr.TerminalNode
+	EQUALS() antlr.TerminalNode
+	NOTEQUALS() antlr.TerminalNode
+	IN() antlr.TerminalNode
+	NOT() antlr.TerminalNode
+	MATCHES() antlr.TerminalNode
+
+	// IsComparisonOperatorContext differentiates from other interfaces.
+	IsComparisonOperatorContext()
+}
+
+type ComparisonOperatorContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyComparisonOperatorContext() *ComparisonOperatorContext {
+	var p = new(ComparisonOperatorContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_comparisonOperator
+	return p
+}
+
+func InitEmptyComparisonOperatorContext(p *ComparisonOperatorContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_comparisonOperator
+}
+
+func (*ComparisonOperatorContext) IsComparisonOperatorContext() {}
+
+func NewComparisonOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ComparisonOperatorContext {
+	var p = new(ComparisonOperatorContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_comparisonOperator
+
+	return p
+}
+
+func (s *ComparisonOperatorContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ComparisonOperatorContext) GT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserGT, 0)
+}
+
+func (s *ComparisonOperatorContext) LT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLT, 0)
+}
+
+func (s *ComparisonOperatorContext) GTE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserGTE, 0)
+}
+
+func (s *ComparisonOperatorContext) LTE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLTE, 0)
+}
+
+func (s *ComparisonOperatorContext) EQUALS() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserEQUALS, 0)
+}
+
+func (s *ComparisonOperatorContext) NOTEQUALS() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserNOTEQUALS, 0)
+}
+
+func (s *ComparisonOperatorContext) IN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserIN, 0)
+}
+
+func (s *ComparisonOperatorContext) NOT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserNOT, 0)
+}
+
+func (s *ComparisonOperatorContext) MATCHES() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserMATCHES, 0)
+}
+
+func (s *ComparisonOperatorContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ComparisonOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ComparisonOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterComparisonOperator(s)
+	}
+}
 
-func (s *AssignmentContext) PLUS_ASIGN() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserPLUS_ASIGN, 0)
+func (s *ComparisonOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitComparisonOperator(s)
+	}
+}
+
+func (s *ComparisonOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitComparisonOperator(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) ComparisonOperator() (localctx IComparisonOperatorContext) {
+	localctx = NewComparisonOperatorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, grulev3ParserRULE_comparisonOperator)
+	p.SetState(427)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case grulev3ParserGT:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(417)
+			p.Match(grulev3ParserGT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case grulev3ParserLT:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(418)
+			p.Match(grulev3ParserLT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case grulev3ParserGTE:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(419)
+			p.Match(grulev3ParserGTE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case grulev3ParserLTE:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(420)
+			p.Match(grulev3ParserLTE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case grulev3ParserEQUALS:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(421)
+			p.Match(grulev3ParserEQUALS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case grulev3ParserNOTEQUALS:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(422)
+			p.Match(grulev3ParserNOTEQUALS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case grulev3ParserIN:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(423)
+			p.Match(grulev3ParserIN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case grulev3ParserNOT:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(424)
+			p.Match(grulev3ParserNOT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(425)
+			p.Match(grulev3ParserIN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case grulev3ParserMATCHES:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(426)
+			p.Match(grulev3ParserMATCHES)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IAndLogicOperatorContext is an interface to support dynamic dispatch.
+type IAndLogicOperatorContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AND() antlr.TerminalNode
+
+	// IsAndLogicOperatorContext differentiates from other interfaces.
+	IsAndLogicOperatorContext()
+}
+
+type AndLogicOperatorContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAndLogicOperatorContext() *AndLogicOperatorContext {
+	var p = new(AndLogicOperatorContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_andLogicOperator
+	return p
+}
+
+func InitEmptyAndLogicOperatorContext(p *AndLogicOperatorContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_andLogicOperator
+}
+
+func (*AndLogicOperatorContext) IsAndLogicOperatorContext() {}
+
+func NewAndLogicOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AndLogicOperatorContext {
+	var p = new(AndLogicOperatorContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_andLogicOperator
+
+	return p
+}
+
+func (s *AndLogicOperatorContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AndLogicOperatorContext) AND() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserAND, 0)
+}
+
+func (s *AndLogicOperatorContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AndLogicOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AndLogicOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterAndLogicOperator(s)
+	}
+}
+
+func (s *AndLogicOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitAndLogicOperator(s)
+	}
+}
+
+func (s *AndLogicOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitAndLogicOperator(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) AndLogicOperator() (localctx IAndLogicOperatorContext) {
+	localctx = NewAndLogicOperatorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, grulev3ParserRULE_andLogicOperator)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(429)
+		p.Match(grulev3ParserAND)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IOrLogicOperatorContext is an interface to support dynamic dispatch.
+type IOrLogicOperatorContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	OR() antlr.TerminalNode
+
+	// IsOrLogicOperatorContext differentiates from other interfaces.
+	IsOrLogicOperatorContext()
+}
+
+type OrLogicOperatorContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOrLogicOperatorContext() *OrLogicOperatorContext {
+	var p = new(OrLogicOperatorContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_orLogicOperator
+	return p
+}
+
+func InitEmptyOrLogicOperatorContext(p *OrLogicOperatorContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_orLogicOperator
 }
 
-func (s *AssignmentContext) MINUS_ASIGN() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserMINUS_ASIGN, 0)
-}
+func (*OrLogicOperatorContext) IsOrLogicOperatorContext() {}
+
+func NewOrLogicOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrLogicOperatorContext {
+	var p = new(OrLogicOperatorContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_orLogicOperator
 
-func (s *AssignmentContext) DIV_ASIGN() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserDIV_ASIGN, 0)
+	return p
 }
 
-func (s *AssignmentContext) MUL_ASIGN() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserMUL_ASIGN, 0)
+func (s *OrLogicOperatorContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OrLogicOperatorContext) OR() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserOR, 0)
 }
 
-func (s *AssignmentContext) GetRuleContext() antlr.RuleContext {
+func (s *OrLogicOperatorContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AssignmentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OrLogicOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AssignmentContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *OrLogicOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterAssignment(s)
+		listenerT.EnterOrLogicOperator(s)
 	}
 }
 
-func (s *AssignmentContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *OrLogicOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitAssignment(s)
+		listenerT.ExitOrLogicOperator(s)
 	}
 }
 
-func (s *AssignmentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OrLogicOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitAssignment(s)
+		return t.VisitOrLogicOperator(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) Assignment() (localctx IAssignmentContext) {
-	localctx = NewAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, grulev3ParserRULE_assignment)
-	var _la int
-
+func (p *grulev3Parser) OrLogicOperator() (localctx IOrLogicOperatorContext) {
+	localctx = NewOrLogicOperatorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, grulev3ParserRULE_orLogicOperator)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(111)
-		p.variable(0)
-	}
-	{
-		p.SetState(112)
-		_la = p.GetTokenStream().LA(1)
-
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2080374784) != 0) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+		p.SetState(431)
+		p.Match(grulev3ParserOR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
-	{
-		p.SetState(113)
-		p.expression(0)
-	}
 
 errorExit:
 	if p.HasError() {
@@ -1830,97 +7876,83 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IExpressionContext is an interface to support dynamic dispatch.
-type IExpressionContext interface {
+// IExpressionAtomContext is an interface to support dynamic dispatch.
+type IExpressionAtomContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	LR_BRACKET() antlr.TerminalNode
-	AllExpression() []IExpressionContext
-	Expression(i int) IExpressionContext
-	RR_BRACKET() antlr.TerminalNode
+	Constant() IConstantContext
+	Variable() IVariableContext
+	FunctionCall() IFunctionCallContext
+	Quantifier() IQuantifierContext
+	Aggregate() IAggregateContext
 	NEGATION() antlr.TerminalNode
 	ExpressionAtom() IExpressionAtomContext
-	MulDivOperators() IMulDivOperatorsContext
-	AddMinusOperators() IAddMinusOperatorsContext
-	ComparisonOperator() IComparisonOperatorContext
-	AndLogicOperator() IAndLogicOperatorContext
-	OrLogicOperator() IOrLogicOperatorContext
+	MethodCall() IMethodCallContext
+	MemberVariable() IMemberVariableContext
+	ArrayMapSelector() IArrayMapSelectorContext
 
-	// IsExpressionContext differentiates from other interfaces.
-	IsExpressionContext()
+	// IsExpressionAtomContext differentiates from other interfaces.
+	IsExpressionAtomContext()
 }
 
-type ExpressionContext struct {
+type ExpressionAtomContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExpressionContext() *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewEmptyExpressionAtomContext() *ExpressionAtomContext {
+	var p = new(ExpressionAtomContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_expression
+	p.RuleIndex = grulev3ParserRULE_expressionAtom
 	return p
 }
 
-func InitEmptyExpressionContext(p *ExpressionContext) {
+func InitEmptyExpressionAtomContext(p *ExpressionAtomContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_expression
+	p.RuleIndex = grulev3ParserRULE_expressionAtom
 }
 
-func (*ExpressionContext) IsExpressionContext() {}
+func (*ExpressionAtomContext) IsExpressionAtomContext() {}
 
-func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewExpressionAtomContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionAtomContext {
+	var p = new(ExpressionAtomContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_expression
+	p.RuleIndex = grulev3ParserRULE_expressionAtom
 
 	return p
 }
 
-func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ExpressionContext) LR_BRACKET() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserLR_BRACKET, 0)
-}
+func (s *ExpressionAtomContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExpressionContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
+func (s *ExpressionAtomContext) Constant() IConstantContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IConstantContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IConstantContext)
 }
 
-func (s *ExpressionContext) Expression(i int) IExpressionContext {
+func (s *ExpressionAtomContext) Variable() IVariableContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IVariableContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -1928,21 +7960,29 @@ func (s *ExpressionContext) Expression(i int) IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IVariableContext)
 }
 
-func (s *ExpressionContext) RR_BRACKET() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserRR_BRACKET, 0)
-}
+func (s *ExpressionAtomContext) FunctionCall() IFunctionCallContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionCallContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *ExpressionContext) NEGATION() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserNEGATION, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionCallContext)
 }
 
-func (s *ExpressionContext) ExpressionAtom() IExpressionAtomContext {
+func (s *ExpressionAtomContext) Quantifier() IQuantifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionAtomContext); ok {
+		if _, ok := ctx.(IQuantifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1952,13 +7992,13 @@ func (s *ExpressionContext) ExpressionAtom() IExpressionAtomContext {
 		return nil
 	}
 
-	return t.(IExpressionAtomContext)
+	return t.(IQuantifierContext)
 }
 
-func (s *ExpressionContext) MulDivOperators() IMulDivOperatorsContext {
+func (s *ExpressionAtomContext) Aggregate() IAggregateContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMulDivOperatorsContext); ok {
+		if _, ok := ctx.(IAggregateContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1968,13 +8008,17 @@ func (s *ExpressionContext) MulDivOperators() IMulDivOperatorsContext {
 		return nil
 	}
 
-	return t.(IMulDivOperatorsContext)
+	return t.(IAggregateContext)
 }
 
-func (s *ExpressionContext) AddMinusOperators() IAddMinusOperatorsContext {
+func (s *ExpressionAtomContext) NEGATION() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserNEGATION, 0)
+}
+
+func (s *ExpressionAtomContext) ExpressionAtom() IExpressionAtomContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAddMinusOperatorsContext); ok {
+		if _, ok := ctx.(IExpressionAtomContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1984,13 +8028,13 @@ func (s *ExpressionContext) AddMinusOperators() IAddMinusOperatorsContext {
 		return nil
 	}
 
-	return t.(IAddMinusOperatorsContext)
+	return t.(IExpressionAtomContext)
 }
 
-func (s *ExpressionContext) ComparisonOperator() IComparisonOperatorContext {
+func (s *ExpressionAtomContext) MethodCall() IMethodCallContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IComparisonOperatorContext); ok {
+		if _, ok := ctx.(IMethodCallContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2000,13 +8044,13 @@ func (s *ExpressionContext) ComparisonOperator() IComparisonOperatorContext {
 		return nil
 	}
 
-	return t.(IComparisonOperatorContext)
+	return t.(IMethodCallContext)
 }
 
-func (s *ExpressionContext) AndLogicOperator() IAndLogicOperatorContext {
+func (s *ExpressionAtomContext) MemberVariable() IMemberVariableContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAndLogicOperatorContext); ok {
+		if _, ok := ctx.(IMemberVariableContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2016,13 +8060,13 @@ func (s *ExpressionContext) AndLogicOperator() IAndLogicOperatorContext {
 		return nil
 	}
 
-	return t.(IAndLogicOperatorContext)
+	return t.(IMemberVariableContext)
 }
 
-func (s *ExpressionContext) OrLogicOperator() IOrLogicOperatorContext {
+func (s *ExpressionAtomContext) ArrayMapSelector() IArrayMapSelectorContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IOrLogicOperatorContext); ok {
+		if _, ok := ctx.(IArrayMapSelectorContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2032,120 +8076,116 @@ func (s *ExpressionContext) OrLogicOperator() IOrLogicOperatorContext {
 		return nil
 	}
 
-	return t.(IOrLogicOperatorContext)
+	return t.(IArrayMapSelectorContext)
 }
 
-func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *ExpressionAtomContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ExpressionAtomContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ExpressionAtomContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterExpression(s)
+		listenerT.EnterExpressionAtom(s)
 	}
 }
 
-func (s *ExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ExpressionAtomContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitExpression(s)
+		listenerT.ExitExpressionAtom(s)
 	}
 }
 
-func (s *ExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ExpressionAtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitExpression(s)
+		return t.VisitExpressionAtom(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) Expression() (localctx IExpressionContext) {
-	return p.expression(0)
+func (p *grulev3Parser) ExpressionAtom() (localctx IExpressionAtomContext) {
+	return p.expressionAtom(0)
 }
 
-func (p *grulev3Parser) expression(_p int) (localctx IExpressionContext) {
+func (p *grulev3Parser) expressionAtom(_p int) (localctx IExpressionAtomContext) {
 	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
 
 	_parentState := p.GetState()
-	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx IExpressionContext = localctx
+	localctx = NewExpressionAtomContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IExpressionAtomContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 20
-	p.EnterRecursionRule(localctx, 20, grulev3ParserRULE_expression, _p)
-	var _la int
-
+	_startState := 78
+	p.EnterRecursionRule(localctx, 78, grulev3ParserRULE_expressionAtom, _p)
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(124)
+	p.SetState(441)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 6, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 32, p.GetParserRuleContext()) {
 	case 1:
-		p.SetState(117)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+		{
+			p.SetState(434)
+			p.Constant()
 		}
-		_la = p.GetTokenStream().LA(1)
 
-		if _la == grulev3ParserNEGATION {
-			{
-				p.SetState(116)
-				p.Match(grulev3ParserNEGATION)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
+	case 2:
+		{
+			p.SetState(435)
+			p.variable(0)
+		}
 
+	case 3:
+		{
+			p.SetState(436)
+			p.FunctionCall()
 		}
+
+	case 4:
 		{
-			p.SetState(119)
-			p.Match(grulev3ParserLR_BRACKET)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(437)
+			p.Quantifier()
 		}
+
+	case 5:
 		{
-			p.SetState(120)
-			p.expression(0)
+			p.SetState(438)
+			p.Aggregate()
 		}
+
+	case 6:
 		{
-			p.SetState(121)
-			p.Match(grulev3ParserRR_BRACKET)
+			p.SetState(439)
+			p.Match(grulev3ParserNEGATION)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-
-	case 2:
 		{
-			p.SetState(123)
-			p.expressionAtom(0)
+			p.SetState(440)
+			p.expressionAtom(1)
 		}
 
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(148)
+	p.SetState(451)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 8, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -2155,101 +8195,53 @@ func (p *grulev3Parser) expression(_p int) (localctx IExpressionContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(146)
+			p.SetState(449)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 7, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 33, p.GetParserRuleContext()) {
 			case 1:
-				localctx = NewExpressionContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
-				p.SetState(126)
+				localctx = NewExpressionAtomContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expressionAtom)
+				p.SetState(443)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(127)
-					p.MulDivOperators()
-				}
-				{
-					p.SetState(128)
-					p.expression(8)
+					p.SetState(444)
+					p.MethodCall()
 				}
 
 			case 2:
-				localctx = NewExpressionContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
-				p.SetState(130)
+				localctx = NewExpressionAtomContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expressionAtom)
+				p.SetState(445)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(131)
-					p.AddMinusOperators()
-				}
-				{
-					p.SetState(132)
-					p.expression(7)
+					p.SetState(446)
+					p.MemberVariable()
 				}
 
 			case 3:
-				localctx = NewExpressionContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
-				p.SetState(134)
-
-				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(135)
-					p.ComparisonOperator()
-				}
-				{
-					p.SetState(136)
-					p.expression(6)
-				}
-
-			case 4:
-				localctx = NewExpressionContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
-				p.SetState(138)
-
-				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(139)
-					p.AndLogicOperator()
-				}
-				{
-					p.SetState(140)
-					p.expression(5)
-				}
-
-			case 5:
-				localctx = NewExpressionContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
-				p.SetState(142)
+				localctx = NewExpressionAtomContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expressionAtom)
+				p.SetState(447)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(143)
-					p.OrLogicOperator()
-				}
-				{
-					p.SetState(144)
-					p.expression(4)
+					p.SetState(448)
+					p.ArrayMapSelector()
 				}
 
 			case antlr.ATNInvalidAltNumber:
@@ -2257,12 +8249,12 @@ func (p *grulev3Parser) expression(_p int) (localctx IExpressionContext) {
 			}
 
 		}
-		p.SetState(150)
+		p.SetState(453)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 8, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -2281,238 +8273,301 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IMulDivOperatorsContext is an interface to support dynamic dispatch.
-type IMulDivOperatorsContext interface {
+// IConstantContext is an interface to support dynamic dispatch.
+type IConstantContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	MUL() antlr.TerminalNode
-	DIV() antlr.TerminalNode
-	MOD() antlr.TerminalNode
+	StringLiteral() IStringLiteralContext
+	IntegerLiteral() IIntegerLiteralContext
+	FloatLiteral() IFloatLiteralContext
+	BooleanLiteral() IBooleanLiteralContext
+	NIL_LITERAL() antlr.TerminalNode
+	DurationLiteral() IDurationLiteralContext
+	DateLiteral() IDateLiteralContext
+	ListLiteral() IListLiteralContext
+	MapLiteral() IMapLiteralContext
 
-	// IsMulDivOperatorsContext differentiates from other interfaces.
-	IsMulDivOperatorsContext()
+	// IsConstantContext differentiates from other interfaces.
+	IsConstantContext()
 }
 
-type MulDivOperatorsContext struct {
+type ConstantContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMulDivOperatorsContext() *MulDivOperatorsContext {
-	var p = new(MulDivOperatorsContext)
+func NewEmptyConstantContext() *ConstantContext {
+	var p = new(ConstantContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_mulDivOperators
+	p.RuleIndex = grulev3ParserRULE_constant
 	return p
 }
 
-func InitEmptyMulDivOperatorsContext(p *MulDivOperatorsContext) {
+func InitEmptyConstantContext(p *ConstantContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_mulDivOperators
+	p.RuleIndex = grulev3ParserRULE_constant
 }
 
-func (*MulDivOperatorsContext) IsMulDivOperatorsContext() {}
+func (*ConstantContext) IsConstantContext() {}
 
-func NewMulDivOperatorsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MulDivOperatorsContext {
-	var p = new(MulDivOperatorsContext)
+func NewConstantContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstantContext {
+	var p = new(ConstantContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_mulDivOperators
+	p.RuleIndex = grulev3ParserRULE_constant
 
 	return p
 }
 
-func (s *MulDivOperatorsContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *MulDivOperatorsContext) MUL() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserMUL, 0)
-}
-
-func (s *MulDivOperatorsContext) DIV() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserDIV, 0)
-}
+func (s *ConstantContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MulDivOperatorsContext) MOD() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserMOD, 0)
-}
+func (s *ConstantContext) StringLiteral() IStringLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStringLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *MulDivOperatorsContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *MulDivOperatorsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+	return t.(IStringLiteralContext)
 }
 
-func (s *MulDivOperatorsContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterMulDivOperators(s)
+func (s *ConstantContext) IntegerLiteral() IIntegerLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIntegerLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
 	}
-}
 
-func (s *MulDivOperatorsContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitMulDivOperators(s)
+	if t == nil {
+		return nil
 	}
-}
-
-func (s *MulDivOperatorsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case grulev3Visitor:
-		return t.VisitMulDivOperators(s)
 
-	default:
-		return t.VisitChildren(s)
-	}
+	return t.(IIntegerLiteralContext)
 }
 
-func (p *grulev3Parser) MulDivOperators() (localctx IMulDivOperatorsContext) {
-	localctx = NewMulDivOperatorsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, grulev3ParserRULE_mulDivOperators)
-	var _la int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(151)
-		_la = p.GetTokenStream().LA(1)
-
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&112) != 0) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+func (s *ConstantContext) FloatLiteral() IFloatLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFloatLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+	if t == nil {
+		return nil
 	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// IAddMinusOperatorsContext is an interface to support dynamic dispatch.
-type IAddMinusOperatorsContext interface {
-	antlr.ParserRuleContext
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+	return t.(IFloatLiteralContext)
+}
 
-	// Getter signatures
-	PLUS() antlr.TerminalNode
-	MINUS() antlr.TerminalNode
-	BITAND() antlr.TerminalNode
-	BITOR() antlr.TerminalNode
+func (s *ConstantContext) BooleanLiteral() IBooleanLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBooleanLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-	// IsAddMinusOperatorsContext differentiates from other interfaces.
-	IsAddMinusOperatorsContext()
-}
+	if t == nil {
+		return nil
+	}
 
-type AddMinusOperatorsContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
+	return t.(IBooleanLiteralContext)
 }
 
-func NewEmptyAddMinusOperatorsContext() *AddMinusOperatorsContext {
-	var p = new(AddMinusOperatorsContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_addMinusOperators
-	return p
+func (s *ConstantContext) NIL_LITERAL() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserNIL_LITERAL, 0)
 }
 
-func InitEmptyAddMinusOperatorsContext(p *AddMinusOperatorsContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_addMinusOperators
-}
+func (s *ConstantContext) DurationLiteral() IDurationLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDurationLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (*AddMinusOperatorsContext) IsAddMinusOperatorsContext() {}
+	if t == nil {
+		return nil
+	}
 
-func NewAddMinusOperatorsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AddMinusOperatorsContext {
-	var p = new(AddMinusOperatorsContext)
+	return t.(IDurationLiteralContext)
+}
 
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+func (s *ConstantContext) DateLiteral() IDateLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDateLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_addMinusOperators
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(IDateLiteralContext)
 }
 
-func (s *AddMinusOperatorsContext) GetParser() antlr.Parser { return s.parser }
+func (s *ConstantContext) ListLiteral() IListLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IListLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *AddMinusOperatorsContext) PLUS() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserPLUS, 0)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *AddMinusOperatorsContext) MINUS() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserMINUS, 0)
+	return t.(IListLiteralContext)
 }
 
-func (s *AddMinusOperatorsContext) BITAND() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserBITAND, 0)
-}
+func (s *ConstantContext) MapLiteral() IMapLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMapLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *AddMinusOperatorsContext) BITOR() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserBITOR, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMapLiteralContext)
 }
 
-func (s *AddMinusOperatorsContext) GetRuleContext() antlr.RuleContext {
+func (s *ConstantContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AddMinusOperatorsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConstantContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AddMinusOperatorsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConstantContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterAddMinusOperators(s)
+		listenerT.EnterConstant(s)
 	}
 }
 
-func (s *AddMinusOperatorsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConstantContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitAddMinusOperators(s)
+		listenerT.ExitConstant(s)
 	}
 }
 
-func (s *AddMinusOperatorsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ConstantContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitAddMinusOperators(s)
+		return t.VisitConstant(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) AddMinusOperators() (localctx IAddMinusOperatorsContext) {
-	localctx = NewAddMinusOperatorsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, grulev3ParserRULE_addMinusOperators)
-	var _la int
+func (p *grulev3Parser) Constant() (localctx IConstantContext) {
+	localctx = NewConstantContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, grulev3ParserRULE_constant)
+	p.SetState(463)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(153)
-		_la = p.GetTokenStream().LA(1)
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 35, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(454)
+			p.StringLiteral()
+		}
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&206158430220) != 0) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(455)
+			p.IntegerLiteral()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(456)
+			p.FloatLiteral()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(457)
+			p.BooleanLiteral()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(458)
+			p.Match(grulev3ParserNIL_LITERAL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(459)
+			p.DurationLiteral()
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(460)
+			p.DateLiteral()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(461)
+			p.ListLiteral()
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(462)
+			p.MapLiteral()
 		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -2528,126 +8583,196 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IComparisonOperatorContext is an interface to support dynamic dispatch.
-type IComparisonOperatorContext interface {
+// IListLiteralContext is an interface to support dynamic dispatch.
+type IListLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	GT() antlr.TerminalNode
-	LT() antlr.TerminalNode
-	GTE() antlr.TerminalNode
-	LTE() antlr.TerminalNode
-	EQUALS() antlr.TerminalNode
-	NOTEQUALS() antlr.TerminalNode
+	LS_BRACKET() antlr.TerminalNode
+	RS_BRACKET() antlr.TerminalNode
+	AllConstant() []IConstantContext
+	Constant(i int) IConstantContext
 
-	// IsComparisonOperatorContext differentiates from other interfaces.
-	IsComparisonOperatorContext()
+	// IsListLiteralContext differentiates from other interfaces.
+	IsListLiteralContext()
 }
 
-type ComparisonOperatorContext struct {
+type ListLiteralContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyComparisonOperatorContext() *ComparisonOperatorContext {
-	var p = new(ComparisonOperatorContext)
+func NewEmptyListLiteralContext() *ListLiteralContext {
+	var p = new(ListLiteralContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_comparisonOperator
+	p.RuleIndex = grulev3ParserRULE_listLiteral
 	return p
 }
 
-func InitEmptyComparisonOperatorContext(p *ComparisonOperatorContext) {
+func InitEmptyListLiteralContext(p *ListLiteralContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_comparisonOperator
+	p.RuleIndex = grulev3ParserRULE_listLiteral
 }
 
-func (*ComparisonOperatorContext) IsComparisonOperatorContext() {}
+func (*ListLiteralContext) IsListLiteralContext() {}
 
-func NewComparisonOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ComparisonOperatorContext {
-	var p = new(ComparisonOperatorContext)
+func NewListLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ListLiteralContext {
+	var p = new(ListLiteralContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_comparisonOperator
+	p.RuleIndex = grulev3ParserRULE_listLiteral
 
 	return p
 }
 
-func (s *ComparisonOperatorContext) GetParser() antlr.Parser { return s.parser }
+func (s *ListLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ComparisonOperatorContext) GT() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserGT, 0)
+func (s *ListLiteralContext) LS_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLS_BRACKET, 0)
 }
 
-func (s *ComparisonOperatorContext) LT() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserLT, 0)
+func (s *ListLiteralContext) RS_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRS_BRACKET, 0)
 }
 
-func (s *ComparisonOperatorContext) GTE() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserGTE, 0)
-}
+func (s *ListLiteralContext) AllConstant() []IConstantContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IConstantContext); ok {
+			len++
+		}
+	}
 
-func (s *ComparisonOperatorContext) LTE() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserLTE, 0)
-}
+	tst := make([]IConstantContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IConstantContext); ok {
+			tst[i] = t.(IConstantContext)
+			i++
+		}
+	}
 
-func (s *ComparisonOperatorContext) EQUALS() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserEQUALS, 0)
+	return tst
 }
 
-func (s *ComparisonOperatorContext) NOTEQUALS() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserNOTEQUALS, 0)
+func (s *ListLiteralContext) Constant(i int) IConstantContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IConstantContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConstantContext)
 }
 
-func (s *ComparisonOperatorContext) GetRuleContext() antlr.RuleContext {
+func (s *ListLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ComparisonOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ListLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ComparisonOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ListLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterComparisonOperator(s)
+		listenerT.EnterListLiteral(s)
 	}
 }
 
-func (s *ComparisonOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ListLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitComparisonOperator(s)
+		listenerT.ExitListLiteral(s)
 	}
 }
 
-func (s *ComparisonOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ListLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitComparisonOperator(s)
+		return t.VisitListLiteral(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) ComparisonOperator() (localctx IComparisonOperatorContext) {
-	localctx = NewComparisonOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, grulev3ParserRULE_comparisonOperator)
+func (p *grulev3Parser) ListLiteral() (localctx IListLiteralContext) {
+	localctx = NewListLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, grulev3ParserRULE_listLiteral)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(155)
+		p.SetState(465)
+		p.Match(grulev3ParserLS_BRACKET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(474)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&61572652269576) != 0) || ((int64((_la-68)) & ^0x3f) == 0 && ((int64(1)<<(_la-68))&8029) != 0) {
+		{
+			p.SetState(466)
+			p.Constant()
+		}
+		p.SetState(471)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&66605547520) != 0) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+		for _la == grulev3ParserT__0 {
+			{
+				p.SetState(467)
+				p.Match(grulev3ParserT__0)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(468)
+				p.Constant()
+			}
+
+			p.SetState(473)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+	{
+		p.SetState(476)
+		p.Match(grulev3ParserRS_BRACKET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
 
@@ -2664,93 +8789,193 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IAndLogicOperatorContext is an interface to support dynamic dispatch.
-type IAndLogicOperatorContext interface {
+// IMapLiteralContext is an interface to support dynamic dispatch.
+type IMapLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AND() antlr.TerminalNode
+	LR_BRACE() antlr.TerminalNode
+	RR_BRACE() antlr.TerminalNode
+	AllMapEntry() []IMapEntryContext
+	MapEntry(i int) IMapEntryContext
 
-	// IsAndLogicOperatorContext differentiates from other interfaces.
-	IsAndLogicOperatorContext()
+	// IsMapLiteralContext differentiates from other interfaces.
+	IsMapLiteralContext()
 }
 
-type AndLogicOperatorContext struct {
+type MapLiteralContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyAndLogicOperatorContext() *AndLogicOperatorContext {
-	var p = new(AndLogicOperatorContext)
+func NewEmptyMapLiteralContext() *MapLiteralContext {
+	var p = new(MapLiteralContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_andLogicOperator
+	p.RuleIndex = grulev3ParserRULE_mapLiteral
 	return p
 }
 
-func InitEmptyAndLogicOperatorContext(p *AndLogicOperatorContext) {
+func InitEmptyMapLiteralContext(p *MapLiteralContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_andLogicOperator
+	p.RuleIndex = grulev3ParserRULE_mapLiteral
 }
 
-func (*AndLogicOperatorContext) IsAndLogicOperatorContext() {}
+func (*MapLiteralContext) IsMapLiteralContext() {}
 
-func NewAndLogicOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AndLogicOperatorContext {
-	var p = new(AndLogicOperatorContext)
+func NewMapLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MapLiteralContext {
+	var p = new(MapLiteralContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_andLogicOperator
+	p.RuleIndex = grulev3ParserRULE_mapLiteral
 
 	return p
 }
 
-func (s *AndLogicOperatorContext) GetParser() antlr.Parser { return s.parser }
+func (s *MapLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *AndLogicOperatorContext) AND() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserAND, 0)
+func (s *MapLiteralContext) LR_BRACE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLR_BRACE, 0)
 }
 
-func (s *AndLogicOperatorContext) GetRuleContext() antlr.RuleContext {
+func (s *MapLiteralContext) RR_BRACE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRR_BRACE, 0)
+}
+
+func (s *MapLiteralContext) AllMapEntry() []IMapEntryContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMapEntryContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMapEntryContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMapEntryContext); ok {
+			tst[i] = t.(IMapEntryContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MapLiteralContext) MapEntry(i int) IMapEntryContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMapEntryContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMapEntryContext)
+}
+
+func (s *MapLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AndLogicOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MapLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AndLogicOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MapLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterAndLogicOperator(s)
+		listenerT.EnterMapLiteral(s)
 	}
 }
 
-func (s *AndLogicOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MapLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitAndLogicOperator(s)
+		listenerT.ExitMapLiteral(s)
 	}
 }
 
-func (s *AndLogicOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MapLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitAndLogicOperator(s)
+		return t.VisitMapLiteral(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) AndLogicOperator() (localctx IAndLogicOperatorContext) {
-	localctx = NewAndLogicOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, grulev3ParserRULE_andLogicOperator)
+func (p *grulev3Parser) MapLiteral() (localctx IMapLiteralContext) {
+	localctx = NewMapLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, grulev3ParserRULE_mapLiteral)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(157)
-		p.Match(grulev3ParserAND)
+		p.SetState(478)
+		p.Match(grulev3ParserLR_BRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(487)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&61572652269576) != 0) || ((int64((_la-68)) & ^0x3f) == 0 && ((int64(1)<<(_la-68))&8029) != 0) {
+		{
+			p.SetState(479)
+			p.MapEntry()
+		}
+		p.SetState(484)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == grulev3ParserT__0 {
+			{
+				p.SetState(480)
+				p.Match(grulev3ParserT__0)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(481)
+				p.MapEntry()
+			}
+
+			p.SetState(486)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+	{
+		p.SetState(489)
+		p.Match(grulev3ParserRR_BRACE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -2770,98 +8995,149 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IOrLogicOperatorContext is an interface to support dynamic dispatch.
-type IOrLogicOperatorContext interface {
+// IMapEntryContext is an interface to support dynamic dispatch.
+type IMapEntryContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	OR() antlr.TerminalNode
+	AllConstant() []IConstantContext
+	Constant(i int) IConstantContext
+	COLON() antlr.TerminalNode
 
-	// IsOrLogicOperatorContext differentiates from other interfaces.
-	IsOrLogicOperatorContext()
+	// IsMapEntryContext differentiates from other interfaces.
+	IsMapEntryContext()
 }
 
-type OrLogicOperatorContext struct {
+type MapEntryContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyOrLogicOperatorContext() *OrLogicOperatorContext {
-	var p = new(OrLogicOperatorContext)
+func NewEmptyMapEntryContext() *MapEntryContext {
+	var p = new(MapEntryContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_orLogicOperator
+	p.RuleIndex = grulev3ParserRULE_mapEntry
 	return p
 }
 
-func InitEmptyOrLogicOperatorContext(p *OrLogicOperatorContext) {
+func InitEmptyMapEntryContext(p *MapEntryContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_orLogicOperator
+	p.RuleIndex = grulev3ParserRULE_mapEntry
 }
 
-func (*OrLogicOperatorContext) IsOrLogicOperatorContext() {}
+func (*MapEntryContext) IsMapEntryContext() {}
 
-func NewOrLogicOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrLogicOperatorContext {
-	var p = new(OrLogicOperatorContext)
+func NewMapEntryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MapEntryContext {
+	var p = new(MapEntryContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_orLogicOperator
+	p.RuleIndex = grulev3ParserRULE_mapEntry
 
 	return p
 }
 
-func (s *OrLogicOperatorContext) GetParser() antlr.Parser { return s.parser }
+func (s *MapEntryContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OrLogicOperatorContext) OR() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserOR, 0)
+func (s *MapEntryContext) AllConstant() []IConstantContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IConstantContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IConstantContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IConstantContext); ok {
+			tst[i] = t.(IConstantContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *OrLogicOperatorContext) GetRuleContext() antlr.RuleContext {
+func (s *MapEntryContext) Constant(i int) IConstantContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IConstantContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConstantContext)
+}
+
+func (s *MapEntryContext) COLON() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserCOLON, 0)
+}
+
+func (s *MapEntryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *OrLogicOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MapEntryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *OrLogicOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MapEntryContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterOrLogicOperator(s)
+		listenerT.EnterMapEntry(s)
 	}
 }
 
-func (s *OrLogicOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MapEntryContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitOrLogicOperator(s)
+		listenerT.ExitMapEntry(s)
 	}
 }
 
-func (s *OrLogicOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MapEntryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitOrLogicOperator(s)
+		return t.VisitMapEntry(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) OrLogicOperator() (localctx IOrLogicOperatorContext) {
-	localctx = NewOrLogicOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, grulev3ParserRULE_orLogicOperator)
+func (p *grulev3Parser) MapEntry() (localctx IMapEntryContext) {
+	localctx = NewMapEntryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, grulev3ParserRULE_mapEntry)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(159)
-		p.Match(grulev3ParserOR)
+		p.SetState(491)
+		p.Constant()
+	}
+	{
+		p.SetState(492)
+		p.Match(grulev3ParserCOLON)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	{
+		p.SetState(493)
+		p.Constant()
+	}
 
 errorExit:
 	if p.HasError() {
@@ -2876,115 +9152,59 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IExpressionAtomContext is an interface to support dynamic dispatch.
-type IExpressionAtomContext interface {
+// IVariableContext is an interface to support dynamic dispatch.
+type IVariableContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Constant() IConstantContext
+	Identifier() IIdentifierContext
 	Variable() IVariableContext
-	FunctionCall() IFunctionCallContext
-	NEGATION() antlr.TerminalNode
-	ExpressionAtom() IExpressionAtomContext
-	MethodCall() IMethodCallContext
 	MemberVariable() IMemberVariableContext
 	ArrayMapSelector() IArrayMapSelectorContext
 
-	// IsExpressionAtomContext differentiates from other interfaces.
-	IsExpressionAtomContext()
+	// IsVariableContext differentiates from other interfaces.
+	IsVariableContext()
 }
 
-type ExpressionAtomContext struct {
+type VariableContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExpressionAtomContext() *ExpressionAtomContext {
-	var p = new(ExpressionAtomContext)
+func NewEmptyVariableContext() *VariableContext {
+	var p = new(VariableContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_expressionAtom
+	p.RuleIndex = grulev3ParserRULE_variable
 	return p
 }
 
-func InitEmptyExpressionAtomContext(p *ExpressionAtomContext) {
+func InitEmptyVariableContext(p *VariableContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_expressionAtom
+	p.RuleIndex = grulev3ParserRULE_variable
 }
 
-func (*ExpressionAtomContext) IsExpressionAtomContext() {}
+func (*VariableContext) IsVariableContext() {}
 
-func NewExpressionAtomContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionAtomContext {
-	var p = new(ExpressionAtomContext)
+func NewVariableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableContext {
+	var p = new(VariableContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_expressionAtom
+	p.RuleIndex = grulev3ParserRULE_variable
 
 	return p
 }
 
-func (s *ExpressionAtomContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ExpressionAtomContext) Constant() IConstantContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IConstantContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IConstantContext)
-}
-
-func (s *ExpressionAtomContext) Variable() IVariableContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IVariableContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IVariableContext)
-}
-
-func (s *ExpressionAtomContext) FunctionCall() IFunctionCallContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFunctionCallContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFunctionCallContext)
-}
-
-func (s *ExpressionAtomContext) NEGATION() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserNEGATION, 0)
-}
+func (s *VariableContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExpressionAtomContext) ExpressionAtom() IExpressionAtomContext {
+func (s *VariableContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionAtomContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2994,13 +9214,13 @@ func (s *ExpressionAtomContext) ExpressionAtom() IExpressionAtomContext {
 		return nil
 	}
 
-	return t.(IExpressionAtomContext)
+	return t.(IIdentifierContext)
 }
 
-func (s *ExpressionAtomContext) MethodCall() IMethodCallContext {
+func (s *VariableContext) Variable() IVariableContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMethodCallContext); ok {
+		if _, ok := ctx.(IVariableContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3010,10 +9230,10 @@ func (s *ExpressionAtomContext) MethodCall() IMethodCallContext {
 		return nil
 	}
 
-	return t.(IMethodCallContext)
+	return t.(IVariableContext)
 }
 
-func (s *ExpressionAtomContext) MemberVariable() IMemberVariableContext {
+func (s *VariableContext) MemberVariable() IMemberVariableContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IMemberVariableContext); ok {
@@ -3029,7 +9249,7 @@ func (s *ExpressionAtomContext) MemberVariable() IMemberVariableContext {
 	return t.(IMemberVariableContext)
 }
 
-func (s *ExpressionAtomContext) ArrayMapSelector() IArrayMapSelectorContext {
+func (s *VariableContext) ArrayMapSelector() IArrayMapSelectorContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IArrayMapSelectorContext); ok {
@@ -3045,101 +9265,64 @@ func (s *ExpressionAtomContext) ArrayMapSelector() IArrayMapSelectorContext {
 	return t.(IArrayMapSelectorContext)
 }
 
-func (s *ExpressionAtomContext) GetRuleContext() antlr.RuleContext {
+func (s *VariableContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExpressionAtomContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *VariableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExpressionAtomContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *VariableContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterExpressionAtom(s)
+		listenerT.EnterVariable(s)
 	}
 }
 
-func (s *ExpressionAtomContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *VariableContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitExpressionAtom(s)
+		listenerT.ExitVariable(s)
 	}
 }
 
-func (s *ExpressionAtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *VariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitExpressionAtom(s)
+		return t.VisitVariable(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) ExpressionAtom() (localctx IExpressionAtomContext) {
-	return p.expressionAtom(0)
+func (p *grulev3Parser) Variable() (localctx IVariableContext) {
+	return p.variable(0)
 }
 
-func (p *grulev3Parser) expressionAtom(_p int) (localctx IExpressionAtomContext) {
+func (p *grulev3Parser) variable(_p int) (localctx IVariableContext) {
 	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
 
 	_parentState := p.GetState()
-	localctx = NewExpressionAtomContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx IExpressionAtomContext = localctx
+	localctx = NewVariableContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IVariableContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 32
-	p.EnterRecursionRule(localctx, 32, grulev3ParserRULE_expressionAtom, _p)
+	_startState := 88
+	p.EnterRecursionRule(localctx, 88, grulev3ParserRULE_variable, _p)
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(167)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+	{
+		p.SetState(496)
+		p.Identifier()
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 9, p.GetParserRuleContext()) {
-	case 1:
-		{
-			p.SetState(162)
-			p.Constant()
-		}
-
-	case 2:
-		{
-			p.SetState(163)
-			p.variable(0)
-		}
-
-	case 3:
-		{
-			p.SetState(164)
-			p.FunctionCall()
-		}
-
-	case 4:
-		{
-			p.SetState(165)
-			p.Match(grulev3ParserNEGATION)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(166)
-			p.expressionAtom(1)
-		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
-	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(177)
+	p.SetState(504)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 11, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 41, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -3149,52 +9332,38 @@ func (p *grulev3Parser) expressionAtom(_p int) (localctx IExpressionAtomContext)
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(175)
+			p.SetState(502)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 10, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 40, p.GetParserRuleContext()) {
 			case 1:
-				localctx = NewExpressionAtomContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expressionAtom)
-				p.SetState(169)
-
-				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(170)
-					p.MethodCall()
-				}
-
-			case 2:
-				localctx = NewExpressionAtomContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expressionAtom)
-				p.SetState(171)
+				localctx = NewVariableContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_variable)
+				p.SetState(498)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(172)
+					p.SetState(499)
 					p.MemberVariable()
 				}
 
-			case 3:
-				localctx = NewExpressionAtomContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expressionAtom)
-				p.SetState(173)
+			case 2:
+				localctx = NewVariableContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_variable)
+				p.SetState(500)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(174)
+					p.SetState(501)
 					p.ArrayMapSelector()
 				}
 
@@ -3203,12 +9372,12 @@ func (p *grulev3Parser) expressionAtom(_p int) (localctx IExpressionAtomContext)
 			}
 
 		}
-		p.SetState(179)
+		p.SetState(506)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 11, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 41, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -3227,108 +9396,62 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IConstantContext is an interface to support dynamic dispatch.
-type IConstantContext interface {
+// IArrayMapSelectorContext is an interface to support dynamic dispatch.
+type IArrayMapSelectorContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	StringLiteral() IStringLiteralContext
-	IntegerLiteral() IIntegerLiteralContext
-	FloatLiteral() IFloatLiteralContext
-	BooleanLiteral() IBooleanLiteralContext
-	NIL_LITERAL() antlr.TerminalNode
+	LS_BRACKET() antlr.TerminalNode
+	Expression() IExpressionContext
+	RS_BRACKET() antlr.TerminalNode
 
-	// IsConstantContext differentiates from other interfaces.
-	IsConstantContext()
+	// IsArrayMapSelectorContext differentiates from other interfaces.
+	IsArrayMapSelectorContext()
 }
 
-type ConstantContext struct {
+type ArrayMapSelectorContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyConstantContext() *ConstantContext {
-	var p = new(ConstantContext)
+func NewEmptyArrayMapSelectorContext() *ArrayMapSelectorContext {
+	var p = new(ArrayMapSelectorContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_constant
+	p.RuleIndex = grulev3ParserRULE_arrayMapSelector
 	return p
 }
 
-func InitEmptyConstantContext(p *ConstantContext) {
+func InitEmptyArrayMapSelectorContext(p *ArrayMapSelectorContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_constant
+	p.RuleIndex = grulev3ParserRULE_arrayMapSelector
 }
 
-func (*ConstantContext) IsConstantContext() {}
+func (*ArrayMapSelectorContext) IsArrayMapSelectorContext() {}
 
-func NewConstantContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstantContext {
-	var p = new(ConstantContext)
+func NewArrayMapSelectorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayMapSelectorContext {
+	var p = new(ArrayMapSelectorContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_constant
+	p.RuleIndex = grulev3ParserRULE_arrayMapSelector
 
 	return p
 }
 
-func (s *ConstantContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ConstantContext) StringLiteral() IStringLiteralContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStringLiteralContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IStringLiteralContext)
-}
-
-func (s *ConstantContext) IntegerLiteral() IIntegerLiteralContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIntegerLiteralContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IIntegerLiteralContext)
-}
-
-func (s *ConstantContext) FloatLiteral() IFloatLiteralContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFloatLiteralContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *ArrayMapSelectorContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IFloatLiteralContext)
+func (s *ArrayMapSelectorContext) LS_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLS_BRACKET, 0)
 }
 
-func (s *ConstantContext) BooleanLiteral() IBooleanLiteralContext {
+func (s *ArrayMapSelectorContext) Expression() IExpressionContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBooleanLiteralContext); ok {
+		if _, ok := ctx.(IExpressionContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3338,94 +9461,66 @@ func (s *ConstantContext) BooleanLiteral() IBooleanLiteralContext {
 		return nil
 	}
 
-	return t.(IBooleanLiteralContext)
+	return t.(IExpressionContext)
 }
 
-func (s *ConstantContext) NIL_LITERAL() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserNIL_LITERAL, 0)
+func (s *ArrayMapSelectorContext) RS_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRS_BRACKET, 0)
 }
 
-func (s *ConstantContext) GetRuleContext() antlr.RuleContext {
+func (s *ArrayMapSelectorContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ConstantContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ArrayMapSelectorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ConstantContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ArrayMapSelectorContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterConstant(s)
+		listenerT.EnterArrayMapSelector(s)
 	}
 }
 
-func (s *ConstantContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ArrayMapSelectorContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitConstant(s)
+		listenerT.ExitArrayMapSelector(s)
 	}
 }
 
-func (s *ConstantContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ArrayMapSelectorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitConstant(s)
+		return t.VisitArrayMapSelector(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) Constant() (localctx IConstantContext) {
-	localctx = NewConstantContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, grulev3ParserRULE_constant)
-	p.SetState(185)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 12, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(180)
-			p.StringLiteral()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(181)
-			p.IntegerLiteral()
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(182)
-			p.FloatLiteral()
-		}
-
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(183)
-			p.BooleanLiteral()
+func (p *grulev3Parser) ArrayMapSelector() (localctx IArrayMapSelectorContext) {
+	localctx = NewArrayMapSelectorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, grulev3ParserRULE_arrayMapSelector)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(507)
+		p.Match(grulev3ParserLS_BRACKET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(184)
-			p.Match(grulev3ParserNIL_LITERAL)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+	}
+	{
+		p.SetState(508)
+		p.expression(0)
+	}
+	{
+		p.SetState(509)
+		p.Match(grulev3ParserRS_BRACKET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -3441,63 +9536,58 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IVariableContext is an interface to support dynamic dispatch.
-type IVariableContext interface {
+// IMemberVariableContext is an interface to support dynamic dispatch.
+type IMemberVariableContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	SIMPLENAME() antlr.TerminalNode
-	Variable() IVariableContext
-	MemberVariable() IMemberVariableContext
-	ArrayMapSelector() IArrayMapSelectorContext
+	Identifier() IIdentifierContext
+	DOT() antlr.TerminalNode
+	SAFE_DOT() antlr.TerminalNode
 
-	// IsVariableContext differentiates from other interfaces.
-	IsVariableContext()
+	// IsMemberVariableContext differentiates from other interfaces.
+	IsMemberVariableContext()
 }
 
-type VariableContext struct {
+type MemberVariableContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyVariableContext() *VariableContext {
-	var p = new(VariableContext)
+func NewEmptyMemberVariableContext() *MemberVariableContext {
+	var p = new(MemberVariableContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_variable
+	p.RuleIndex = grulev3ParserRULE_memberVariable
 	return p
 }
 
-func InitEmptyVariableContext(p *VariableContext) {
+func InitEmptyMemberVariableContext(p *MemberVariableContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_variable
+	p.RuleIndex = grulev3ParserRULE_memberVariable
 }
 
-func (*VariableContext) IsVariableContext() {}
+func (*MemberVariableContext) IsMemberVariableContext() {}
 
-func NewVariableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableContext {
-	var p = new(VariableContext)
+func NewMemberVariableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MemberVariableContext {
+	var p = new(MemberVariableContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_variable
+	p.RuleIndex = grulev3ParserRULE_memberVariable
 
 	return p
 }
 
-func (s *VariableContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *VariableContext) SIMPLENAME() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserSIMPLENAME, 0)
-}
+func (s *MemberVariableContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *VariableContext) Variable() IVariableContext {
+func (s *MemberVariableContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IVariableContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3507,162 +9597,68 @@ func (s *VariableContext) Variable() IVariableContext {
 		return nil
 	}
 
-	return t.(IVariableContext)
+	return t.(IIdentifierContext)
 }
 
-func (s *VariableContext) MemberVariable() IMemberVariableContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMemberVariableContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IMemberVariableContext)
+func (s *MemberVariableContext) DOT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserDOT, 0)
 }
 
-func (s *VariableContext) ArrayMapSelector() IArrayMapSelectorContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IArrayMapSelectorContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IArrayMapSelectorContext)
+func (s *MemberVariableContext) SAFE_DOT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSAFE_DOT, 0)
 }
 
-func (s *VariableContext) GetRuleContext() antlr.RuleContext {
+func (s *MemberVariableContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *VariableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MemberVariableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *VariableContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MemberVariableContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterVariable(s)
+		listenerT.EnterMemberVariable(s)
 	}
 }
 
-func (s *VariableContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MemberVariableContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitVariable(s)
+		listenerT.ExitMemberVariable(s)
 	}
 }
 
-func (s *VariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MemberVariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitVariable(s)
+		return t.VisitMemberVariable(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) Variable() (localctx IVariableContext) {
-	return p.variable(0)
-}
-
-func (p *grulev3Parser) variable(_p int) (localctx IVariableContext) {
-	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
-
-	_parentState := p.GetState()
-	localctx = NewVariableContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx IVariableContext = localctx
-	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 36
-	p.EnterRecursionRule(localctx, 36, grulev3ParserRULE_variable, _p)
-	var _alt int
+func (p *grulev3Parser) MemberVariable() (localctx IMemberVariableContext) {
+	localctx = NewMemberVariableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, grulev3ParserRULE_memberVariable)
+	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(188)
-		p.Match(grulev3ParserSIMPLENAME)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-
-	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(196)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 14, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			if p.GetParseListeners() != nil {
-				p.TriggerExitRuleEvent()
-			}
-			_prevctx = localctx
-			p.SetState(194)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 13, p.GetParserRuleContext()) {
-			case 1:
-				localctx = NewVariableContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_variable)
-				p.SetState(190)
-
-				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(191)
-					p.MemberVariable()
-				}
-
-			case 2:
-				localctx = NewVariableContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_variable)
-				p.SetState(192)
-
-				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(193)
-					p.ArrayMapSelector()
-				}
-
-			case antlr.ATNInvalidAltNumber:
-				goto errorExit
-			}
+		p.SetState(511)
+		_la = p.GetTokenStream().LA(1)
 
-		}
-		p.SetState(198)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 14, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
+		if !(_la == grulev3ParserDOT || _la == grulev3ParserSAFE_DOT) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
 	}
+	{
+		p.SetState(512)
+		p.Identifier()
+	}
 
 errorExit:
 	if p.HasError() {
@@ -3672,67 +9668,64 @@ errorExit:
 		p.GetErrorHandler().Recover(p, v)
 		p.SetError(nil)
 	}
-	p.UnrollRecursionContexts(_parentctx)
+	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IArrayMapSelectorContext is an interface to support dynamic dispatch.
-type IArrayMapSelectorContext interface {
+// IFunctionCallContext is an interface to support dynamic dispatch.
+type IFunctionCallContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	LS_BRACKET() antlr.TerminalNode
-	Expression() IExpressionContext
-	RS_BRACKET() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	LR_BRACKET() antlr.TerminalNode
+	RR_BRACKET() antlr.TerminalNode
+	ArgumentList() IArgumentListContext
 
-	// IsArrayMapSelectorContext differentiates from other interfaces.
-	IsArrayMapSelectorContext()
+	// IsFunctionCallContext differentiates from other interfaces.
+	IsFunctionCallContext()
 }
 
-type ArrayMapSelectorContext struct {
+type FunctionCallContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyArrayMapSelectorContext() *ArrayMapSelectorContext {
-	var p = new(ArrayMapSelectorContext)
+func NewEmptyFunctionCallContext() *FunctionCallContext {
+	var p = new(FunctionCallContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_arrayMapSelector
+	p.RuleIndex = grulev3ParserRULE_functionCall
 	return p
 }
 
-func InitEmptyArrayMapSelectorContext(p *ArrayMapSelectorContext) {
+func InitEmptyFunctionCallContext(p *FunctionCallContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_arrayMapSelector
+	p.RuleIndex = grulev3ParserRULE_functionCall
 }
 
-func (*ArrayMapSelectorContext) IsArrayMapSelectorContext() {}
+func (*FunctionCallContext) IsFunctionCallContext() {}
 
-func NewArrayMapSelectorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayMapSelectorContext {
-	var p = new(ArrayMapSelectorContext)
+func NewFunctionCallContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionCallContext {
+	var p = new(FunctionCallContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_arrayMapSelector
+	p.RuleIndex = grulev3ParserRULE_functionCall
 
 	return p
 }
 
-func (s *ArrayMapSelectorContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ArrayMapSelectorContext) LS_BRACKET() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserLS_BRACKET, 0)
-}
+func (s *FunctionCallContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ArrayMapSelectorContext) Expression() IExpressionContext {
+func (s *FunctionCallContext) Identifier() IIdentifierContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3742,62 +9735,98 @@ func (s *ArrayMapSelectorContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IIdentifierContext)
 }
 
-func (s *ArrayMapSelectorContext) RS_BRACKET() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserRS_BRACKET, 0)
+func (s *FunctionCallContext) LR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLR_BRACKET, 0)
 }
 
-func (s *ArrayMapSelectorContext) GetRuleContext() antlr.RuleContext {
+func (s *FunctionCallContext) RR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRR_BRACKET, 0)
+}
+
+func (s *FunctionCallContext) ArgumentList() IArgumentListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IArgumentListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArgumentListContext)
+}
+
+func (s *FunctionCallContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ArrayMapSelectorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FunctionCallContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ArrayMapSelectorContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FunctionCallContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterArrayMapSelector(s)
+		listenerT.EnterFunctionCall(s)
 	}
 }
 
-func (s *ArrayMapSelectorContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FunctionCallContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitArrayMapSelector(s)
+		listenerT.ExitFunctionCall(s)
 	}
 }
 
-func (s *ArrayMapSelectorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FunctionCallContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitArrayMapSelector(s)
+		return t.VisitFunctionCall(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) ArrayMapSelector() (localctx IArrayMapSelectorContext) {
-	localctx = NewArrayMapSelectorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, grulev3ParserRULE_arrayMapSelector)
+func (p *grulev3Parser) FunctionCall() (localctx IFunctionCallContext) {
+	localctx = NewFunctionCallContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, grulev3ParserRULE_functionCall)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(199)
-		p.Match(grulev3ParserLS_BRACKET)
+		p.SetState(514)
+		p.Identifier()
+	}
+	{
+		p.SetState(515)
+		p.Match(grulev3ParserLR_BRACKET)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(200)
-		p.expression(0)
+	p.SetState(517)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&18148538895892488) != 0) || ((int64((_la-68)) & ^0x3f) == 0 && ((int64(1)<<(_la-68))&8031) != 0) {
+		{
+			p.SetState(516)
+			p.ArgumentList()
+		}
+
 	}
 	{
-		p.SetState(201)
-		p.Match(grulev3ParserRS_BRACKET)
+		p.SetState(519)
+		p.Match(grulev3ParserRR_BRACKET)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -3817,106 +9846,208 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IMemberVariableContext is an interface to support dynamic dispatch.
-type IMemberVariableContext interface {
+// IQuantifierContext is an interface to support dynamic dispatch.
+type IQuantifierContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	DOT() antlr.TerminalNode
 	SIMPLENAME() antlr.TerminalNode
+	LR_BRACKET() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	IN() antlr.TerminalNode
+	ExpressionAtom() IExpressionAtomContext
+	COLON() antlr.TerminalNode
+	Expression() IExpressionContext
+	RR_BRACKET() antlr.TerminalNode
 
-	// IsMemberVariableContext differentiates from other interfaces.
-	IsMemberVariableContext()
+	// IsQuantifierContext differentiates from other interfaces.
+	IsQuantifierContext()
 }
 
-type MemberVariableContext struct {
+type QuantifierContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMemberVariableContext() *MemberVariableContext {
-	var p = new(MemberVariableContext)
+func NewEmptyQuantifierContext() *QuantifierContext {
+	var p = new(QuantifierContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_memberVariable
+	p.RuleIndex = grulev3ParserRULE_quantifier
 	return p
 }
 
-func InitEmptyMemberVariableContext(p *MemberVariableContext) {
+func InitEmptyQuantifierContext(p *QuantifierContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_memberVariable
+	p.RuleIndex = grulev3ParserRULE_quantifier
 }
 
-func (*MemberVariableContext) IsMemberVariableContext() {}
+func (*QuantifierContext) IsQuantifierContext() {}
 
-func NewMemberVariableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MemberVariableContext {
-	var p = new(MemberVariableContext)
+func NewQuantifierContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QuantifierContext {
+	var p = new(QuantifierContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_memberVariable
+	p.RuleIndex = grulev3ParserRULE_quantifier
 
 	return p
 }
 
-func (s *MemberVariableContext) GetParser() antlr.Parser { return s.parser }
+func (s *QuantifierContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MemberVariableContext) DOT() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserDOT, 0)
+func (s *QuantifierContext) SIMPLENAME() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSIMPLENAME, 0)
 }
 
-func (s *MemberVariableContext) SIMPLENAME() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserSIMPLENAME, 0)
+func (s *QuantifierContext) LR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLR_BRACKET, 0)
 }
 
-func (s *MemberVariableContext) GetRuleContext() antlr.RuleContext {
+func (s *QuantifierContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *QuantifierContext) IN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserIN, 0)
+}
+
+func (s *QuantifierContext) ExpressionAtom() IExpressionAtomContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionAtomContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionAtomContext)
+}
+
+func (s *QuantifierContext) COLON() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserCOLON, 0)
+}
+
+func (s *QuantifierContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *QuantifierContext) RR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRR_BRACKET, 0)
+}
+
+func (s *QuantifierContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MemberVariableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *QuantifierContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MemberVariableContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *QuantifierContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterMemberVariable(s)
+		listenerT.EnterQuantifier(s)
 	}
 }
 
-func (s *MemberVariableContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *QuantifierContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitMemberVariable(s)
+		listenerT.ExitQuantifier(s)
 	}
 }
 
-func (s *MemberVariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *QuantifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitMemberVariable(s)
+		return t.VisitQuantifier(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) MemberVariable() (localctx IMemberVariableContext) {
-	localctx = NewMemberVariableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, grulev3ParserRULE_memberVariable)
+func (p *grulev3Parser) Quantifier() (localctx IQuantifierContext) {
+	localctx = NewQuantifierContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, grulev3ParserRULE_quantifier)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(203)
-		p.Match(grulev3ParserDOT)
+		p.SetState(521)
+		p.Match(grulev3ParserSIMPLENAME)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(204)
-		p.Match(grulev3ParserSIMPLENAME)
+		p.SetState(522)
+		p.Match(grulev3ParserLR_BRACKET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(523)
+		p.Identifier()
+	}
+	{
+		p.SetState(524)
+		p.Match(grulev3ParserIN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(525)
+		p.expressionAtom(0)
+	}
+	{
+		p.SetState(526)
+		p.Match(grulev3ParserCOLON)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(527)
+		p.expression(0)
+	}
+	{
+		p.SetState(528)
+		p.Match(grulev3ParserRR_BRACKET)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -3936,8 +10067,8 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFunctionCallContext is an interface to support dynamic dispatch.
-type IFunctionCallContext interface {
+// IAggregateContext is an interface to support dynamic dispatch.
+type IAggregateContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -3946,61 +10077,108 @@ type IFunctionCallContext interface {
 	// Getter signatures
 	SIMPLENAME() antlr.TerminalNode
 	LR_BRACKET() antlr.TerminalNode
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	FOR() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	IN() antlr.TerminalNode
+	ExpressionAtom() IExpressionAtomContext
 	RR_BRACKET() antlr.TerminalNode
-	ArgumentList() IArgumentListContext
+	IF() antlr.TerminalNode
 
-	// IsFunctionCallContext differentiates from other interfaces.
-	IsFunctionCallContext()
+	// IsAggregateContext differentiates from other interfaces.
+	IsAggregateContext()
 }
 
-type FunctionCallContext struct {
+type AggregateContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFunctionCallContext() *FunctionCallContext {
-	var p = new(FunctionCallContext)
+func NewEmptyAggregateContext() *AggregateContext {
+	var p = new(AggregateContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_functionCall
+	p.RuleIndex = grulev3ParserRULE_aggregate
 	return p
 }
 
-func InitEmptyFunctionCallContext(p *FunctionCallContext) {
+func InitEmptyAggregateContext(p *AggregateContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = grulev3ParserRULE_functionCall
+	p.RuleIndex = grulev3ParserRULE_aggregate
 }
 
-func (*FunctionCallContext) IsFunctionCallContext() {}
+func (*AggregateContext) IsAggregateContext() {}
 
-func NewFunctionCallContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionCallContext {
-	var p = new(FunctionCallContext)
+func NewAggregateContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AggregateContext {
+	var p = new(AggregateContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = grulev3ParserRULE_functionCall
+	p.RuleIndex = grulev3ParserRULE_aggregate
 
 	return p
 }
 
-func (s *FunctionCallContext) GetParser() antlr.Parser { return s.parser }
+func (s *AggregateContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FunctionCallContext) SIMPLENAME() antlr.TerminalNode {
+func (s *AggregateContext) SIMPLENAME() antlr.TerminalNode {
 	return s.GetToken(grulev3ParserSIMPLENAME, 0)
 }
 
-func (s *FunctionCallContext) LR_BRACKET() antlr.TerminalNode {
+func (s *AggregateContext) LR_BRACKET() antlr.TerminalNode {
 	return s.GetToken(grulev3ParserLR_BRACKET, 0)
 }
 
-func (s *FunctionCallContext) RR_BRACKET() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserRR_BRACKET, 0)
+func (s *AggregateContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *FunctionCallContext) ArgumentList() IArgumentListContext {
+func (s *AggregateContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IArgumentListContext); ok {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AggregateContext) FOR() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserFOR, 0)
+}
+
+func (s *AggregateContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4010,77 +10188,141 @@ func (s *FunctionCallContext) ArgumentList() IArgumentListContext {
 		return nil
 	}
 
-	return t.(IArgumentListContext)
+	return t.(IIdentifierContext)
 }
 
-func (s *FunctionCallContext) GetRuleContext() antlr.RuleContext {
+func (s *AggregateContext) IN() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserIN, 0)
+}
+
+func (s *AggregateContext) ExpressionAtom() IExpressionAtomContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionAtomContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionAtomContext)
+}
+
+func (s *AggregateContext) RR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRR_BRACKET, 0)
+}
+
+func (s *AggregateContext) IF() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserIF, 0)
+}
+
+func (s *AggregateContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FunctionCallContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AggregateContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FunctionCallContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AggregateContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.EnterFunctionCall(s)
+		listenerT.EnterAggregate(s)
 	}
 }
 
-func (s *FunctionCallContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AggregateContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(grulev3Listener); ok {
-		listenerT.ExitFunctionCall(s)
+		listenerT.ExitAggregate(s)
 	}
 }
 
-func (s *FunctionCallContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AggregateContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case grulev3Visitor:
-		return t.VisitFunctionCall(s)
+		return t.VisitAggregate(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *grulev3Parser) FunctionCall() (localctx IFunctionCallContext) {
-	localctx = NewFunctionCallContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, grulev3ParserRULE_functionCall)
+func (p *grulev3Parser) Aggregate() (localctx IAggregateContext) {
+	localctx = NewAggregateContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, grulev3ParserRULE_aggregate)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(206)
-		p.Match(grulev3ParserSIMPLENAME)
+		p.SetState(530)
+		p.Match(grulev3ParserSIMPLENAME)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(531)
+		p.Match(grulev3ParserLR_BRACKET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(532)
+		p.expression(0)
+	}
+	{
+		p.SetState(533)
+		p.Match(grulev3ParserFOR)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(207)
-		p.Match(grulev3ParserLR_BRACKET)
+		p.SetState(534)
+		p.Identifier()
+	}
+	{
+		p.SetState(535)
+		p.Match(grulev3ParserIN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(209)
+	{
+		p.SetState(536)
+		p.expressionAtom(0)
+	}
+	p.SetState(539)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&259209881978888) != 0 {
+	if _la == grulev3ParserIF {
 		{
-			p.SetState(208)
-			p.ArgumentList()
+			p.SetState(537)
+			p.Match(grulev3ParserIF)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(538)
+			p.expression(0)
 		}
 
 	}
 	{
-		p.SetState(211)
+		p.SetState(541)
 		p.Match(grulev3ParserRR_BRACKET)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4109,8 +10351,9 @@ type IMethodCallContext interface {
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	DOT() antlr.TerminalNode
 	FunctionCall() IFunctionCallContext
+	DOT() antlr.TerminalNode
+	SAFE_DOT() antlr.TerminalNode
 
 	// IsMethodCallContext differentiates from other interfaces.
 	IsMethodCallContext()
@@ -4148,10 +10391,6 @@ func NewMethodCallContext(parser antlr.Parser, parent antlr.ParserRuleContext, i
 
 func (s *MethodCallContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MethodCallContext) DOT() antlr.TerminalNode {
-	return s.GetToken(grulev3ParserDOT, 0)
-}
-
 func (s *MethodCallContext) FunctionCall() IFunctionCallContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -4168,6 +10407,14 @@ func (s *MethodCallContext) FunctionCall() IFunctionCallContext {
 	return t.(IFunctionCallContext)
 }
 
+func (s *MethodCallContext) DOT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserDOT, 0)
+}
+
+func (s *MethodCallContext) SAFE_DOT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSAFE_DOT, 0)
+}
+
 func (s *MethodCallContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -4200,18 +10447,23 @@ func (s *MethodCallContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *grulev3Parser) MethodCall() (localctx IMethodCallContext) {
 	localctx = NewMethodCallContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, grulev3ParserRULE_methodCall)
+	p.EnterRule(localctx, 100, grulev3ParserRULE_methodCall)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(213)
-		p.Match(grulev3ParserDOT)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		p.SetState(543)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == grulev3ParserDOT || _la == grulev3ParserSAFE_DOT) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
 	}
 	{
-		p.SetState(214)
+		p.SetState(544)
 		p.FunctionCall()
 	}
 
@@ -4348,15 +10600,15 @@ func (s *ArgumentListContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *grulev3Parser) ArgumentList() (localctx IArgumentListContext) {
 	localctx = NewArgumentListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, grulev3ParserRULE_argumentList)
+	p.EnterRule(localctx, 102, grulev3ParserRULE_argumentList)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(216)
+		p.SetState(546)
 		p.expression(0)
 	}
-	p.SetState(221)
+	p.SetState(551)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4365,7 +10617,7 @@ func (p *grulev3Parser) ArgumentList() (localctx IArgumentListContext) {
 
 	for _la == grulev3ParserT__0 {
 		{
-			p.SetState(217)
+			p.SetState(547)
 			p.Match(grulev3ParserT__0)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4373,11 +10625,11 @@ func (p *grulev3Parser) ArgumentList() (localctx IArgumentListContext) {
 			}
 		}
 		{
-			p.SetState(218)
+			p.SetState(548)
 			p.expression(0)
 		}
 
-		p.SetState(223)
+		p.SetState(553)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -4509,25 +10761,25 @@ func (s *FloatLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *grulev3Parser) FloatLiteral() (localctx IFloatLiteralContext) {
 	localctx = NewFloatLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, grulev3ParserRULE_floatLiteral)
-	p.SetState(226)
+	p.EnterRule(localctx, 104, grulev3ParserRULE_floatLiteral)
+	p.SetState(556)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 17, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 45, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(224)
+			p.SetState(554)
 			p.DecimalFloatLiteral()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(225)
+			p.SetState(555)
 			p.HexadecimalFloatLiteral()
 		}
 
@@ -4635,11 +10887,11 @@ func (s *DecimalFloatLiteralContext) Accept(visitor antlr.ParseTreeVisitor) inte
 
 func (p *grulev3Parser) DecimalFloatLiteral() (localctx IDecimalFloatLiteralContext) {
 	localctx = NewDecimalFloatLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, grulev3ParserRULE_decimalFloatLiteral)
+	p.EnterRule(localctx, 106, grulev3ParserRULE_decimalFloatLiteral)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(229)
+	p.SetState(559)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4648,7 +10900,7 @@ func (p *grulev3Parser) DecimalFloatLiteral() (localctx IDecimalFloatLiteralCont
 
 	if _la == grulev3ParserMINUS {
 		{
-			p.SetState(228)
+			p.SetState(558)
 			p.Match(grulev3ParserMINUS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4658,7 +10910,7 @@ func (p *grulev3Parser) DecimalFloatLiteral() (localctx IDecimalFloatLiteralCont
 
 	}
 	{
-		p.SetState(231)
+		p.SetState(561)
 		p.Match(grulev3ParserDECIMAL_FLOAT_LIT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4766,11 +11018,11 @@ func (s *HexadecimalFloatLiteralContext) Accept(visitor antlr.ParseTreeVisitor)
 
 func (p *grulev3Parser) HexadecimalFloatLiteral() (localctx IHexadecimalFloatLiteralContext) {
 	localctx = NewHexadecimalFloatLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, grulev3ParserRULE_hexadecimalFloatLiteral)
+	p.EnterRule(localctx, 108, grulev3ParserRULE_hexadecimalFloatLiteral)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(234)
+	p.SetState(564)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4779,7 +11031,7 @@ func (p *grulev3Parser) HexadecimalFloatLiteral() (localctx IHexadecimalFloatLit
 
 	if _la == grulev3ParserMINUS {
 		{
-			p.SetState(233)
+			p.SetState(563)
 			p.Match(grulev3ParserMINUS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4789,7 +11041,7 @@ func (p *grulev3Parser) HexadecimalFloatLiteral() (localctx IHexadecimalFloatLit
 
 	}
 	{
-		p.SetState(236)
+		p.SetState(566)
 		p.Match(grulev3ParserHEX_FLOAT_LIT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4938,32 +11190,32 @@ func (s *IntegerLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *grulev3Parser) IntegerLiteral() (localctx IIntegerLiteralContext) {
 	localctx = NewIntegerLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, grulev3ParserRULE_integerLiteral)
-	p.SetState(241)
+	p.EnterRule(localctx, 110, grulev3ParserRULE_integerLiteral)
+	p.SetState(571)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 48, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(238)
+			p.SetState(568)
 			p.DecimalLiteral()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(239)
+			p.SetState(569)
 			p.HexadecimalLiteral()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(240)
+			p.SetState(570)
 			p.OctalLiteral()
 		}
 
@@ -5071,11 +11323,11 @@ func (s *DecimalLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *grulev3Parser) DecimalLiteral() (localctx IDecimalLiteralContext) {
 	localctx = NewDecimalLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, grulev3ParserRULE_decimalLiteral)
+	p.EnterRule(localctx, 112, grulev3ParserRULE_decimalLiteral)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(244)
+	p.SetState(574)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5084,7 +11336,7 @@ func (p *grulev3Parser) DecimalLiteral() (localctx IDecimalLiteralContext) {
 
 	if _la == grulev3ParserMINUS {
 		{
-			p.SetState(243)
+			p.SetState(573)
 			p.Match(grulev3ParserMINUS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5094,7 +11346,7 @@ func (p *grulev3Parser) DecimalLiteral() (localctx IDecimalLiteralContext) {
 
 	}
 	{
-		p.SetState(246)
+		p.SetState(576)
 		p.Match(grulev3ParserDEC_LIT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5202,11 +11454,11 @@ func (s *HexadecimalLiteralContext) Accept(visitor antlr.ParseTreeVisitor) inter
 
 func (p *grulev3Parser) HexadecimalLiteral() (localctx IHexadecimalLiteralContext) {
 	localctx = NewHexadecimalLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, grulev3ParserRULE_hexadecimalLiteral)
+	p.EnterRule(localctx, 114, grulev3ParserRULE_hexadecimalLiteral)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(249)
+	p.SetState(579)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5215,7 +11467,7 @@ func (p *grulev3Parser) HexadecimalLiteral() (localctx IHexadecimalLiteralContex
 
 	if _la == grulev3ParserMINUS {
 		{
-			p.SetState(248)
+			p.SetState(578)
 			p.Match(grulev3ParserMINUS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5225,7 +11477,7 @@ func (p *grulev3Parser) HexadecimalLiteral() (localctx IHexadecimalLiteralContex
 
 	}
 	{
-		p.SetState(251)
+		p.SetState(581)
 		p.Match(grulev3ParserHEX_LIT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5333,11 +11585,11 @@ func (s *OctalLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *grulev3Parser) OctalLiteral() (localctx IOctalLiteralContext) {
 	localctx = NewOctalLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, grulev3ParserRULE_octalLiteral)
+	p.EnterRule(localctx, 116, grulev3ParserRULE_octalLiteral)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(254)
+	p.SetState(584)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5346,7 +11598,7 @@ func (p *grulev3Parser) OctalLiteral() (localctx IOctalLiteralContext) {
 
 	if _la == grulev3ParserMINUS {
 		{
-			p.SetState(253)
+			p.SetState(583)
 			p.Match(grulev3ParserMINUS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5356,7 +11608,7 @@ func (p *grulev3Parser) OctalLiteral() (localctx IOctalLiteralContext) {
 
 	}
 	{
-		p.SetState(256)
+		p.SetState(586)
 		p.Match(grulev3ParserOCT_LIT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5464,12 +11716,12 @@ func (s *StringLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *grulev3Parser) StringLiteral() (localctx IStringLiteralContext) {
 	localctx = NewStringLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, grulev3ParserRULE_stringLiteral)
+	p.EnterRule(localctx, 118, grulev3ParserRULE_stringLiteral)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(258)
+		p.SetState(588)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == grulev3ParserDQUOTA_STRING || _la == grulev3ParserSQUOTA_STRING) {
@@ -5493,6 +11745,251 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
+// IDurationLiteralContext is an interface to support dynamic dispatch.
+type IDurationLiteralContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	DURATION_LIT() antlr.TerminalNode
+	ISO_DURATION_LIT() antlr.TerminalNode
+	MINUS() antlr.TerminalNode
+
+	// IsDurationLiteralContext differentiates from other interfaces.
+	IsDurationLiteralContext()
+}
+
+type DurationLiteralContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDurationLiteralContext() *DurationLiteralContext {
+	var p = new(DurationLiteralContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_durationLiteral
+	return p
+}
+
+func InitEmptyDurationLiteralContext(p *DurationLiteralContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_durationLiteral
+}
+
+func (*DurationLiteralContext) IsDurationLiteralContext() {}
+
+func NewDurationLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DurationLiteralContext {
+	var p = new(DurationLiteralContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_durationLiteral
+
+	return p
+}
+
+func (s *DurationLiteralContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DurationLiteralContext) DURATION_LIT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserDURATION_LIT, 0)
+}
+
+func (s *DurationLiteralContext) ISO_DURATION_LIT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserISO_DURATION_LIT, 0)
+}
+
+func (s *DurationLiteralContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserMINUS, 0)
+}
+
+func (s *DurationLiteralContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DurationLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DurationLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterDurationLiteral(s)
+	}
+}
+
+func (s *DurationLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitDurationLiteral(s)
+	}
+}
+
+func (s *DurationLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitDurationLiteral(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) DurationLiteral() (localctx IDurationLiteralContext) {
+	localctx = NewDurationLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 120, grulev3ParserRULE_durationLiteral)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(591)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == grulev3ParserMINUS {
+		{
+			p.SetState(590)
+			p.Match(grulev3ParserMINUS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+	{
+		p.SetState(593)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == grulev3ParserISO_DURATION_LIT || _la == grulev3ParserDURATION_LIT) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IDateLiteralContext is an interface to support dynamic dispatch.
+type IDateLiteralContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	DATE_LIT() antlr.TerminalNode
+
+	// IsDateLiteralContext differentiates from other interfaces.
+	IsDateLiteralContext()
+}
+
+type DateLiteralContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDateLiteralContext() *DateLiteralContext {
+	var p = new(DateLiteralContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_dateLiteral
+	return p
+}
+
+func InitEmptyDateLiteralContext(p *DateLiteralContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_dateLiteral
+}
+
+func (*DateLiteralContext) IsDateLiteralContext() {}
+
+func NewDateLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DateLiteralContext {
+	var p = new(DateLiteralContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_dateLiteral
+
+	return p
+}
+
+func (s *DateLiteralContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DateLiteralContext) DATE_LIT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserDATE_LIT, 0)
+}
+
+func (s *DateLiteralContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DateLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DateLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterDateLiteral(s)
+	}
+}
+
+func (s *DateLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitDateLiteral(s)
+	}
+}
+
+func (s *DateLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitDateLiteral(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) DateLiteral() (localctx IDateLiteralContext) {
+	localctx = NewDateLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 122, grulev3ParserRULE_dateLiteral)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(595)
+		p.Match(grulev3ParserDATE_LIT)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
 // IBooleanLiteralContext is an interface to support dynamic dispatch.
 type IBooleanLiteralContext interface {
 	antlr.ParserRuleContext
@@ -5580,12 +12077,12 @@ func (s *BooleanLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *grulev3Parser) BooleanLiteral() (localctx IBooleanLiteralContext) {
 	localctx = NewBooleanLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, grulev3ParserRULE_booleanLiteral)
+	p.EnterRule(localctx, 124, grulev3ParserRULE_booleanLiteral)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(260)
+		p.SetState(597)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == grulev3ParserTRUE || _la == grulev3ParserFALSE) {
@@ -5611,21 +12108,21 @@ errorExit:
 
 func (p *grulev3Parser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 10:
+	case 33:
 		var t *ExpressionContext = nil
 		if localctx != nil {
 			t = localctx.(*ExpressionContext)
 		}
 		return p.Expression_Sempred(t, predIndex)
 
-	case 16:
+	case 39:
 		var t *ExpressionAtomContext = nil
 		if localctx != nil {
 			t = localctx.(*ExpressionAtomContext)
 		}
 		return p.ExpressionAtom_Sempred(t, predIndex)
 
-	case 18:
+	case 44:
 		var t *VariableContext = nil
 		if localctx != nil {
 			t = localctx.(*VariableContext)
@@ -5640,18 +12137,30 @@ func (p *grulev3Parser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex
 func (p *grulev3Parser) Expression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 7)
+		return p.Precpred(p.GetParserRuleContext(), 12)
 
 	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 6)
+		return p.Precpred(p.GetParserRuleContext(), 10)
 
 	case 2:
-		return p.Precpred(p.GetParserRuleContext(), 5)
+		return p.Precpred(p.GetParserRuleContext(), 9)
 
 	case 3:
-		return p.Precpred(p.GetParserRuleContext(), 4)
+		return p.Precpred(p.GetParserRuleContext(), 8)
 
 	case 4:
+		return p.Precpred(p.GetParserRuleContext(), 7)
+
+	case 5:
+		return p.Precpred(p.GetParserRuleContext(), 6)
+
+	case 6:
+		return p.Precpred(p.GetParserRuleContext(), 5)
+
+	case 7:
+		return p.Precpred(p.GetParserRuleContext(), 4)
+
+	case 8:
 		return p.Precpred(p.GetParserRuleContext(), 3)
 
 	default:
@@ -5661,13 +12170,13 @@ func (p *grulev3Parser) Expression_Sempred(localctx antlr.RuleContext, predIndex
 
 func (p *grulev3Parser) ExpressionAtom_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
-	case 5:
+	case 9:
 		return p.Precpred(p.GetParserRuleContext(), 4)
 
-	case 6:
+	case 10:
 		return p.Precpred(p.GetParserRuleContext(), 3)
 
-	case 7:
+	case 11:
 		return p.Precpred(p.GetParserRuleContext(), 2)
 
 	default:
@@ -5677,10 +12186,10 @@ func (p *grulev3Parser) ExpressionAtom_Sempred(localctx antlr.RuleContext, predI
 
 func (p *grulev3Parser) Variable_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
-	case 8:
+	case 12:
 		return p.Precpred(p.GetParserRuleContext(), 3)
 
-	case 9:
+	case 13:
 		return p.Precpred(p.GetParserRuleContext(), 2)
 
 	default:
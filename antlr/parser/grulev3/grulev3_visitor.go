@@ -10,12 +10,69 @@ type grulev3Visitor interface {
 	// Visit a parse tree produced by grulev3Parser#grl.
 	VisitGrl(ctx *GrlContext) interface{}
 
+	// Visit a parse tree produced by grulev3Parser#packageDeclaration.
+	VisitPackageDeclaration(ctx *PackageDeclarationContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#importDeclaration.
+	VisitImportDeclaration(ctx *ImportDeclarationContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#qualifiedName.
+	VisitQualifiedName(ctx *QualifiedNameContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#identifier.
+	VisitIdentifier(ctx *IdentifierContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#functionDeclaration.
+	VisitFunctionDeclaration(ctx *FunctionDeclarationContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#parameterList.
+	VisitParameterList(ctx *ParameterListContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#constDeclaration.
+	VisitConstDeclaration(ctx *ConstDeclarationContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#globalDeclaration.
+	VisitGlobalDeclaration(ctx *GlobalDeclarationContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#typeName.
+	VisitTypeName(ctx *TypeNameContext) interface{}
+
 	// Visit a parse tree produced by grulev3Parser#ruleEntry.
 	VisitRuleEntry(ctx *RuleEntryContext) interface{}
 
+	// Visit a parse tree produced by grulev3Parser#ruleExtends.
+	VisitRuleExtends(ctx *RuleExtendsContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#ruleAttribute.
+	VisitRuleAttribute(ctx *RuleAttributeContext) interface{}
+
 	// Visit a parse tree produced by grulev3Parser#salience.
 	VisitSalience(ctx *SalienceContext) interface{}
 
+	// Visit a parse tree produced by grulev3Parser#agendaGroup.
+	VisitAgendaGroup(ctx *AgendaGroupContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#activationGroup.
+	VisitActivationGroup(ctx *ActivationGroupContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#noLoop.
+	VisitNoLoop(ctx *NoLoopContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#lockOnActive.
+	VisitLockOnActive(ctx *LockOnActiveContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#dateEffective.
+	VisitDateEffective(ctx *DateEffectiveContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#dateExpires.
+	VisitDateExpires(ctx *DateExpiresContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#enabled.
+	VisitEnabled(ctx *EnabledContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#ruleMetadata.
+	VisitRuleMetadata(ctx *RuleMetadataContext) interface{}
+
 	// Visit a parse tree produced by grulev3Parser#ruleName.
 	VisitRuleName(ctx *RuleNameContext) interface{}
 
@@ -31,6 +88,18 @@ type grulev3Visitor interface {
 	// Visit a parse tree produced by grulev3Parser#thenExpressionList.
 	VisitThenExpressionList(ctx *ThenExpressionListContext) interface{}
 
+	// Visit a parse tree produced by grulev3Parser#thenStatement.
+	VisitThenStatement(ctx *ThenStatementContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#letStatement.
+	VisitLetStatement(ctx *LetStatementContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#ifStatement.
+	VisitIfStatement(ctx *IfStatementContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#thenBlock.
+	VisitThenBlock(ctx *ThenBlockContext) interface{}
+
 	// Visit a parse tree produced by grulev3Parser#thenExpression.
 	VisitThenExpression(ctx *ThenExpressionContext) interface{}
 
@@ -61,6 +130,15 @@ type grulev3Visitor interface {
 	// Visit a parse tree produced by grulev3Parser#constant.
 	VisitConstant(ctx *ConstantContext) interface{}
 
+	// Visit a parse tree produced by grulev3Parser#listLiteral.
+	VisitListLiteral(ctx *ListLiteralContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#mapLiteral.
+	VisitMapLiteral(ctx *MapLiteralContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#mapEntry.
+	VisitMapEntry(ctx *MapEntryContext) interface{}
+
 	// Visit a parse tree produced by grulev3Parser#variable.
 	VisitVariable(ctx *VariableContext) interface{}
 
@@ -73,6 +151,12 @@ type grulev3Visitor interface {
 	// Visit a parse tree produced by grulev3Parser#functionCall.
 	VisitFunctionCall(ctx *FunctionCallContext) interface{}
 
+	// Visit a parse tree produced by grulev3Parser#quantifier.
+	VisitQuantifier(ctx *QuantifierContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#aggregate.
+	VisitAggregate(ctx *AggregateContext) interface{}
+
 	// Visit a parse tree produced by grulev3Parser#methodCall.
 	VisitMethodCall(ctx *MethodCallContext) interface{}
 
@@ -103,6 +187,12 @@ type grulev3Visitor interface {
 	// Visit a parse tree produced by grulev3Parser#stringLiteral.
 	VisitStringLiteral(ctx *StringLiteralContext) interface{}
 
+	// Visit a parse tree produced by grulev3Parser#durationLiteral.
+	VisitDurationLiteral(ctx *DurationLiteralContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#dateLiteral.
+	VisitDateLiteral(ctx *DateLiteralContext) interface{}
+
 	// Visit a parse tree produced by grulev3Parser#booleanLiteral.
 	VisitBooleanLiteral(ctx *BooleanLiteralContext) interface{}
 }
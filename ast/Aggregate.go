@@ -0,0 +1,323 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast/unique"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+)
+
+const (
+	// AggregateSum adds up the values
+	AggregateSum = "sum"
+	// AggregateAvg is the arithmetic mean of the values
+	AggregateAvg = "avg"
+	// AggregateMin is the smallest of the values
+	AggregateMin = "min"
+	// AggregateMax is the largest of the values
+	AggregateMax = "max"
+)
+
+// IsAggregate returns true if the name is one of the aggregate kinds, regardless of its case.
+func IsAggregate(name string) bool {
+	switch strings.ToLower(name) {
+	case AggregateSum, AggregateAvg, AggregateMin, AggregateMax:
+
+		return true
+	}
+
+	return false
+}
+
+// NewAggregate creates new instance of Aggregate
+func NewAggregate() *Aggregate {
+
+	return &Aggregate{
+		AstID: unique.NewID(),
+	}
+}
+
+// Aggregate AST graph node. It accumulates the Value of the elements of an array, slice or map Collection
+// for which the optional Filter holds, each element being bound in turn to the local Variable.
+type Aggregate struct {
+	AstID   string
+	GrlText string
+
+	Kind       string
+	Variable   *Variable
+	Collection *ExpressionAtom
+	Value      *Expression
+	Filter     *Expression
+}
+
+// AggregateReceiver must be implemented by any AST object that will store an Aggregate
+type AggregateReceiver interface {
+	AcceptAggregate(aggregate *Aggregate) error
+}
+
+// MakeCatalog create a catalog entry for this AST Node
+func (e *Aggregate) MakeCatalog(cat *Catalog) {
+	meta := &AggregateMeta{
+		NodeMeta: NodeMeta{
+			AstID:    e.AstID,
+			GrlText:  e.GrlText,
+			Snapshot: e.GetSnapshot(),
+		},
+	}
+	if cat.AddMeta(e.AstID, meta) {
+		meta.Kind = e.Kind
+		if e.Variable != nil {
+			meta.VariableID = e.Variable.AstID
+			e.Variable.MakeCatalog(cat)
+		}
+		if e.Collection != nil {
+			meta.CollectionID = e.Collection.AstID
+			e.Collection.MakeCatalog(cat)
+		}
+		if e.Value != nil {
+			meta.ValueID = e.Value.AstID
+			e.Value.MakeCatalog(cat)
+		}
+		if e.Filter != nil {
+			meta.FilterID = e.Filter.AstID
+			e.Filter.MakeCatalog(cat)
+		}
+	}
+}
+
+// Clone will clone this Aggregate. The new clone will have an identical structure
+func (e *Aggregate) Clone(cloneTable *pkg.CloneTable) *Aggregate {
+	clone := &Aggregate{
+		AstID:   unique.NewID(),
+		GrlText: e.GrlText,
+		Kind:    e.Kind,
+	}
+
+	if e.Variable != nil {
+		if cloneTable.IsCloned(e.Variable.AstID) {
+			clone.Variable = cloneTable.Records[e.Variable.AstID].CloneInstance.(*Variable)
+		} else {
+			cloned := e.Variable.Clone(cloneTable)
+			clone.Variable = cloned
+			cloneTable.MarkCloned(e.Variable.AstID, cloned.AstID, e.Variable, cloned)
+		}
+	}
+
+	if e.Collection != nil {
+		if cloneTable.IsCloned(e.Collection.AstID) {
+			clone.Collection = cloneTable.Records[e.Collection.AstID].CloneInstance.(*ExpressionAtom)
+		} else {
+			cloned := e.Collection.Clone(cloneTable)
+			clone.Collection = cloned
+			cloneTable.MarkCloned(e.Collection.AstID, cloned.AstID, e.Collection, cloned)
+		}
+	}
+
+	if e.Value != nil {
+		if cloneTable.IsCloned(e.Value.AstID) {
+			clone.Value = cloneTable.Records[e.Value.AstID].CloneInstance.(*Expression)
+		} else {
+			cloned := e.Value.Clone(cloneTable)
+			clone.Value = cloned
+			cloneTable.MarkCloned(e.Value.AstID, cloned.AstID, e.Value, cloned)
+		}
+	}
+
+	if e.Filter != nil {
+		if cloneTable.IsCloned(e.Filter.AstID) {
+			clone.Filter = cloneTable.Records[e.Filter.AstID].CloneInstance.(*Expression)
+		} else {
+			cloned := e.Filter.Clone(cloneTable)
+			clone.Filter = cloned
+			cloneTable.MarkCloned(e.Filter.AstID, cloned.AstID, e.Filter, cloned)
+		}
+	}
+
+	return clone
+}
+
+// AcceptExpressionAtom will accept the collection of this aggregate
+func (e *Aggregate) AcceptExpressionAtom(atm *ExpressionAtom) error {
+	if e.Collection != nil {
+
+		return errors.New("collection for aggregate already assigned")
+	}
+	e.Collection = atm
+
+	return nil
+}
+
+// AcceptExpression will accept the value of this aggregate, then its filter
+func (e *Aggregate) AcceptExpression(exp *Expression) error {
+	if e.Value == nil {
+		e.Value = exp
+
+		return nil
+	}
+	if e.Filter != nil {
+
+		return errors.New("filter for aggregate already assigned")
+	}
+	e.Filter = exp
+
+	return nil
+}
+
+// GetAstID get the UUID asigned for this AST graph node
+func (e *Aggregate) GetAstID() string {
+
+	return e.AstID
+}
+
+// GetGrlText get the expression syntax related to this graph when it wast constructed
+func (e *Aggregate) GetGrlText() string {
+
+	return e.GrlText
+}
+
+// GetSnapshot will create a structure signature or AST graph
+func (e *Aggregate) GetSnapshot() string {
+	var buff strings.Builder
+	buff.WriteString(AGGREGATE)
+	buff.WriteString("(")
+	buff.WriteString(e.Kind)
+	buff.WriteString(":")
+	if e.Value != nil {
+		buff.WriteString(e.Value.GetSnapshot())
+	}
+	buff.WriteString(" for ")
+	if e.Variable != nil {
+		buff.WriteString(e.Variable.GetSnapshot())
+	}
+	buff.WriteString(" in ")
+	if e.Collection != nil {
+		buff.WriteString(e.Collection.GetSnapshot())
+	}
+	if e.Filter != nil {
+		buff.WriteString(" if ")
+		buff.WriteString(e.Filter.GetSnapshot())
+	}
+	buff.WriteString(")")
+
+	return buff.String()
+}
+
+// SetGrlText set the expression syntax related to this graph when it was constructed. Only ANTLR4 listener should
+// call this function.
+func (e *Aggregate) SetGrlText(grlText string) {
+	e.GrlText = grlText
+}
+
+// Evaluate will accumulate the values of the elements of the collection the filter holds for.
+// The sum of no element is zero, while avg, min and max of no element is an error.
+func (e *Aggregate) Evaluate(dataContext IDataContext, memory *WorkingMemory) (reflect.Value, error) {
+	_, err := e.Collection.Evaluate(dataContext, memory)
+	if err != nil {
+
+		return reflect.Value{}, err
+	}
+	elements, err := quantifiedElements(e.Collection.ValueNode)
+	if err != nil {
+
+		return reflect.Value{}, err
+	}
+	var result reflect.Value
+	count := int64(0)
+	for _, element := range elements {
+		memory.setLocal(e.Variable.Local, element)
+		if e.Filter != nil {
+			// the memoized values of the filter belong to the previous element.
+			unsetExpression(e.Filter)
+			holds, err := e.Filter.Evaluate(dataContext, memory)
+			if err != nil {
+
+				return reflect.Value{}, err
+			}
+			if holds.Kind() != reflect.Bool {
+
+				return reflect.Value{}, fmt.Errorf("filter of %s must evaluate to a boolean, got %s", e.GrlText, holds.Kind().String())
+			}
+			if !holds.Bool() {
+
+				continue
+			}
+		}
+		unsetExpression(e.Value)
+		val, err := e.Value.Evaluate(dataContext, memory)
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+		val = pkg.GetValueElem(val)
+		if !pkg.IsNumber(val) {
+
+			return reflect.Value{}, fmt.Errorf("value of %s must evaluate to a number, got %s", e.GrlText, val.Kind().String())
+		}
+		count++
+		if count == 1 {
+			result = val
+
+			continue
+		}
+		result, err = e.accumulate(result, val)
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+	}
+	if count == 0 {
+		if e.Kind == AggregateSum {
+
+			return reflect.ValueOf(int64(0)), nil
+		}
+
+		return reflect.Value{}, fmt.Errorf("%s has no element to aggregate", e.GrlText)
+	}
+	if e.Kind == AggregateAvg {
+
+		return pkg.EvaluateDivision(result, reflect.ValueOf(count))
+	}
+
+	return result, nil
+}
+
+// accumulate folds the next value into the accumulated one.
+func (e *Aggregate) accumulate(accumulated, next reflect.Value) (reflect.Value, error) {
+	switch e.Kind {
+	case AggregateMin:
+		lesser, err := pkg.EvaluateLesserThan(next, accumulated)
+		if err != nil || !lesser.Bool() {
+
+			return accumulated, err
+		}
+
+		return next, nil
+	case AggregateMax:
+		greater, err := pkg.EvaluateGreaterThan(next, accumulated)
+		if err != nil || !greater.Bool() {
+
+			return accumulated, err
+		}
+
+		return next, nil
+	}
+
+	return pkg.EvaluateAddition(accumulated, next)
+}
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"github.com/DataWiseHQ/grule-rule-engine/ast/unique"
 	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"reflect"
 	"strings"
 )
 
@@ -179,7 +180,13 @@ func (e *Assignment) Execute(dataContext IDataContext, memory *WorkingMemory) er
 		return err
 	}
 	if e.IsAssign {
-		return e.Variable.Assign(exprVal, dataContext, memory)
+		if !memory.hasAssignmentListeners() {
+			return e.Variable.assign(exprVal, e.Expression.isNullSafe(), dataContext, memory)
+		}
+		// the old value is only needed by the listeners, a variable that does not exist yet has none.
+		oldVal, _ := e.Variable.Evaluate(dataContext, memory)
+
+		return e.assign(oldVal, exprVal, dataContext, memory)
 	}
 	varval, err := e.Variable.Evaluate(dataContext, memory)
 	if err != nil {
@@ -192,7 +199,7 @@ func (e *Assignment) Execute(dataContext IDataContext, memory *WorkingMemory) er
 			return err
 		}
 
-		return e.Variable.Assign(nval, dataContext, memory)
+		return e.assign(varval, nval, dataContext, memory)
 	}
 	if e.IsMinusAssign {
 		nval, err := pkg.EvaluateSubtraction(varval, exprVal)
@@ -201,7 +208,7 @@ func (e *Assignment) Execute(dataContext IDataContext, memory *WorkingMemory) er
 			return err
 		}
 
-		return e.Variable.Assign(nval, dataContext, memory)
+		return e.assign(varval, nval, dataContext, memory)
 	}
 	if e.IsMulAssign {
 		nval, err := pkg.EvaluateMultiplication(varval, exprVal)
@@ -210,7 +217,7 @@ func (e *Assignment) Execute(dataContext IDataContext, memory *WorkingMemory) er
 			return err
 		}
 
-		return e.Variable.Assign(nval, dataContext, memory)
+		return e.assign(varval, nval, dataContext, memory)
 	}
 	if e.IsDivAssign {
 		nval, err := pkg.EvaluateDivision(varval, exprVal)
@@ -219,8 +226,34 @@ func (e *Assignment) Execute(dataContext IDataContext, memory *WorkingMemory) er
 			return err
 		}
 
-		return e.Variable.Assign(nval, dataContext, memory)
+		return e.assign(varval, nval, dataContext, memory)
 	}
 
 	return nil
 }
+
+// assign will assign the new value into the variable, and notify the working memory's assignment listeners.
+func (e *Assignment) assign(oldVal, newVal reflect.Value, dataContext IDataContext, memory *WorkingMemory) error {
+	// only a plain assignment of a null-safe chain may assign a nil as the zero value of the variable.
+	nullSafe := e.IsAssign && e.Expression.isNullSafe()
+	if !memory.hasAssignmentListeners() {
+
+		return e.Variable.assign(newVal, nullSafe, dataContext, memory)
+	}
+	// the old value may refer to the field being assigned, keep a copy of it.
+	if oldVal.IsValid() && oldVal.CanInterface() {
+		oldVal = reflect.ValueOf(oldVal.Interface())
+	}
+	err := e.Variable.assign(newVal, nullSafe, dataContext, memory)
+	if err == nil {
+		memory.notifyAssignment(e.Variable, oldVal, newVal)
+	}
+
+	return err
+}
+
+// AssignmentListener is notified of every assignment executed in a then scope.
+type AssignmentListener interface {
+	// VariableAssigned will be called after the variable got assigned. The old value is invalid if the variable did not exist.
+	VariableAssigned(variable *Variable, oldValue, newValue reflect.Value)
+}
@@ -22,6 +22,8 @@ import (
 )
 
 const (
+	// AGGREGATE signature for aggregate snapshot
+	AGGREGATE = "AGG"
 	// ARGUMENTLIST signature for argument list snapshot
 	ARGUMENTLIST = "AL"
 	// MAPARRAYSELECTOR signature for map array snapshot
@@ -30,14 +32,26 @@ const (
 	ASSIGMENT = "AS"
 	// CONSTANT signature for constant snapshot
 	CONSTANT = "C"
+	// DECLARATION signature for declaration snapshot
+	DECLARATION = "DCL"
 	// EXPRESSION signature for expression snapshot
 	EXPRESSION = "E"
 	// EXPRESSIONATOM signature for expression atom snapshot
 	EXPRESSIONATOM = "A"
+	// FUNCTION signature for function snapshot
+	FUNCTION = "FN"
 	// FUNCTIONCALL signature for function call snapshot
 	FUNCTIONCALL = "F"
+	// IFSTATEMENT signature for if statement snapshot
+	IFSTATEMENT = "IF"
+	// LETSTATEMENT signature for let statement snapshot
+	LETSTATEMENT = "LET"
+	// QUANTIFIER signature for quantifier snapshot
+	QUANTIFIER = "Q"
 	// RULEENTRY signature for rule entry snapshot
 	RULEENTRY = "R"
+	// RULEFLOW signature for rule flow snapshot
+	RULEFLOW = "RF"
 	// THENEXPRESSION signature for then expression snapshot
 	THENEXPRESSION = "TE"
 	// THENEXPRESSIONLIST signature for then expression list snapshot
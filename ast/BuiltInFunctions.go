@@ -135,11 +135,20 @@ func (gf *BuiltInFunctions) IsZero(i interface{}) bool {
 	}
 }
 
-// Retract will retract a rule from next evaluation cycle.
+// Retract will retract a rule from next evaluation cycle. A name which is not qualified refers to the rule of the
+// package of the executing rule first.
 func (gf *BuiltInFunctions) Retract(ruleName string) {
+	if entry := gf.DataContext.GetRuleEntry(); entry != nil && len(entry.Package) > 0 && gf.Knowledge.ContainsRuleEntry(entry.Package+"."+ruleName) {
+		ruleName = entry.Package + "." + ruleName
+	}
 	gf.Knowledge.RetractRule(ruleName)
 }
 
+// SetFocus will give the focus to an agenda group, its rules are executed first.
+func (gf *BuiltInFunctions) SetFocus(agendaGroup string) {
+	gf.Knowledge.SetFocus(agendaGroup)
+}
+
 // GetTimeYear will get the year value of time
 func (gf *BuiltInFunctions) GetTimeYear(time time.Time) int {
 
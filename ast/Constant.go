@@ -19,9 +19,12 @@ import (
 	"encoding/binary"
 	"fmt"
 	"github.com/DataWiseHQ/grule-rule-engine/ast/unique"
+	"io"
 	"math"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/DataWiseHQ/grule-rule-engine/pkg"
 )
@@ -56,32 +59,7 @@ func (e *Constant) MakeCatalog(cat *Catalog) {
 	}
 	if cat.AddMeta(e.AstID, meta) {
 		var buff bytes.Buffer
-		switch e.Value.Kind() {
-		case reflect.String:
-			meta.ValueType = TypeString
-			length := make([]byte, 8)
-			data := []byte(e.Value.String())
-			binary.LittleEndian.PutUint64(length, uint64(len(data)))
-			buff.Write(length)
-			buff.Write(data)
-		case reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8, reflect.Uint, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8, reflect.Int:
-			meta.ValueType = TypeInteger
-			intData := make([]byte, 8)
-			binary.LittleEndian.PutUint64(intData, uint64(e.Value.Int()))
-			buff.Write(intData)
-		case reflect.Float32, reflect.Float64:
-			meta.ValueType = TypeFloat
-			floatData := make([]byte, 8)
-			binary.LittleEndian.PutUint64(floatData, math.Float64bits(e.Value.Float()))
-			buff.Write(floatData)
-		case reflect.Bool:
-			meta.ValueType = TypeBoolean
-			if e.Value.Bool() {
-				buff.WriteByte(1)
-			} else {
-				buff.WriteByte(0)
-			}
-		}
+		meta.ValueType = writeConstantValue(&buff, e.Value)
 		meta.ValueBytes = buff.Bytes()
 		meta.IsNil = e.IsNil
 	}
@@ -120,6 +98,12 @@ func (e *Constant) GetSnapshot() string {
 	var buff strings.Builder
 	buff.WriteString(CONSTANT)
 	buff.WriteString("(")
+	if snapshot, ok := timeSnapshot(e.Value); ok {
+		buff.WriteString(snapshot)
+		buff.WriteString(")")
+
+		return buff.String()
+	}
 	buff.WriteString(e.Value.Kind().String())
 	buff.WriteString("->")
 	switch e.Value.Kind() {
@@ -133,12 +117,306 @@ func (e *Constant) GetSnapshot() string {
 		buff.WriteString(fmt.Sprintf("%f", e.Value.Float()))
 	case reflect.Bool:
 		buff.WriteString(fmt.Sprintf("%v", e.Value.Bool()))
+	case reflect.Slice, reflect.Map:
+		buff.WriteString(literalSnapshot(e.Value))
 	}
 	buff.WriteString(")")
 
 	return buff.String()
 }
 
+// literalSnapshot creates the signature of a list or map literal, its map entries sorted by key.
+func literalSnapshot(val reflect.Value) string {
+	if val.Kind() == reflect.Interface {
+		val = val.Elem()
+	}
+	if snapshot, ok := timeSnapshot(val); ok {
+
+		return snapshot
+	}
+	switch val.Kind() {
+	case reflect.Invalid:
+
+		return "nil"
+	case reflect.String:
+
+		return fmt.Sprintf("\"%s\"", val.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+
+		return fmt.Sprintf("%d", val.Int())
+	case reflect.Float32, reflect.Float64:
+
+		return fmt.Sprintf("%f", val.Float())
+	case reflect.Slice:
+		elements := make([]string, val.Len())
+		for i := range elements {
+			elements[i] = literalSnapshot(val.Index(i))
+		}
+
+		return fmt.Sprintf("[%s]", strings.Join(elements, ","))
+	case reflect.Map:
+		entries := make([]string, 0, val.Len())
+		for _, key := range val.MapKeys() {
+			entries = append(entries, fmt.Sprintf("%s:%s", literalSnapshot(key), literalSnapshot(val.MapIndex(key))))
+		}
+		sort.Strings(entries)
+
+		return fmt.Sprintf("{%s}", strings.Join(entries, ","))
+	}
+
+	return fmt.Sprintf("%v", val.Interface())
+}
+
+// timeSnapshot creates the signature of a time.Time, a time.Duration or a pkg.Period value, which would otherwise
+// be mistaken for the struct or the integer of its kind.
+func timeSnapshot(val reflect.Value) (string, bool) {
+	if !val.IsValid() {
+
+		return "", false
+	}
+	switch value := val.Interface().(type) {
+	case time.Time:
+
+		return fmt.Sprintf("%s->@%s", val.Type().String(), value.Format(time.RFC3339Nano)), true
+	case time.Duration, pkg.Period:
+
+		return fmt.Sprintf("%s->%s", val.Type().String(), value), true
+	}
+
+	return "", false
+}
+
+// writeConstantValue writes the binary form of a constant value into the buffer and returns its type label.
+// The elements of a list or map are written one after another, each prefixed by its type label and length.
+func writeConstantValue(buff *bytes.Buffer, val reflect.Value) ValueType {
+	if val.Kind() == reflect.Interface {
+		val = val.Elem()
+	}
+	if val.IsValid() {
+		switch value := val.Interface().(type) {
+		case time.Duration:
+			writeConstantLength(buff, int(value))
+
+			return TypeDuration
+		case pkg.Period:
+			for _, part := range []int{value.Years, value.Months, value.Days, int(value.Duration)} {
+				writeConstantLength(buff, part)
+			}
+
+			return TypePeriod
+		case time.Time:
+			data, _ := value.MarshalBinary()
+			buff.Write(data)
+
+			return TypeTime
+		}
+	}
+	switch val.Kind() {
+	case reflect.String:
+		length := make([]byte, 8)
+		data := []byte(val.String())
+		binary.LittleEndian.PutUint64(length, uint64(len(data)))
+		buff.Write(length)
+		buff.Write(data)
+
+		return TypeString
+	case reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8, reflect.Uint, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8, reflect.Int:
+		intData := make([]byte, 8)
+		binary.LittleEndian.PutUint64(intData, uint64(val.Int()))
+		buff.Write(intData)
+
+		return TypeInteger
+	case reflect.Float32, reflect.Float64:
+		floatData := make([]byte, 8)
+		binary.LittleEndian.PutUint64(floatData, math.Float64bits(val.Float()))
+		buff.Write(floatData)
+
+		return TypeFloat
+	case reflect.Bool:
+		if val.Bool() {
+			buff.WriteByte(1)
+		} else {
+			buff.WriteByte(0)
+		}
+
+		return TypeBoolean
+	case reflect.Slice:
+		writeConstantLength(buff, val.Len())
+		for i := 0; i < val.Len(); i++ {
+			writeConstantElement(buff, val.Index(i))
+		}
+
+		return TypeList
+	case reflect.Map:
+		writeConstantLength(buff, val.Len())
+		for _, key := range val.MapKeys() {
+			writeConstantElement(buff, key)
+			writeConstantElement(buff, val.MapIndex(key))
+		}
+
+		return TypeMap
+	}
+
+	return TypeNil
+}
+
+func writeConstantLength(buff *bytes.Buffer, length int) {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, uint64(length))
+	buff.Write(data)
+}
+
+func writeConstantElement(buff *bytes.Buffer, val reflect.Value) {
+	var element bytes.Buffer
+	valueType := writeConstantValue(&element, val)
+	writeConstantLength(buff, int(valueType))
+	writeConstantLength(buff, element.Len())
+	buff.Write(element.Bytes())
+}
+
+// readConstantValue reads a constant value of the type label written by writeConstantValue.
+func readConstantValue(buffer *bytes.Buffer, valueType ValueType) (reflect.Value, error) {
+	switch valueType {
+	case TypeString:
+		length, err := readConstantLength(buffer)
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+		byteArr := make([]byte, length)
+		_, err = io.ReadFull(buffer, byteArr)
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+
+		return reflect.ValueOf(string(byteArr)), nil
+	case TypeBoolean:
+		arr := make([]byte, 1)
+		_, err := buffer.Read(arr)
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+
+		return reflect.ValueOf(arr[0] == 1), nil
+	case TypeInteger:
+		integer, err := readConstantLength(buffer)
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+
+		return reflect.ValueOf(int64(integer)), nil
+	case TypeFloat:
+		bits, err := readConstantLength(buffer)
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+
+		return reflect.ValueOf(math.Float64frombits(bits)), nil
+	case TypeList:
+		values, err := readConstantElements(buffer, 1)
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+
+		return MakeListValue(values), nil
+	case TypeMap:
+		entries, err := readConstantElements(buffer, 2)
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+		keys := make([]reflect.Value, 0, len(entries)/2)
+		values := make([]reflect.Value, 0, len(entries)/2)
+		for i := 0; i < len(entries); i += 2 {
+			keys = append(keys, entries[i])
+			values = append(values, entries[i+1])
+		}
+
+		return MakeMapValue(keys, values), nil
+	case TypeDuration:
+		duration, err := readConstantLength(buffer)
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+
+		return reflect.ValueOf(time.Duration(duration)), nil
+	case TypePeriod:
+		parts := make([]int, 4)
+		for i := range parts {
+			part, err := readConstantLength(buffer)
+			if err != nil {
+
+				return reflect.Value{}, err
+			}
+			parts[i] = int(part)
+		}
+
+		return reflect.ValueOf(pkg.Period{Years: parts[0], Months: parts[1], Days: parts[2], Duration: time.Duration(parts[3])}), nil
+	case TypeTime:
+		date := time.Time{}
+		err := date.UnmarshalBinary(buffer.Bytes())
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+
+		return reflect.ValueOf(date), nil
+	case TypeNil:
+
+		return reflect.Value{}, nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("unrecognized type %d", valueType)
+}
+
+func readConstantLength(buffer *bytes.Buffer) (uint64, error) {
+	data := make([]byte, 8)
+	_, err := io.ReadFull(buffer, data)
+	if err != nil {
+
+		return 0, err
+	}
+
+	return binary.LittleEndian.Uint64(data), nil
+}
+
+// readConstantElements reads the count prefixed elements of a list, or the key and value pairs of a map.
+func readConstantElements(buffer *bytes.Buffer, perEntry int) ([]reflect.Value, error) {
+	count, err := readConstantLength(buffer)
+	if err != nil {
+
+		return nil, err
+	}
+	values := make([]reflect.Value, 0, int(count)*perEntry)
+	for i := 0; i < int(count)*perEntry; i++ {
+		valueType, err := readConstantLength(buffer)
+		if err != nil {
+
+			return nil, err
+		}
+		length, err := readConstantLength(buffer)
+		if err != nil {
+
+			return nil, err
+		}
+		element := bytes.NewBuffer(buffer.Next(int(length)))
+		val, err := readConstantValue(element, ValueType(valueType))
+		if err != nil {
+
+			return nil, err
+		}
+		values = append(values, val)
+	}
+
+	return values, nil
+}
+
 // SetGrlText set the expression syntax related to this graph when it was constructed. Only ANTLR4 listener should
 // call this function.
 func (e *Constant) SetGrlText(grlText string) {
@@ -160,6 +438,26 @@ func (e *Constant) AcceptFloatLiteral(fun *FloatLiteral) {
 	e.Value = reflect.ValueOf(fun.Float)
 }
 
+// AcceptDurationLiteral will accept duration literal
+func (e *Constant) AcceptDurationLiteral(fun *DurationLiteral) {
+	e.Value = fun.Duration
+}
+
+// AcceptDateLiteral will accept date literal
+func (e *Constant) AcceptDateLiteral(fun *DateLiteral) {
+	e.Value = reflect.ValueOf(fun.Date)
+}
+
+// AcceptListLiteral will accept list literal
+func (e *Constant) AcceptListLiteral(fun *ListLiteral) {
+	e.Value = MakeListValue(fun.Values)
+}
+
+// AcceptMapLiteral will accept map literal
+func (e *Constant) AcceptMapLiteral(fun *MapLiteral) {
+	e.Value = MakeMapValue(fun.Keys, fun.Values)
+}
+
 // AcceptBooleanLiteral will accept boolean literal
 func (e *Constant) AcceptBooleanLiteral(fun *BooleanLiteral) {
 	e.Value = reflect.ValueOf(fun.Boolean)
@@ -40,6 +40,17 @@ type DataContext struct {
 	variableChangeCount uint64
 	complete            bool
 	ruleEntry           *RuleEntry
+	listeners           []DataContextListener
+}
+
+// DataContextListener is notified when facts are added, modified or retracted from a DataContext.
+type DataContextListener interface {
+	// FactAdded will be called when a fact with a new key is added, or a retracted fact is made available again.
+	FactAdded(key string)
+	// FactModified will be called when a fact replaces another fact with the same key.
+	FactModified(key string)
+	// FactRetracted will be called when a fact is retracted.
+	FactRetracted(key string)
 }
 
 func (ctx *DataContext) GetKeys() []string {
@@ -95,6 +106,40 @@ type IDataContext interface {
 	GetRuleEntry() *RuleEntry
 }
 
+// ObservableDataContext is implemented by the data contexts that notify their fact changes. It is optional,
+// the RETE network evaluates all its conditions in every cycle when the data context does not implement it.
+type ObservableDataContext interface {
+	AddListener(listener DataContextListener)
+	RemoveListener(listener DataContextListener)
+}
+
+// AddListener will register a listener to be notified on fact changes.
+func (ctx *DataContext) AddListener(listener DataContextListener) {
+	ctx.listeners = append(ctx.listeners, listener)
+}
+
+// RemoveListener will unregister a listener previously added using AddListener.
+func (ctx *DataContext) RemoveListener(listener DataContextListener) {
+	for i, l := range ctx.listeners {
+		if l == listener {
+			ctx.listeners = append(ctx.listeners[:i], ctx.listeners[i+1:]...)
+
+			return
+		}
+	}
+}
+
+// notifyFactChange will notify all registered listeners that the fact with the key has been added or modified.
+func (ctx *DataContext) notifyFactChange(key string, existed bool) {
+	for _, l := range ctx.listeners {
+		if existed {
+			l.FactModified(key)
+		} else {
+			l.FactAdded(key)
+		}
+	}
+}
+
 // ResetVariableChangeCount will reset the variable change count
 func (ctx *DataContext) ResetVariableChangeCount() {
 	ctx.variableChangeCount = 0
@@ -113,7 +158,9 @@ func (ctx *DataContext) HasVariableChange() bool {
 
 // Add will add struct instance into rule execution context
 func (ctx *DataContext) Add(key string, obj interface{}) error {
+	_, existed := ctx.ObjectStore[key]
 	ctx.ObjectStore[key] = model.NewGoValueNode(reflect.ValueOf(obj), key)
+	ctx.notifyFactChange(key, existed)
 
 	return nil
 }
@@ -125,7 +172,9 @@ func (ctx *DataContext) AddJSON(key string, JSON []byte) error {
 
 		return err
 	}
+	_, existed := ctx.ObjectStore[key]
 	ctx.ObjectStore[key] = vn
+	ctx.notifyFactChange(key, existed)
 
 	return nil
 }
@@ -142,7 +191,14 @@ func (ctx *DataContext) Get(key string) model.ValueNode {
 
 // Retract temporary retract a fact from data context, making it unavailable for evaluation or modification.
 func (ctx *DataContext) Retract(key string) {
+	if ctx.IsRetracted(key) {
+
+		return
+	}
 	ctx.retracted = append(ctx.retracted, key)
+	for _, l := range ctx.listeners {
+		l.FactRetracted(key)
+	}
 }
 
 // IsRetracted checks if a key fact is currently retracted.
@@ -164,5 +220,11 @@ func (ctx *DataContext) Retracted() []string {
 
 // Reset will un-retract all fact, making them available for evaluation and modification.
 func (ctx *DataContext) Reset() {
+	retracted := ctx.retracted
 	ctx.retracted = make([]string, 0)
+	for _, key := range retracted {
+		for _, l := range ctx.listeners {
+			l.FactAdded(key)
+		}
+	}
 }
@@ -0,0 +1,196 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast/unique"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+)
+
+const (
+	// DeclarationConst declares a named constant, inlined into the rules that use it when they are built
+	DeclarationConst = "const"
+	// DeclarationGlobal declares the name and type of a fact expected in the data context
+	DeclarationGlobal = "global"
+)
+
+// NewDeclaration creates new instance of Declaration
+func NewDeclaration(kind string) *Declaration {
+
+	return &Declaration{
+		AstID: unique.NewID(),
+		Kind:  kind,
+	}
+}
+
+// Declaration AST graph node. It is a top level declaration of a GRL resource, either a const with its Constant
+// value, or a global with the Type of the fact.
+type Declaration struct {
+	AstID   string
+	GrlText string
+
+	Kind     string
+	Name     string
+	Type     string
+	Constant *Constant
+}
+
+// DeclarationReceiver must be implemented by any AST object that will store a Declaration
+type DeclarationReceiver interface {
+	AcceptDeclaration(declaration *Declaration) error
+}
+
+// MakeCatalog create a catalog entry for this AST Node
+func (e *Declaration) MakeCatalog(cat *Catalog) {
+	meta := &DeclarationMeta{
+		NodeMeta: NodeMeta{
+			AstID:    e.AstID,
+			GrlText:  e.GrlText,
+			Snapshot: e.GetSnapshot(),
+		},
+	}
+	if cat.AddMeta(e.AstID, meta) {
+		meta.Kind = e.Kind
+		meta.Name = e.Name
+		meta.Type = e.Type
+		if e.Constant != nil {
+			meta.ConstantID = e.Constant.AstID
+			e.Constant.MakeCatalog(cat)
+		}
+	}
+}
+
+// Clone will clone this Declaration. The new clone will have an identical structure
+func (e *Declaration) Clone(cloneTable *pkg.CloneTable) *Declaration {
+	clone := &Declaration{
+		AstID:   unique.NewID(),
+		GrlText: e.GrlText,
+		Kind:    e.Kind,
+		Name:    e.Name,
+		Type:    e.Type,
+	}
+
+	if e.Constant != nil {
+		if cloneTable.IsCloned(e.Constant.AstID) {
+			clone.Constant = cloneTable.Records[e.Constant.AstID].CloneInstance.(*Constant)
+		} else {
+			cloned := e.Constant.Clone(cloneTable)
+			clone.Constant = cloned
+			cloneTable.MarkCloned(e.Constant.AstID, cloned.AstID, e.Constant, cloned)
+		}
+	}
+
+	return clone
+}
+
+// AcceptConstant will accept the value of a const declaration
+func (e *Declaration) AcceptConstant(con *Constant) error {
+	if e.Constant != nil {
+
+		return errors.New("constant for declaration already assigned")
+	}
+	e.Constant = con
+
+	return nil
+}
+
+// GetAstID get the UUID asigned for this AST graph node
+func (e *Declaration) GetAstID() string {
+
+	return e.AstID
+}
+
+// GetGrlText get the expression syntax related to this graph when it wast constructed
+func (e *Declaration) GetGrlText() string {
+
+	return e.GrlText
+}
+
+// GetSnapshot will create a structure signature or AST graph
+func (e *Declaration) GetSnapshot() string {
+	var buff strings.Builder
+	buff.WriteString(DECLARATION)
+	buff.WriteString("(")
+	buff.WriteString(e.Kind)
+	buff.WriteString(":")
+	buff.WriteString(e.Name)
+	if len(e.Type) > 0 {
+		buff.WriteString(":")
+		buff.WriteString(e.Type)
+	}
+	if e.Constant != nil {
+		buff.WriteString("=")
+		buff.WriteString(e.Constant.GetSnapshot())
+	}
+	buff.WriteString(")")
+
+	return buff.String()
+}
+
+// SetGrlText set the expression syntax related to this graph when it was constructed. Only ANTLR4 listener should
+// call this function.
+func (e *Declaration) SetGrlText(grlText string) {
+	e.GrlText = grlText
+}
+
+// Validate will check that the fact of a global declaration is in the data context with the declared type.
+func (e *Declaration) Validate(dataContext IDataContext) error {
+	if e.Kind != DeclarationGlobal {
+
+		return nil
+	}
+	valueNode := dataContext.Get(e.Name)
+	if valueNode == nil || dataContext.IsRetracted(e.Name) {
+
+		return fmt.Errorf("global %s of type %s is not in the data context", e.Name, e.Type)
+	}
+	typ, err := valueNode.GetType()
+	if err != nil || typ == nil {
+
+		return fmt.Errorf("global %s of type %s has no type in the data context", e.Name, e.Type)
+	}
+	if !IsDeclaredType(e.Type, typ) {
+
+		return fmt.Errorf("global %s is declared of type %s, but the data context holds a %s", e.Name, e.Type, typ.String())
+	}
+
+	return nil
+}
+
+// IsDeclaredType returns true if the type matches the type name of a global declaration. The name may be qualified
+// with the package name, and a name without a leading * matches a pointer to the type as well.
+func IsDeclaredType(name string, typ reflect.Type) bool {
+	if strings.HasPrefix(name, "*") {
+		if typ.Kind() != reflect.Pointer {
+
+			return false
+		}
+		name = name[1:]
+		typ = typ.Elem()
+	} else if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if strings.Contains(name, ".") {
+
+		return typ.String() == name
+	}
+
+	return typ.Name() == name
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"github.com/DataWiseHQ/grule-rule-engine/ast/unique"
 	"reflect"
+	"regexp"
 	"strings"
 
 	"github.com/DataWiseHQ/grule-rule-engine/pkg"
@@ -55,6 +56,22 @@ const (
 	OpAnd
 	// OpOr Logical Or operator
 	OpOr
+	// OpIn Membership operator
+	OpIn
+	// OpNotIn Non membership operator
+	OpNotIn
+	// OpBetween Inclusive range operator
+	OpBetween
+	// OpMatches Regular expression match operator
+	OpMatches
+	// OpConditional Conditional operator
+	OpConditional
+	// OpCoalesce Null coalescing operator
+	OpCoalesce
+	// OpPow Exponent operator
+	OpPow
+	// OpIntDiv Integer division operator
+	OpIntDiv
 )
 
 // NewExpression creates new Expression instance
@@ -77,8 +94,18 @@ type Expression struct {
 	ExpressionAtom   *ExpressionAtom
 	Operator         int
 	Negated          bool
+	Negative         bool
 	Value            reflect.Value
 
+	// UpperExpression is the upper bound of the between operator, LeftExpression being its value
+	// and RightExpression its lower bound.
+	UpperExpression *Expression
+	// Pattern is the compiled pattern of the matches operator, when it is a string constant.
+	Pattern *regexp.Regexp
+	// ElseExpression is the value of the conditional operator when its condition, the LeftExpression, is false.
+	// RightExpression is its value when the condition is true.
+	ElseExpression *Expression
+
 	Evaluated bool
 }
 
@@ -108,8 +135,17 @@ func (e *Expression) MakeCatalog(cat *Catalog) {
 			meta.ExpressionAtomID = e.ExpressionAtom.AstID
 			e.ExpressionAtom.MakeCatalog(cat)
 		}
+		if e.UpperExpression != nil {
+			meta.UpperExpressionID = e.UpperExpression.AstID
+			e.UpperExpression.MakeCatalog(cat)
+		}
+		if e.ElseExpression != nil {
+			meta.ElseExpressionID = e.ElseExpression.AstID
+			e.ElseExpression.MakeCatalog(cat)
+		}
 		meta.Operator = e.Operator
 		meta.Negated = e.Negated
+		meta.Negative = e.Negative
 	}
 }
 
@@ -120,6 +156,8 @@ func (e *Expression) Clone(cloneTable *pkg.CloneTable) *Expression {
 		GrlText:  e.GrlText,
 		Operator: e.Operator,
 		Negated:  e.Negated,
+		Negative: e.Negative,
+		Pattern:  e.Pattern,
 	}
 
 	if e.LeftExpression != nil {
@@ -163,6 +201,26 @@ func (e *Expression) Clone(cloneTable *pkg.CloneTable) *Expression {
 		}
 	}
 
+	if e.UpperExpression != nil {
+		if cloneTable.IsCloned(e.UpperExpression.AstID) {
+			clone.UpperExpression = cloneTable.Records[e.UpperExpression.AstID].CloneInstance.(*Expression)
+		} else {
+			cloned := e.UpperExpression.Clone(cloneTable)
+			clone.UpperExpression = cloned
+			cloneTable.MarkCloned(e.UpperExpression.AstID, cloned.AstID, e.UpperExpression, cloned)
+		}
+	}
+
+	if e.ElseExpression != nil {
+		if cloneTable.IsCloned(e.ElseExpression.AstID) {
+			clone.ElseExpression = cloneTable.Records[e.ElseExpression.AstID].CloneInstance.(*Expression)
+		} else {
+			cloned := e.ElseExpression.Clone(cloneTable)
+			clone.ElseExpression = cloned
+			cloneTable.MarkCloned(e.ElseExpression.AstID, cloned.AstID, e.ElseExpression, cloned)
+		}
+	}
+
 	return clone
 }
 
@@ -174,6 +232,14 @@ func (e *Expression) AcceptExpression(exp *Expression) error {
 		e.LeftExpression = e.SingleExpression
 		e.RightExpression = exp
 		e.SingleExpression = nil
+		if e.Operator == OpMatches {
+
+			return e.CompilePattern()
+		}
+	} else if e.Operator == OpBetween && e.UpperExpression == nil {
+		e.UpperExpression = exp
+	} else if e.Operator == OpConditional && e.ElseExpression == nil {
+		e.ElseExpression = exp
 	} else {
 
 		return errors.New("left or right side expression already assigned")
@@ -182,6 +248,28 @@ func (e *Expression) AcceptExpression(exp *Expression) error {
 	return nil
 }
 
+// CompilePattern will compile the pattern of the matches operator once if it is a string constant,
+// instead of at each evaluation.
+func (e *Expression) CompilePattern() error {
+	if e.RightExpression == nil || e.RightExpression.ExpressionAtom == nil || e.RightExpression.ExpressionAtom.Constant == nil {
+
+		return nil
+	}
+	constant := e.RightExpression.ExpressionAtom.Constant
+	if constant.Value.Kind() != reflect.String {
+
+		return fmt.Errorf("pattern %s of %s is not a string", constant.GrlText, e.GrlText)
+	}
+	pattern, err := regexp.Compile(constant.Value.String())
+	if err != nil {
+
+		return fmt.Errorf("invalid pattern %s : %w", constant.GrlText, err)
+	}
+	e.Pattern = pattern
+
+	return nil
+}
+
 // ExpressionReceiver contains function to be implemented by other AST graph to receive an Expression AST graph
 type ExpressionReceiver interface {
 	AcceptExpression(exp *Expression) error
@@ -216,6 +304,9 @@ func (e *Expression) GetSnapshot() string {
 		if e.Negated {
 			buff.WriteString("!")
 		}
+		if e.Negative {
+			buff.WriteString("-")
+		}
 		buff.WriteString(e.SingleExpression.GetSnapshot())
 		buff.WriteString(")")
 	}
@@ -255,11 +346,37 @@ func (e *Expression) GetSnapshot() string {
 			buff.WriteString("&&")
 		case OpOr:
 			buff.WriteString("||")
+		case OpIn:
+			buff.WriteString("IN")
+		case OpNotIn:
+			buff.WriteString("NOTIN")
+		case OpBetween:
+			buff.WriteString("BETWEEN")
+		case OpMatches:
+			buff.WriteString("MATCHES")
+		case OpConditional:
+			buff.WriteString("?")
+		case OpCoalesce:
+			buff.WriteString("??")
+		case OpPow:
+			buff.WriteString("**")
+		case OpIntDiv:
+			buff.WriteString("~/")
 		}
 
 		buff.WriteString("ER(")
 		buff.WriteString(e.RightExpression.GetSnapshot())
 		buff.WriteString(")")
+		if e.UpperExpression != nil {
+			buff.WriteString("EU(")
+			buff.WriteString(e.UpperExpression.GetSnapshot())
+			buff.WriteString(")")
+		}
+		if e.ElseExpression != nil {
+			buff.WriteString(":EE(")
+			buff.WriteString(e.ElseExpression.GetSnapshot())
+			buff.WriteString(")")
+		}
 	}
 	if e.ExpressionAtom != nil {
 		buff.WriteString("EA(")
@@ -277,6 +394,20 @@ func (e *Expression) SetGrlText(grlText string) {
 	e.GrlText = grlText
 }
 
+// isNullSafe tells whether the expression is a null-safe chain or a null coalescing, which may yield a nil.
+func (e *Expression) isNullSafe() bool {
+	if e.Operator == OpCoalesce && e.LeftExpression != nil {
+
+		return true
+	}
+	if e.SingleExpression != nil {
+
+		return e.SingleExpression.isNullSafe()
+	}
+
+	return e.ExpressionAtom != nil && e.ExpressionAtom.isNullSafe()
+}
+
 // Evaluate will evaluate this AST graph for when scope evaluation
 func (e *Expression) Evaluate(dataContext IDataContext, memory *WorkingMemory) (reflect.Value, error) {
 	if e.Evaluated == true {
@@ -294,6 +425,9 @@ func (e *Expression) Evaluate(dataContext IDataContext, memory *WorkingMemory) (
 	}
 	if e.SingleExpression != nil {
 		val, err := e.SingleExpression.Evaluate(dataContext, memory)
+		if err == nil && e.Negative {
+			val, err = pkg.EvaluateUnaryMinus(val)
+		}
 		if err == nil {
 			e.Value = val
 			if e.Negated {
@@ -313,6 +447,26 @@ func (e *Expression) Evaluate(dataContext IDataContext, memory *WorkingMemory) (
 		var opErr error
 
 		lval, lerr := e.LeftExpression.Evaluate(dataContext, memory)
+		if e.Operator == OpConditional {
+			if lerr != nil {
+
+				return reflect.Value{}, fmt.Errorf("condition expression error. got %v", lerr)
+			}
+
+			return e.evaluateConditional(lval, dataContext, memory)
+		}
+		if e.Operator == OpCoalesce {
+			if lerr != nil {
+
+				return reflect.Value{}, fmt.Errorf("left hand expression error. got %v", lerr)
+			}
+			if !pkg.IsNilValue(lval) {
+				e.Value = lval
+				e.Evaluated = true
+
+				return lval, nil
+			}
+		}
 		if e.Operator == OpAnd {
 			if lerr != nil {
 
@@ -357,6 +511,10 @@ func (e *Expression) Evaluate(dataContext IDataContext, memory *WorkingMemory) (
 			val, opErr = pkg.EvaluateDivision(lval, rval)
 		case OpMod:
 			val, opErr = pkg.EvaluateModulo(lval, rval)
+		case OpIntDiv:
+			val, opErr = pkg.EvaluateIntegerDivision(lval, rval)
+		case OpPow:
+			val, opErr = pkg.EvaluatePower(lval, rval)
 		case OpAdd:
 			val, opErr = pkg.EvaluateAddition(lval, rval)
 		case OpSub:
@@ -381,6 +539,21 @@ func (e *Expression) Evaluate(dataContext IDataContext, memory *WorkingMemory) (
 			val, opErr = pkg.EvaluateLogicAnd(lval, rval)
 		case OpOr:
 			val, opErr = pkg.EvaluateLogicOr(lval, rval)
+		case OpIn:
+			val, opErr = pkg.EvaluateIn(lval, rval)
+		case OpNotIn:
+			val, opErr = pkg.EvaluateNotIn(lval, rval)
+		case OpBetween:
+			uval, uerr := e.UpperExpression.Evaluate(dataContext, memory)
+			if uerr != nil {
+
+				return reflect.Value{}, fmt.Errorf("upper bound expression error. got %v", uerr)
+			}
+			val, opErr = pkg.EvaluateBetween(lval, rval, uval)
+		case OpMatches:
+			val, opErr = e.evaluateMatches(lval, rval)
+		case OpCoalesce:
+			val = rval
 		}
 		if opErr == nil {
 			e.Value = val
@@ -392,3 +565,46 @@ func (e *Expression) Evaluate(dataContext IDataContext, memory *WorkingMemory) (
 
 	return reflect.Value{}, nil
 }
+
+// evaluateMatches matches the value against the compiled pattern, or against the pattern evaluated from the
+// right hand expression when it is not a constant.
+func (e *Expression) evaluateMatches(val, patternVal reflect.Value) (reflect.Value, error) {
+	pattern := e.Pattern
+	if pattern == nil {
+		patternVal = pkg.GetValueElem(patternVal)
+		if patternVal.Kind() != reflect.String {
+
+			return reflect.Value{}, fmt.Errorf("pattern of %s must evaluate to a string, got %s", e.GrlText, patternVal.Kind().String())
+		}
+		var err error
+		pattern, err = regexp.Compile(patternVal.String())
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+	}
+
+	return pkg.EvaluateMatches(val, pattern)
+}
+
+// evaluateConditional evaluates only the branch selected by the condition.
+func (e *Expression) evaluateConditional(condition reflect.Value, dataContext IDataContext, memory *WorkingMemory) (reflect.Value, error) {
+	condition = pkg.GetValueElem(condition)
+	if condition.Kind() != reflect.Bool {
+
+		return reflect.Value{}, fmt.Errorf("condition of %s must evaluate to a boolean, got %s", e.GrlText, condition.Kind().String())
+	}
+	branch := e.ElseExpression
+	if condition.Bool() {
+		branch = e.RightExpression
+	}
+	val, err := branch.Evaluate(dataContext, memory)
+	if err != nil {
+
+		return reflect.Value{}, err
+	}
+	e.Value = val
+	e.Evaluated = true
+
+	return val, nil
+}
@@ -43,8 +43,11 @@ type ExpressionAtom struct {
 	FunctionCall     *FunctionCall
 	Variable         *Variable
 	Negated          bool
+	NullSafe         bool
 	ExpressionAtom   *ExpressionAtom
 	ArrayMapSelector *ArrayMapSelector
+	Quantifier       *Quantifier
+	Aggregate        *Aggregate
 
 	Value     reflect.Value
 	ValueNode model.ValueNode
@@ -82,8 +85,17 @@ func (e *ExpressionAtom) MakeCatalog(cat *Catalog) {
 			meta.ArrayMapSelectorID = e.ArrayMapSelector.AstID
 			e.ArrayMapSelector.MakeCatalog(cat)
 		}
+		if e.Quantifier != nil {
+			meta.QuantifierID = e.Quantifier.AstID
+			e.Quantifier.MakeCatalog(cat)
+		}
+		if e.Aggregate != nil {
+			meta.AggregateID = e.Aggregate.AstID
+			e.Aggregate.MakeCatalog(cat)
+		}
 		meta.VariableName = e.VariableName
 		meta.Negated = e.Negated
+		meta.NullSafe = e.NullSafe
 	}
 }
 
@@ -99,6 +111,7 @@ func (e *ExpressionAtom) Clone(cloneTable *pkg.CloneTable) *ExpressionAtom {
 		GrlText:      e.GrlText,
 		VariableName: e.VariableName,
 		Negated:      e.Negated,
+		NullSafe:     e.NullSafe,
 	}
 
 	if e.Constant != nil {
@@ -151,6 +164,26 @@ func (e *ExpressionAtom) Clone(cloneTable *pkg.CloneTable) *ExpressionAtom {
 		}
 	}
 
+	if e.Quantifier != nil {
+		if cloneTable.IsCloned(e.Quantifier.AstID) {
+			clone.Quantifier = cloneTable.Records[e.Quantifier.AstID].CloneInstance.(*Quantifier)
+		} else {
+			cloned := e.Quantifier.Clone(cloneTable)
+			clone.Quantifier = cloned
+			cloneTable.MarkCloned(e.Quantifier.AstID, cloned.AstID, e.Quantifier, cloned)
+		}
+	}
+
+	if e.Aggregate != nil {
+		if cloneTable.IsCloned(e.Aggregate.AstID) {
+			clone.Aggregate = cloneTable.Records[e.Aggregate.AstID].CloneInstance.(*Aggregate)
+		} else {
+			cloned := e.Aggregate.Clone(cloneTable)
+			clone.Aggregate = cloned
+			cloneTable.MarkCloned(e.Aggregate.AstID, cloned.AstID, e.Aggregate, cloned)
+		}
+	}
+
 	return clone
 }
 
@@ -210,6 +243,28 @@ func (e *ExpressionAtom) AcceptArrayMapSelector(sel *ArrayMapSelector) error {
 	return nil
 }
 
+// AcceptQuantifier will accept a Quantifier AST graph into this ast graph
+func (e *ExpressionAtom) AcceptQuantifier(quantifier *Quantifier) error {
+	if e.Quantifier != nil {
+
+		return errors.New("quantifier for ExpressionAtom already assigned")
+	}
+	e.Quantifier = quantifier
+
+	return nil
+}
+
+// AcceptAggregate will accept an Aggregate AST graph into this ast graph
+func (e *ExpressionAtom) AcceptAggregate(aggregate *Aggregate) error {
+	if e.Aggregate != nil {
+
+		return errors.New("aggregate for ExpressionAtom already assigned")
+	}
+	e.Aggregate = aggregate
+
+	return nil
+}
+
 // GetAstID get the UUID asigned for this AST graph node
 func (e *ExpressionAtom) GetAstID() string {
 
@@ -231,6 +286,10 @@ func (e *ExpressionAtom) GetSnapshot() string {
 		buff.WriteString(e.Variable.GetSnapshot())
 	} else if e.Constant != nil {
 		buff.WriteString(e.Constant.GetSnapshot())
+	} else if e.Quantifier != nil {
+		buff.WriteString(e.Quantifier.GetSnapshot())
+	} else if e.Aggregate != nil {
+		buff.WriteString(e.Aggregate.GetSnapshot())
 	} else if e.FunctionCall != nil && e.ExpressionAtom == nil {
 		buff.WriteString(e.FunctionCall.GetSnapshot())
 	} else if e.FunctionCall == nil && e.ExpressionAtom != nil && len(e.VariableName) == 0 {
@@ -240,10 +299,16 @@ func (e *ExpressionAtom) GetSnapshot() string {
 		buff.WriteString(e.ExpressionAtom.GetSnapshot())
 	} else if e.FunctionCall != nil && e.ExpressionAtom != nil {
 		buff.WriteString(e.ExpressionAtom.GetSnapshot())
+		if e.NullSafe {
+			buff.WriteString("?")
+		}
 		buff.WriteString("->")
 		buff.WriteString(e.FunctionCall.GetSnapshot())
 	} else if len(e.VariableName) > 0 && e.ExpressionAtom != nil {
 		buff.WriteString(e.ExpressionAtom.GetSnapshot())
+		if e.NullSafe {
+			buff.WriteString("?")
+		}
 		buff.WriteString("->MV:")
 		buff.WriteString(e.VariableName)
 	}
@@ -263,6 +328,20 @@ func (e *ExpressionAtom) SetGrlText(grlText string) {
 	e.GrlText = grlText
 }
 
+// isNullSafe tells whether the atom is reached through a null-safe member access or function call.
+func (e *ExpressionAtom) isNullSafe() bool {
+	if e.NullSafe {
+
+		return true
+	}
+	if e.Variable != nil && e.Variable.isNullSafe() {
+
+		return true
+	}
+
+	return e.ExpressionAtom != nil && e.ExpressionAtom.isNullSafe()
+}
+
 // Evaluate will evaluate this AST graph for when scope evaluation
 func (e *ExpressionAtom) Evaluate(dataContext IDataContext, memory *WorkingMemory) (val reflect.Value, err error) {
 	if e.Evaluated == true {
@@ -276,7 +355,11 @@ func (e *ExpressionAtom) Evaluate(dataContext IDataContext, memory *WorkingMemor
 			return reflect.Value{}, err
 		}
 		e.Value = val
-		e.ValueNode = model.NewGoValueNode(val, fmt.Sprintf("%s->%s", val.Type().String(), val.String()))
+		if val.IsValid() {
+			e.ValueNode = model.NewGoValueNode(val, fmt.Sprintf("%s->%s", val.Type().String(), val.String()))
+		} else {
+			e.ValueNode = model.NewGoValueNode(val, "nil")
+		}
 		e.Evaluated = true
 
 		return val, err
@@ -294,14 +377,42 @@ func (e *ExpressionAtom) Evaluate(dataContext IDataContext, memory *WorkingMemor
 
 		return val, err
 	}
+	if e.Quantifier != nil {
+		val, err := e.Quantifier.Evaluate(dataContext, memory)
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+		e.Value = val
+		e.ValueNode = model.NewGoValueNode(val, e.GrlText)
+		e.Evaluated = true
+
+		return val, nil
+	}
+	if e.Aggregate != nil {
+		val, err := e.Aggregate.Evaluate(dataContext, memory)
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+		e.Value = val
+		e.ValueNode = model.NewGoValueNode(val, e.GrlText)
+		e.Evaluated = true
+
+		return val, nil
+	}
 	if e.ExpressionAtom == nil && e.FunctionCall != nil {
-		valueNode := dataContext.Get("DEFUNC")
 		args, err := e.FunctionCall.EvaluateArgumentList(dataContext, memory)
 		if err != nil {
 
 			return reflect.Value{}, err
 		}
-		ret, err := valueNode.CallFunction(e.FunctionCall.FunctionName, args...)
+		var ret reflect.Value
+		if function := e.FunctionCall.GetFunction(memory); function != nil {
+			ret, err = function.Call(dataContext, memory, args...)
+		} else {
+			ret, err = dataContext.Get("DEFUNC").CallFunction(e.FunctionCall.FunctionName, args...)
+		}
 		if err != nil {
 
 			return reflect.Value{}, err
@@ -339,6 +450,13 @@ func (e *ExpressionAtom) Evaluate(dataContext IDataContext, memory *WorkingMemor
 
 			return reflect.ValueOf(nil), err
 		}
+		if e.NullSafe && pkg.IsNilValue(e.ExpressionAtom.Value) {
+			e.Value = reflect.Value{}
+			e.ValueNode = e.ExpressionAtom.ValueNode.ContinueWithValue(e.Value, e.FunctionCall.FunctionName)
+			e.Evaluated = true
+
+			return e.Value, nil
+		}
 
 		args, err := e.FunctionCall.EvaluateArgumentList(dataContext, memory)
 		if err != nil {
@@ -366,6 +484,13 @@ func (e *ExpressionAtom) Evaluate(dataContext IDataContext, memory *WorkingMemor
 
 			return reflect.Value{}, err
 		}
+		if e.NullSafe && isAbsentMember(e.ExpressionAtom.ValueNode, e.VariableName) {
+			e.Value = reflect.Value{}
+			e.ValueNode = e.ExpressionAtom.ValueNode.ContinueWithValue(e.Value, e.VariableName)
+			e.Evaluated = true
+
+			return e.Value, nil
+		}
 		valueNode, err := e.ExpressionAtom.ValueNode.GetChildNodeByField(e.VariableName)
 		if err != nil {
 
@@ -0,0 +1,261 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast/unique"
+	"github.com/DataWiseHQ/grule-rule-engine/model"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+)
+
+// MaxFunctionCallDepth is the maximum number of nested calls of a function declared in GRL, which stops
+// a runaway recursion before it exhausts the stack.
+var MaxFunctionCallDepth = 256
+
+// NewFunction creates new instance of Function
+func NewFunction() *Function {
+
+	return &Function{
+		AstID: unique.NewID(),
+	}
+}
+
+// Function AST graph node. It is a function declared in GRL, callable from any rule of its knowledge base.
+// A call binds the arguments to the Parameters local variables, executes the let statements, and returns
+// the value of the Expression.
+type Function struct {
+	AstID   string
+	GrlText string
+
+	Name          string
+	Parameters    []*Variable
+	LetStatements []*LetStatement
+	Expression    *Expression
+
+	depth int
+}
+
+// FunctionReceiver must be implemented by any AST object that will store a Function
+type FunctionReceiver interface {
+	AcceptFunction(function *Function) error
+}
+
+// FunctionScope returns the scope of the local variables of the function, as used by LocalVariableKey.
+func FunctionScope(name string) string {
+
+	return fmt.Sprintf("%s()", name)
+}
+
+// MakeCatalog create a catalog entry for this AST Node
+func (e *Function) MakeCatalog(cat *Catalog) {
+	meta := &FunctionMeta{
+		NodeMeta: NodeMeta{
+			AstID:    e.AstID,
+			GrlText:  e.GrlText,
+			Snapshot: e.GetSnapshot(),
+		},
+	}
+	if cat.AddMeta(e.AstID, meta) {
+		meta.Name = e.Name
+		meta.ParameterIDs = make([]string, len(e.Parameters))
+		for i, param := range e.Parameters {
+			meta.ParameterIDs[i] = param.AstID
+			param.MakeCatalog(cat)
+		}
+		meta.LetStatementIDs = make([]string, len(e.LetStatements))
+		for i, letStatement := range e.LetStatements {
+			meta.LetStatementIDs[i] = letStatement.AstID
+			letStatement.MakeCatalog(cat)
+		}
+		if e.Expression != nil {
+			meta.ExpressionID = e.Expression.AstID
+			e.Expression.MakeCatalog(cat)
+		}
+	}
+}
+
+// Clone will clone this Function. The new clone will have an identical structure
+func (e *Function) Clone(cloneTable *pkg.CloneTable) *Function {
+	clone := &Function{
+		AstID:   unique.NewID(),
+		GrlText: e.GrlText,
+		Name:    e.Name,
+	}
+
+	if e.Parameters != nil {
+		clone.Parameters = make([]*Variable, len(e.Parameters))
+		for i, param := range e.Parameters {
+			if cloneTable.IsCloned(param.AstID) {
+				clone.Parameters[i] = cloneTable.Records[param.AstID].CloneInstance.(*Variable)
+			} else {
+				cloned := param.Clone(cloneTable)
+				clone.Parameters[i] = cloned
+				cloneTable.MarkCloned(param.AstID, cloned.AstID, param, cloned)
+			}
+		}
+	}
+
+	if e.LetStatements != nil {
+		clone.LetStatements = make([]*LetStatement, len(e.LetStatements))
+		for i, letStatement := range e.LetStatements {
+			if cloneTable.IsCloned(letStatement.AstID) {
+				clone.LetStatements[i] = cloneTable.Records[letStatement.AstID].CloneInstance.(*LetStatement)
+			} else {
+				cloned := letStatement.Clone(cloneTable)
+				clone.LetStatements[i] = cloned
+				cloneTable.MarkCloned(letStatement.AstID, cloned.AstID, letStatement, cloned)
+			}
+		}
+	}
+
+	if e.Expression != nil {
+		if cloneTable.IsCloned(e.Expression.AstID) {
+			clone.Expression = cloneTable.Records[e.Expression.AstID].CloneInstance.(*Expression)
+		} else {
+			cloned := e.Expression.Clone(cloneTable)
+			clone.Expression = cloned
+			cloneTable.MarkCloned(e.Expression.AstID, cloned.AstID, e.Expression, cloned)
+		}
+	}
+
+	return clone
+}
+
+// AcceptLetStatement will accept a let statement of the function body
+func (e *Function) AcceptLetStatement(letStatement *LetStatement) error {
+	e.LetStatements = append(e.LetStatements, letStatement)
+
+	return nil
+}
+
+// AcceptExpression will accept the Expression whose value is returned by the function
+func (e *Function) AcceptExpression(exp *Expression) error {
+	if e.Expression != nil {
+
+		return errors.New("return expression for function already assigned")
+	}
+	e.Expression = exp
+
+	return nil
+}
+
+// GetAstID get the UUID asigned for this AST graph node
+func (e *Function) GetAstID() string {
+
+	return e.AstID
+}
+
+// GetGrlText get the expression syntax related to this graph when it wast constructed
+func (e *Function) GetGrlText() string {
+
+	return e.GrlText
+}
+
+// GetSnapshot will create a structure signature or AST graph
+func (e *Function) GetSnapshot() string {
+	var buff strings.Builder
+	buff.WriteString(FUNCTION)
+	buff.WriteString("(")
+	buff.WriteString(e.Name)
+	buff.WriteString("(")
+	for i, param := range e.Parameters {
+		if i > 0 {
+			buff.WriteString(",")
+		}
+		buff.WriteString(param.GetSnapshot())
+	}
+	buff.WriteString(")")
+	for _, letStatement := range e.LetStatements {
+		buff.WriteString(letStatement.GetSnapshot())
+	}
+	if e.Expression != nil {
+		buff.WriteString(e.Expression.GetSnapshot())
+	}
+	buff.WriteString(")")
+
+	return buff.String()
+}
+
+// SetGrlText set the expression syntax related to this graph when it was constructed. Only ANTLR4 listener should
+// call this function.
+func (e *Function) SetGrlText(grlText string) {
+	e.GrlText = grlText
+}
+
+// Call will call this function with the arguments and return the value of its expression.
+// The local variables of an outer call of the same function are restored once the call returns.
+func (e *Function) Call(dataContext IDataContext, memory *WorkingMemory, args ...reflect.Value) (reflect.Value, error) {
+	if len(args) != len(e.Parameters) {
+
+		return reflect.Value{}, fmt.Errorf("function %s expects %d arguments, got %d", e.Name, len(e.Parameters), len(args))
+	}
+	if e.depth >= MaxFunctionCallDepth {
+
+		return reflect.Value{}, fmt.Errorf("function %s exceeds the maximum call depth of %d", e.Name, MaxFunctionCallDepth)
+	}
+	e.depth++
+	saved := make(map[string]model.ValueNode)
+	for _, variable := range e.locals() {
+		if value, ok := memory.getLocal(variable.Local); ok {
+			saved[variable.Local] = value
+		}
+	}
+	defer func() {
+		e.depth--
+		// the memoized values of the body belong to this call.
+		e.unset()
+		for key, value := range saved {
+			memory.setLocal(key, value)
+		}
+	}()
+
+	for i, param := range e.Parameters {
+		memory.setLocal(param.Local, model.NewGoValueNode(args[i], param.Name))
+	}
+	e.unset()
+	for _, letStatement := range e.LetStatements {
+		err := letStatement.Execute(dataContext, memory)
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+	}
+
+	return e.Expression.Evaluate(dataContext, memory)
+}
+
+// locals returns the local variables of the function, its parameters and the variables of its let statements.
+func (e *Function) locals() []*Variable {
+	locals := make([]*Variable, 0, len(e.Parameters)+len(e.LetStatements))
+	locals = append(locals, e.Parameters...)
+	for _, letStatement := range e.LetStatements {
+		locals = append(locals, letStatement.Variable)
+	}
+
+	return locals
+}
+
+// unset clears the evaluated status of the expressions of the function body.
+func (e *Function) unset() {
+	for _, letStatement := range e.LetStatements {
+		unsetExpression(letStatement.Expression)
+	}
+	unsetExpression(e.Expression)
+}
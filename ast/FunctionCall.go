@@ -37,6 +37,8 @@ type FunctionCall struct {
 	GrlText string
 
 	FunctionName string
+	// Package is the package of the GRL calling the function, a function declared in it is called first.
+	Package      string
 	ArgumentList *ArgumentList
 	Value        reflect.Value
 }
@@ -56,6 +58,7 @@ func (e *FunctionCall) MakeCatalog(cat *Catalog) {
 			e.ArgumentList.MakeCatalog(cat)
 		}
 		meta.FunctionName = e.FunctionName
+		meta.Package = e.Package
 	}
 }
 
@@ -65,6 +68,7 @@ func (e *FunctionCall) Clone(cloneTable *pkg.CloneTable) *FunctionCall {
 		AstID:        unique.NewID(),
 		GrlText:      e.GrlText,
 		FunctionName: e.FunctionName,
+		Package:      e.Package,
 	}
 
 	if e.ArgumentList != nil {
@@ -102,6 +106,9 @@ func (e *FunctionCall) GetSnapshot() string {
 	var buff strings.Builder
 	buff.WriteString(FUNCTIONCALL)
 	buff.WriteString(fmt.Sprintf("(n:%s", e.FunctionName))
+	if len(e.Package) > 0 {
+		buff.WriteString(fmt.Sprintf(",p:%s", e.Package))
+	}
 	if e.ArgumentList != nil {
 		buff.WriteString(",")
 		buff.WriteString(e.ArgumentList.GetSnapshot())
@@ -138,3 +145,16 @@ func (e *FunctionCall) EvaluateArgumentList(dataContext IDataContext, memory *Wo
 
 	return args, nil
 }
+
+// GetFunction returns the function declared in GRL this call refers to, the one declared in the package of the call
+// first, nil if the call refers to a built-in function.
+func (e *FunctionCall) GetFunction(memory *WorkingMemory) *Function {
+	if len(e.Package) > 0 {
+		if function := memory.GetFunction(e.Package + "." + e.FunctionName); function != nil {
+
+			return function
+		}
+	}
+
+	return memory.GetFunction(e.FunctionName)
+}
@@ -26,7 +26,12 @@ func NewGrl() *Grl {
 
 // Grl will contains multiple RuleEntries
 type Grl struct {
-	RuleEntries map[string]*RuleEntry
+	Package      string // declared by the package header, it qualifies the names of the rules, functions and consts
+	RuleEntries  map[string]*RuleEntry
+	Functions    map[string]*Function
+	Declarations map[string]*Declaration
+
+	declared []*RuleEntry
 }
 
 // GrlReceiver is interface for objects that should hold a GRL, will be called by ANTLR walker.
@@ -44,6 +49,41 @@ func (g *Grl) ReceiveRuleEntry(entry *RuleEntry) error {
 		return fmt.Errorf("duplicate rule entry %s", entry.RuleName)
 	}
 	g.RuleEntries[entry.RuleName] = entry
+	g.declared = append(g.declared, entry)
+
+	return nil
+}
+
+// DeclaredRuleEntries returns the rule entries in the order they were declared in the GRL.
+func (g *Grl) DeclaredRuleEntries() []*RuleEntry {
+
+	return g.declared
+}
+
+// AcceptFunction will make this GRL to accept functions created by ANTLR walker
+func (g *Grl) AcceptFunction(function *Function) error {
+	if g.Functions == nil {
+		g.Functions = make(map[string]*Function)
+	}
+	if _, ok := g.Functions[function.Name]; ok {
+
+		return fmt.Errorf("duplicate function %s", function.Name)
+	}
+	g.Functions[function.Name] = function
+
+	return nil
+}
+
+// AcceptDeclaration will make this GRL to accept const and global declarations created by ANTLR walker
+func (g *Grl) AcceptDeclaration(declaration *Declaration) error {
+	if g.Declarations == nil {
+		g.Declarations = make(map[string]*Declaration)
+	}
+	if _, ok := g.Declarations[declaration.Name]; ok {
+
+		return fmt.Errorf("duplicate declaration %s", declaration.Name)
+	}
+	g.Declarations[declaration.Name] = declaration
 
 	return nil
 }
@@ -0,0 +1,223 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast/unique"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+)
+
+// NewIfStatement creates new instance of IfStatement
+func NewIfStatement() *IfStatement {
+
+	return &IfStatement{
+		AstID: unique.NewID(),
+	}
+}
+
+// IfStatement AST graph node. It executes the Then list when the Condition is true, otherwise the Else list if any.
+// An else-if chain is represented as an Else list holding a single nested IfStatement.
+type IfStatement struct {
+	AstID   string
+	GrlText string
+	Line    int // line of the statement within its GRL resource, zero if unknown
+
+	Condition *Expression
+	Then      *ThenExpressionList
+	Else      *ThenExpressionList
+}
+
+// IfStatementReceiver must be implemented by any AST object that will store an IfStatement
+type IfStatementReceiver interface {
+	AcceptIfStatement(ifStatement *IfStatement) error
+}
+
+// MakeCatalog create a catalog entry for this AST Node
+func (e *IfStatement) MakeCatalog(cat *Catalog) {
+	meta := &IfStatementMeta{
+		NodeMeta: NodeMeta{
+			AstID:    e.AstID,
+			GrlText:  e.GrlText,
+			Snapshot: e.GetSnapshot(),
+		},
+	}
+	if cat.AddMeta(e.AstID, meta) {
+		meta.Line = e.Line
+		if e.Condition != nil {
+			meta.ConditionID = e.Condition.AstID
+			e.Condition.MakeCatalog(cat)
+		}
+		if e.Then != nil {
+			meta.ThenID = e.Then.AstID
+			e.Then.MakeCatalog(cat)
+		}
+		if e.Else != nil {
+			meta.ElseID = e.Else.AstID
+			e.Else.MakeCatalog(cat)
+		}
+	}
+}
+
+// Clone will clone this IfStatement. The new clone will have an identical structure
+func (e *IfStatement) Clone(cloneTable *pkg.CloneTable) *IfStatement {
+	clone := &IfStatement{
+		AstID:   unique.NewID(),
+		GrlText: e.GrlText,
+		Line:    e.Line,
+	}
+
+	if e.Condition != nil {
+		if cloneTable.IsCloned(e.Condition.AstID) {
+			clone.Condition = cloneTable.Records[e.Condition.AstID].CloneInstance.(*Expression)
+		} else {
+			cloned := e.Condition.Clone(cloneTable)
+			clone.Condition = cloned
+			cloneTable.MarkCloned(e.Condition.AstID, cloned.AstID, e.Condition, cloned)
+		}
+	}
+
+	if e.Then != nil {
+		if cloneTable.IsCloned(e.Then.AstID) {
+			clone.Then = cloneTable.Records[e.Then.AstID].CloneInstance.(*ThenExpressionList)
+		} else {
+			cloned := e.Then.Clone(cloneTable)
+			clone.Then = cloned
+			cloneTable.MarkCloned(e.Then.AstID, cloned.AstID, e.Then, cloned)
+		}
+	}
+
+	if e.Else != nil {
+		if cloneTable.IsCloned(e.Else.AstID) {
+			clone.Else = cloneTable.Records[e.Else.AstID].CloneInstance.(*ThenExpressionList)
+		} else {
+			cloned := e.Else.Clone(cloneTable)
+			clone.Else = cloned
+			cloneTable.MarkCloned(e.Else.AstID, cloned.AstID, e.Else, cloned)
+		}
+	}
+
+	return clone
+}
+
+// AcceptExpression will accept the condition Expression of this if statement
+func (e *IfStatement) AcceptExpression(exp *Expression) error {
+	if e.Condition != nil {
+
+		return errors.New("condition for if statement already assigned")
+	}
+	e.Condition = exp
+
+	return nil
+}
+
+// AcceptThenExpressionList will accept the then block, and the else block on the second call
+func (e *IfStatement) AcceptThenExpressionList(list *ThenExpressionList) error {
+	if e.Then == nil {
+		e.Then = list
+
+		return nil
+	}
+	if e.Else == nil {
+		e.Else = list
+
+		return nil
+	}
+
+	return errors.New("else block for if statement already assigned")
+}
+
+// AcceptIfStatement will accept a nested else-if statement, it is stored as the only statement of the else block
+func (e *IfStatement) AcceptIfStatement(ifStatement *IfStatement) error {
+	elseIf := NewThenExpression()
+	elseIf.GrlText = ifStatement.GrlText
+	elseIf.Line = ifStatement.Line
+	elseIf.IfStatement = ifStatement
+	elseList := NewThenExpressionList()
+	elseList.GrlText = ifStatement.GrlText
+	elseList.ThenExpressions = append(elseList.ThenExpressions, elseIf)
+
+	return e.AcceptThenExpressionList(elseList)
+}
+
+// GetAstID get the UUID asigned for this AST graph node
+func (e *IfStatement) GetAstID() string {
+
+	return e.AstID
+}
+
+// GetGrlText get the expression syntax related to this graph when it wast constructed
+func (e *IfStatement) GetGrlText() string {
+
+	return e.GrlText
+}
+
+// GetSnapshot will create a structure signature or AST graph
+func (e *IfStatement) GetSnapshot() string {
+	var buff strings.Builder
+	buff.WriteString(IFSTATEMENT)
+	buff.WriteString("(")
+	if e.Condition != nil {
+		buff.WriteString(e.Condition.GetSnapshot())
+	}
+	if e.Then != nil {
+		buff.WriteString(e.Then.GetSnapshot())
+	}
+	if e.Else != nil {
+		buff.WriteString(" ELSE ")
+		buff.WriteString(e.Else.GetSnapshot())
+	}
+	buff.WriteString(")")
+
+	return buff.String()
+}
+
+// SetGrlText set the expression syntax related to this graph when it was constructed. Only ANTLR4 listener should
+// call this function.
+func (e *IfStatement) SetGrlText(grlText string) {
+	e.GrlText = grlText
+}
+
+// Execute will evaluate the condition and execute the matching block
+func (e *IfStatement) Execute(dataContext IDataContext, memory *WorkingMemory) error {
+	val, err := e.Condition.Evaluate(dataContext, memory)
+	if err != nil {
+		AstLog.Errorf("error while evaluating if condition %s. got %s", e.Condition.GrlText, err.Error())
+
+		return err
+	}
+	if val.Kind() != reflect.Bool {
+
+		return fmt.Errorf("if condition %s must evaluate to a boolean, got %s", e.Condition.GrlText, val.Kind().String())
+	}
+	if val.Bool() {
+		if e.Then != nil {
+
+			return e.Then.Execute(dataContext, memory)
+		}
+
+		return nil
+	}
+	if e.Else != nil {
+
+		return e.Else.Execute(dataContext, memory)
+	}
+
+	return nil
+}
@@ -18,9 +18,11 @@ import (
 	"fmt"
 	"github.com/google/uuid"
 	"io"
+	"reflect"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/DataWiseHQ/grule-rule-engine/pkg"
 )
@@ -69,6 +71,7 @@ func (lib *KnowledgeLibrary) RemoveRuleEntry(ruleName, name string, version stri
 			lib.Library[nameVersion].RuleEntries[ruleName].Deleted = true
 			delete(lib.Library[nameVersion].RuleEntries, ruleName)
 			lib.Library[nameVersion].RuleEntries[ruleEntry.RuleName] = ruleEntry
+			lib.Library[nameVersion].reteNetwork = nil
 		}
 	}
 }
@@ -156,6 +159,13 @@ type KnowledgeBase struct {
 	DataContext   IDataContext
 	WorkingMemory *WorkingMemory
 	RuleEntries   map[string]*RuleEntry
+	RuleFlows     map[string]*RuleFlow
+	Declarations  map[string]*Declaration
+
+	// focus is the agenda group focus stack, MainAgendaGroup is implicitly at its bottom.
+	focus []string
+	// reteNetwork is compiled on first use, and again once a rule entry is added or removed.
+	reteNetwork *ReteNetwork
 }
 
 // MakeCatalog will create a catalog entry for all AST Nodes under the KnowledgeBase
@@ -178,6 +188,12 @@ func (e *KnowledgeBase) MakeCatalog() *Catalog {
 	for _, v := range e.RuleEntries {
 		v.MakeCatalog(catalog)
 	}
+	for _, v := range e.Declarations {
+		v.MakeCatalog(catalog)
+	}
+	for _, v := range e.RuleFlows {
+		v.MakeCatalog(catalog)
+	}
 	e.WorkingMemory.MakeCatalog(catalog)
 
 	return catalog
@@ -209,6 +225,51 @@ func (e *KnowledgeBase) GetSnapshot() string {
 		buffer.WriteString(e.RuleEntries[k].GetSnapshot())
 	}
 	buffer.WriteString("]")
+	if e.WorkingMemory != nil && len(e.WorkingMemory.functions) > 0 {
+		names := make([]string, 0, len(e.WorkingMemory.functions))
+		for name := range e.WorkingMemory.functions {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		buffer.WriteString("{")
+		for i, name := range names {
+			if i > 0 {
+				buffer.WriteString(",")
+			}
+			buffer.WriteString(e.WorkingMemory.functions[name].GetSnapshot())
+		}
+		buffer.WriteString("}")
+	}
+	if len(e.Declarations) > 0 {
+		names := make([]string, 0, len(e.Declarations))
+		for name := range e.Declarations {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		buffer.WriteString("<")
+		for i, name := range names {
+			if i > 0 {
+				buffer.WriteString(",")
+			}
+			buffer.WriteString(e.Declarations[name].GetSnapshot())
+		}
+		buffer.WriteString(">")
+	}
+	if len(e.RuleFlows) > 0 {
+		names := make([]string, 0, len(e.RuleFlows))
+		for name := range e.RuleFlows {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		buffer.WriteString("(")
+		for i, name := range names {
+			if i > 0 {
+				buffer.WriteString(",")
+			}
+			buffer.WriteString(e.RuleFlows[name].GetSnapshot())
+		}
+		buffer.WriteString(")")
+	}
 
 	return buffer.String()
 }
@@ -231,6 +292,19 @@ func (e *KnowledgeBase) Clone(cloneTable *pkg.CloneTable) (*KnowledgeBase, error
 			}
 		}
 	}
+	// the transition conditions are in the working memory, so the rule flows are cloned before it.
+	for name, flow := range e.RuleFlows {
+		if clone.RuleFlows == nil {
+			clone.RuleFlows = make(map[string]*RuleFlow)
+		}
+		if cloneTable.IsCloned(flow.AstID) {
+			clone.RuleFlows[name] = cloneTable.Records[flow.AstID].CloneInstance.(*RuleFlow)
+		} else {
+			cloned := flow.Clone(cloneTable)
+			clone.RuleFlows[name] = cloned
+			cloneTable.MarkCloned(flow.AstID, cloned.AstID, flow, cloned)
+		}
+	}
 	if e.WorkingMemory != nil {
 		wm, err := e.WorkingMemory.Clone(cloneTable)
 		if err != nil {
@@ -238,10 +312,102 @@ func (e *KnowledgeBase) Clone(cloneTable *pkg.CloneTable) (*KnowledgeBase, error
 		}
 		clone.WorkingMemory = wm
 	}
-
+	for name, declaration := range e.Declarations {
+		if clone.Declarations == nil {
+			clone.Declarations = make(map[string]*Declaration)
+		}
+		if cloneTable.IsCloned(declaration.AstID) {
+			clone.Declarations[name] = cloneTable.Records[declaration.AstID].CloneInstance.(*Declaration)
+		} else {
+			cloned := declaration.Clone(cloneTable)
+			clone.Declarations[name] = cloned
+			cloneTable.MarkCloned(declaration.AstID, cloned.AstID, declaration, cloned)
+		}
+	}
 	return clone, nil
 }
 
+// AddRuleFlow add a rule flow into this knowledge base.
+// return an error if the flow is not valid or if a rule flow with the same name already exist in this knowledge base.
+func (e *KnowledgeBase) AddRuleFlow(flow *RuleFlow) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	err := flow.Validate()
+	if err != nil {
+
+		return err
+	}
+	if _, ok := e.RuleFlows[flow.Name]; ok {
+
+		return fmt.Errorf("rule flow %s already exist", flow.Name)
+	}
+	if e.RuleFlows == nil {
+		e.RuleFlows = make(map[string]*RuleFlow)
+	}
+	e.RuleFlows[flow.Name] = flow
+
+	return nil
+}
+
+// AddFunction add a function declared in GRL into this knowledge base.
+// return an error if the name is taken by a built-in function or by a function already in this knowledge base.
+func (e *KnowledgeBase) AddFunction(function *Function) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	name := function.Name[strings.LastIndex(function.Name, ".")+1:]
+	if _, ok := reflect.TypeOf(&BuiltInFunctions{}).MethodByName(name); ok {
+
+		return fmt.Errorf("function %s is a built-in function", function.Name)
+	}
+
+	return e.WorkingMemory.AddFunction(function)
+}
+
+// AddDeclaration add a const or global declaration into this knowledge base.
+// return an error if a declaration with the same name already exist in this knowledge base.
+func (e *KnowledgeBase) AddDeclaration(declaration *Declaration) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if _, ok := e.Declarations[declaration.Name]; ok {
+
+		return fmt.Errorf("%s is already declared", declaration.Name)
+	}
+	if e.Declarations == nil {
+		e.Declarations = make(map[string]*Declaration)
+	}
+	e.Declarations[declaration.Name] = declaration
+
+	return nil
+}
+
+// GetConstant returns the value of the const declared with the name, nil if there is none.
+func (e *KnowledgeBase) GetConstant(name string) *Constant {
+	if declaration, ok := e.Declarations[name]; ok && declaration.Kind == DeclarationConst {
+
+		return declaration.Constant
+	}
+
+	return nil
+}
+
+// ValidateGlobals will check that the data context holds the facts of the global declarations, with their type.
+func (e *KnowledgeBase) ValidateGlobals(dataCtx IDataContext) error {
+	names := make([]string, 0, len(e.Declarations))
+	for name := range e.Declarations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		err := e.Declarations[name].Validate(dataCtx)
+		if err != nil {
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 // AddRuleEntry add ruleentry into this knowledge base.
 // return an error if a rule entry with the same name already exist in this knowledge base.
 func (e *KnowledgeBase) AddRuleEntry(entry *RuleEntry) error {
@@ -251,7 +417,10 @@ func (e *KnowledgeBase) AddRuleEntry(entry *RuleEntry) error {
 
 		return fmt.Errorf("rule entry %s already exist", entry.RuleName)
 	}
+	// rule entries are never removed from the map, only marked as deleted, so its size is a stable declaration counter.
+	entry.Sequence = len(e.RuleEntries)
 	e.RuleEntries[entry.RuleName] = entry
+	e.reteNetwork = nil
 
 	return nil
 }
@@ -275,14 +444,52 @@ func (e *KnowledgeBase) RemoveRuleEntry(name string) {
 		e.RuleEntries[name].Deleted = true
 		delete(e.RuleEntries, name)
 		e.RuleEntries[ruleEntry.RuleName] = ruleEntry
+		e.reteNetwork = nil
 	}
 }
 
+// GetReteNetwork returns the RETE network compiled from the rule entries of this knowledge base.
+// The network is kept between executions, until a rule entry is added or removed.
+func (e *KnowledgeBase) GetReteNetwork() *ReteNetwork {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.reteNetwork == nil {
+		e.reteNetwork = NewReteNetwork(e)
+	}
+
+	return e.reteNetwork
+}
+
 // InitializeContext will initialize this AST graph with data context and working memory before running rule on them.
 func (e *KnowledgeBase) InitializeContext(dataCtx IDataContext) {
 	e.DataContext = dataCtx
 }
 
+// ContainsPackage will check if a rule entry, a function or a const of the package is in this knowledge base.
+func (e *KnowledgeBase) ContainsPackage(name string) bool {
+	prefix := name + "."
+	for _, entry := range e.RuleEntries {
+		if entry.Package == name {
+
+			return true
+		}
+	}
+	for functionName := range e.WorkingMemory.functions {
+		if strings.HasPrefix(functionName, prefix) {
+
+			return true
+		}
+	}
+	for declarationName := range e.Declarations {
+		if strings.HasPrefix(declarationName, prefix) {
+
+			return true
+		}
+	}
+
+	return false
+}
+
 // RetractRule will retract the selected rule for execution on the next cycle.
 func (e *KnowledgeBase) RetractRule(ruleName string) {
 	for _, re := range e.RuleEntries {
@@ -312,3 +519,68 @@ func (e *KnowledgeBase) Reset() {
 		}
 	}
 }
+
+// GetActiveRuleEntries returns the rule entries that are enabled, not deleted and within their effective dates
+// at the given instant, in declaration order.
+func (e *KnowledgeBase) GetActiveRuleEntries(instant time.Time) []*RuleEntry {
+	active := make([]*RuleEntry, 0, len(e.RuleEntries))
+	for _, re := range e.RuleEntries {
+		if !re.Deleted && re.IsActiveAt(instant) {
+			active = append(active, re)
+		}
+	}
+	sort.Slice(active, func(i, j int) bool {
+
+		return active[i].Sequence < active[j].Sequence
+	})
+
+	return active
+}
+
+// SetFocus will push the agenda group on top of the focus stack, so the engine only executes its rule entries
+// until none of them can be executed anymore. Then the focus goes back to the previously focused agenda group.
+func (e *KnowledgeBase) SetFocus(agendaGroup string) {
+	if e.GetFocus() != agendaGroup {
+		e.focus = append(e.focus, agendaGroup)
+	}
+}
+
+// GetFocus returns the agenda group on top of the focus stack.
+func (e *KnowledgeBase) GetFocus() string {
+	if len(e.focus) == 0 {
+
+		return MainAgendaGroup
+	}
+
+	return e.focus[len(e.focus)-1]
+}
+
+// PopFocus will remove the agenda group on top of the focus stack.
+// It returns false if the focus is already on MainAgendaGroup.
+func (e *KnowledgeBase) PopFocus() bool {
+	if len(e.focus) == 0 {
+
+		return false
+	}
+	e.focus = e.focus[:len(e.focus)-1]
+
+	return true
+}
+
+// ClearFocus will remove all agenda groups from the focus stack, leaving only MainAgendaGroup.
+func (e *KnowledgeBase) ClearFocus() {
+	e.focus = nil
+}
+
+// RetractActivationGroup will retract all rules of the activation group, except the one with the given name.
+func (e *KnowledgeBase) RetractActivationGroup(activationGroup, except string) {
+	if len(activationGroup) == 0 {
+
+		return
+	}
+	for _, re := range e.RuleEntries {
+		if re.ActivationGroup == activationGroup && re.RuleName != except {
+			re.Retracted = true
+		}
+	}
+}
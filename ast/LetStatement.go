@@ -0,0 +1,169 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast/unique"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+)
+
+// NewLetStatement creates new instance of LetStatement
+func NewLetStatement() *LetStatement {
+
+	return &LetStatement{
+		AstID: unique.NewID(),
+	}
+}
+
+// LetStatement AST graph node. It declares a local variable of a rule entry.
+// In the then scope, the expression is evaluated and its value stored when the statement is executed.
+// In the when scope, the expression is bound to the local variable and evaluated whenever the variable is.
+type LetStatement struct {
+	AstID   string
+	GrlText string
+	Line    int // line of the statement within its GRL resource, zero if unknown
+
+	Name       string
+	Variable   *Variable
+	Expression *Expression
+}
+
+// LetStatementReceiver must be implemented by any AST object that will store a LetStatement
+type LetStatementReceiver interface {
+	AcceptLetStatement(letStatement *LetStatement) error
+}
+
+// LocalVariableKey returns the key of the local variable declared with the name in the rule entry.
+func LocalVariableKey(ruleName, name string) string {
+
+	return fmt.Sprintf("%s.%s", ruleName, name)
+}
+
+// MakeCatalog create a catalog entry for this AST Node
+func (e *LetStatement) MakeCatalog(cat *Catalog) {
+	meta := &LetStatementMeta{
+		NodeMeta: NodeMeta{
+			AstID:    e.AstID,
+			GrlText:  e.GrlText,
+			Snapshot: e.GetSnapshot(),
+		},
+	}
+	if cat.AddMeta(e.AstID, meta) {
+		meta.Name = e.Name
+		meta.Line = e.Line
+		if e.Variable != nil {
+			meta.VariableID = e.Variable.AstID
+			e.Variable.MakeCatalog(cat)
+		}
+		if e.Expression != nil {
+			meta.ExpressionID = e.Expression.AstID
+			e.Expression.MakeCatalog(cat)
+		}
+	}
+}
+
+// Clone will clone this LetStatement. The new clone will have an identical structure
+func (e *LetStatement) Clone(cloneTable *pkg.CloneTable) *LetStatement {
+	clone := &LetStatement{
+		AstID:   unique.NewID(),
+		GrlText: e.GrlText,
+		Line:    e.Line,
+		Name:    e.Name,
+	}
+
+	if e.Variable != nil {
+		if cloneTable.IsCloned(e.Variable.AstID) {
+			clone.Variable = cloneTable.Records[e.Variable.AstID].CloneInstance.(*Variable)
+		} else {
+			cloned := e.Variable.Clone(cloneTable)
+			clone.Variable = cloned
+			cloneTable.MarkCloned(e.Variable.AstID, cloned.AstID, e.Variable, cloned)
+		}
+	}
+
+	if e.Expression != nil {
+		if cloneTable.IsCloned(e.Expression.AstID) {
+			clone.Expression = cloneTable.Records[e.Expression.AstID].CloneInstance.(*Expression)
+		} else {
+			cloned := e.Expression.Clone(cloneTable)
+			clone.Expression = cloned
+			cloneTable.MarkCloned(e.Expression.AstID, cloned.AstID, e.Expression, cloned)
+		}
+	}
+
+	return clone
+}
+
+// AcceptExpression will accept the Expression whose value is given to the local variable
+func (e *LetStatement) AcceptExpression(exp *Expression) error {
+	if e.Expression != nil {
+
+		return errors.New("expression for let statement already assigned")
+	}
+	e.Expression = exp
+
+	return nil
+}
+
+// GetAstID get the UUID asigned for this AST graph node
+func (e *LetStatement) GetAstID() string {
+
+	return e.AstID
+}
+
+// GetGrlText get the expression syntax related to this graph when it wast constructed
+func (e *LetStatement) GetGrlText() string {
+
+	return e.GrlText
+}
+
+// GetSnapshot will create a structure signature or AST graph
+func (e *LetStatement) GetSnapshot() string {
+	var buff strings.Builder
+	buff.WriteString(LETSTATEMENT)
+	buff.WriteString("(")
+	if e.Variable != nil {
+		buff.WriteString(e.Variable.GetSnapshot())
+	}
+	buff.WriteString("=")
+	if e.Expression != nil {
+		buff.WriteString(e.Expression.GetSnapshot())
+	}
+	buff.WriteString(")")
+
+	return buff.String()
+}
+
+// SetGrlText set the expression syntax related to this graph when it was constructed. Only ANTLR4 listener should
+// call this function.
+func (e *LetStatement) SetGrlText(grlText string) {
+	e.GrlText = grlText
+}
+
+// Execute will evaluate the expression and store its value into the local variable
+func (e *LetStatement) Execute(dataContext IDataContext, memory *WorkingMemory) error {
+	val, err := e.Expression.Evaluate(dataContext, memory)
+	if err != nil {
+		AstLog.Errorf("error while evaluating let %s. got %s", e.Name, err.Error())
+
+		return err
+	}
+
+	return e.Variable.Assign(val, dataContext, memory)
+}
@@ -14,6 +14,14 @@
 
 package ast
 
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+)
+
 // IntegerLiteral will hold IntegerLiteral constant AST data
 type IntegerLiteral struct {
 	Integer int64
@@ -34,6 +42,62 @@ type BooleanLiteral struct {
 	Boolean bool
 }
 
+// DurationLiteral will hold DurationLiteral constant AST data, a time.Duration or a pkg.Period
+type DurationLiteral struct {
+	Duration reflect.Value
+}
+
+// Negate will turn the duration into its negative
+func (e *DurationLiteral) Negate() {
+	switch duration := e.Duration.Interface().(type) {
+	case time.Duration:
+		e.Duration = reflect.ValueOf(-duration)
+	case pkg.Period:
+		e.Duration = reflect.ValueOf(duration.Negate())
+	}
+}
+
+// DateLiteral will hold DateLiteral constant AST data
+type DateLiteral struct {
+	Date time.Time
+}
+
+// ListLiteral will hold the element constants of a list literal such as [1, 2, 3]
+type ListLiteral struct {
+	Values []reflect.Value
+}
+
+// AcceptConstant will accept the next element of the list
+func (e *ListLiteral) AcceptConstant(con *Constant) error {
+	val, _ := con.Evaluate(nil, nil)
+	e.Values = append(e.Values, val)
+
+	return nil
+}
+
+// MapLiteral will hold the key and value constants of a map literal such as {"a": 1}
+type MapLiteral struct {
+	Keys   []reflect.Value
+	Values []reflect.Value
+}
+
+// AcceptConstant will accept the next key, then its value
+func (e *MapLiteral) AcceptConstant(con *Constant) error {
+	val, _ := con.Evaluate(nil, nil)
+	if len(e.Keys) == len(e.Values) {
+		if !val.IsValid() || !val.Type().Comparable() {
+
+			return fmt.Errorf("%s can not be used as map key", con.GrlText)
+		}
+		e.Keys = append(e.Keys, val)
+
+		return nil
+	}
+	e.Values = append(e.Values, val)
+
+	return nil
+}
+
 // IntegerLiteralReceiver should be implemented by AST graph node to receive a IntegerLiteral AST graph node
 type IntegerLiteralReceiver interface {
 	AcceptIntegerLiteral(fun *IntegerLiteral)
@@ -53,3 +117,85 @@ type FloatLiteralReceiver interface {
 type BooleanLiteralReceiver interface {
 	AcceptBooleanLiteral(fun *BooleanLiteral)
 }
+
+// DurationLiteralReceiver should be implemented by AST graph node to receive a DurationLiteral AST graph node
+type DurationLiteralReceiver interface {
+	AcceptDurationLiteral(fun *DurationLiteral)
+}
+
+// DateLiteralReceiver should be implemented by AST graph node to receive a DateLiteral AST graph node
+type DateLiteralReceiver interface {
+	AcceptDateLiteral(fun *DateLiteral)
+}
+
+// ListLiteralReceiver should be implemented by AST graph node to receive a ListLiteral AST graph node
+type ListLiteralReceiver interface {
+	AcceptListLiteral(fun *ListLiteral)
+}
+
+// MapLiteralReceiver should be implemented by AST graph node to receive a MapLiteral AST graph node
+type MapLiteralReceiver interface {
+	AcceptMapLiteral(fun *MapLiteral)
+}
+
+// MakeListValue creates the slice of a list literal. Its element type is the common type of the values,
+// float64 when integers and floats are mixed, or interface{} otherwise.
+func MakeListValue(values []reflect.Value) reflect.Value {
+	typ := literalType(values)
+	list := reflect.MakeSlice(reflect.SliceOf(typ), len(values), len(values))
+	for i, val := range values {
+		if val.IsValid() {
+			list.Index(i).Set(val.Convert(typ))
+		}
+	}
+
+	return list
+}
+
+// MakeMapValue creates the map of a map literal, typed the same way as MakeListValue.
+func MakeMapValue(keys, values []reflect.Value) reflect.Value {
+	keyType := literalType(keys)
+	valueType := literalType(values)
+	mapValue := reflect.MakeMapWithSize(reflect.MapOf(keyType, valueType), len(keys))
+	for i, key := range keys {
+		val := reflect.Zero(valueType)
+		if values[i].IsValid() {
+			val = values[i].Convert(valueType)
+		}
+		mapValue.SetMapIndex(key.Convert(keyType), val)
+	}
+
+	return mapValue
+}
+
+// literalType returns the type able to hold all the values of a list or map literal.
+func literalType(values []reflect.Value) reflect.Type {
+	var typ reflect.Type
+	for _, val := range values {
+		switch {
+		case !val.IsValid():
+
+			return interfaceType
+		case typ == nil || typ == val.Type():
+			typ = val.Type()
+		case isNumberType(typ) && isNumberType(val.Type()):
+			typ = reflect.TypeOf(float64(0))
+		default:
+
+			return interfaceType
+		}
+	}
+	if typ == nil {
+
+		return interfaceType
+	}
+
+	return typ
+}
+
+var interfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+
+func isNumberType(typ reflect.Type) bool {
+
+	return typ.Kind() == reflect.Int64 || typ.Kind() == reflect.Float64
+}
@@ -0,0 +1,357 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast/unique"
+	"github.com/DataWiseHQ/grule-rule-engine/model"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+)
+
+const (
+	// QuantifierExists is satisfied when the predicate holds for at least one element
+	QuantifierExists = "exists"
+	// QuantifierAny is an alias of QuantifierExists
+	QuantifierAny = "any"
+	// QuantifierForAll is satisfied when the predicate holds for every element, or when there is no element
+	QuantifierForAll = "forall"
+	// QuantifierCount counts the elements the predicate holds for
+	QuantifierCount = "count"
+)
+
+// IsQuantifier returns true if the name is one of the quantifier kinds, regardless of its case.
+func IsQuantifier(name string) bool {
+	switch strings.ToLower(name) {
+	case QuantifierExists, QuantifierAny, QuantifierForAll, QuantifierCount:
+
+		return true
+	}
+
+	return false
+}
+
+// NewQuantifier creates new instance of Quantifier
+func NewQuantifier() *Quantifier {
+
+	return &Quantifier{
+		AstID: unique.NewID(),
+	}
+}
+
+// Quantifier AST graph node. It evaluates the Predicate for the elements of an array, slice or map Collection,
+// each element being bound in turn to the local Variable.
+type Quantifier struct {
+	AstID   string
+	GrlText string
+
+	Kind       string
+	Variable   *Variable
+	Collection *ExpressionAtom
+	Predicate  *Expression
+}
+
+// QuantifierReceiver must be implemented by any AST object that will store a Quantifier
+type QuantifierReceiver interface {
+	AcceptQuantifier(quantifier *Quantifier) error
+}
+
+// MakeCatalog create a catalog entry for this AST Node
+func (e *Quantifier) MakeCatalog(cat *Catalog) {
+	meta := &QuantifierMeta{
+		NodeMeta: NodeMeta{
+			AstID:    e.AstID,
+			GrlText:  e.GrlText,
+			Snapshot: e.GetSnapshot(),
+		},
+	}
+	if cat.AddMeta(e.AstID, meta) {
+		meta.Kind = e.Kind
+		if e.Variable != nil {
+			meta.VariableID = e.Variable.AstID
+			e.Variable.MakeCatalog(cat)
+		}
+		if e.Collection != nil {
+			meta.CollectionID = e.Collection.AstID
+			e.Collection.MakeCatalog(cat)
+		}
+		if e.Predicate != nil {
+			meta.PredicateID = e.Predicate.AstID
+			e.Predicate.MakeCatalog(cat)
+		}
+	}
+}
+
+// Clone will clone this Quantifier. The new clone will have an identical structure
+func (e *Quantifier) Clone(cloneTable *pkg.CloneTable) *Quantifier {
+	clone := &Quantifier{
+		AstID:   unique.NewID(),
+		GrlText: e.GrlText,
+		Kind:    e.Kind,
+	}
+
+	if e.Variable != nil {
+		if cloneTable.IsCloned(e.Variable.AstID) {
+			clone.Variable = cloneTable.Records[e.Variable.AstID].CloneInstance.(*Variable)
+		} else {
+			cloned := e.Variable.Clone(cloneTable)
+			clone.Variable = cloned
+			cloneTable.MarkCloned(e.Variable.AstID, cloned.AstID, e.Variable, cloned)
+		}
+	}
+
+	if e.Collection != nil {
+		if cloneTable.IsCloned(e.Collection.AstID) {
+			clone.Collection = cloneTable.Records[e.Collection.AstID].CloneInstance.(*ExpressionAtom)
+		} else {
+			cloned := e.Collection.Clone(cloneTable)
+			clone.Collection = cloned
+			cloneTable.MarkCloned(e.Collection.AstID, cloned.AstID, e.Collection, cloned)
+		}
+	}
+
+	if e.Predicate != nil {
+		if cloneTable.IsCloned(e.Predicate.AstID) {
+			clone.Predicate = cloneTable.Records[e.Predicate.AstID].CloneInstance.(*Expression)
+		} else {
+			cloned := e.Predicate.Clone(cloneTable)
+			clone.Predicate = cloned
+			cloneTable.MarkCloned(e.Predicate.AstID, cloned.AstID, e.Predicate, cloned)
+		}
+	}
+
+	return clone
+}
+
+// AcceptExpressionAtom will accept the collection of this quantifier
+func (e *Quantifier) AcceptExpressionAtom(atm *ExpressionAtom) error {
+	if e.Collection != nil {
+
+		return errors.New("collection for quantifier already assigned")
+	}
+	e.Collection = atm
+
+	return nil
+}
+
+// AcceptExpression will accept the predicate of this quantifier
+func (e *Quantifier) AcceptExpression(exp *Expression) error {
+	if e.Predicate != nil {
+
+		return errors.New("predicate for quantifier already assigned")
+	}
+	e.Predicate = exp
+
+	return nil
+}
+
+// GetAstID get the UUID asigned for this AST graph node
+func (e *Quantifier) GetAstID() string {
+
+	return e.AstID
+}
+
+// GetGrlText get the expression syntax related to this graph when it wast constructed
+func (e *Quantifier) GetGrlText() string {
+
+	return e.GrlText
+}
+
+// GetSnapshot will create a structure signature or AST graph
+func (e *Quantifier) GetSnapshot() string {
+	var buff strings.Builder
+	buff.WriteString(QUANTIFIER)
+	buff.WriteString("(")
+	buff.WriteString(e.Kind)
+	buff.WriteString(":")
+	if e.Variable != nil {
+		buff.WriteString(e.Variable.GetSnapshot())
+	}
+	buff.WriteString(" in ")
+	if e.Collection != nil {
+		buff.WriteString(e.Collection.GetSnapshot())
+	}
+	buff.WriteString(":")
+	if e.Predicate != nil {
+		buff.WriteString(e.Predicate.GetSnapshot())
+	}
+	buff.WriteString(")")
+
+	return buff.String()
+}
+
+// SetGrlText set the expression syntax related to this graph when it was constructed. Only ANTLR4 listener should
+// call this function.
+func (e *Quantifier) SetGrlText(grlText string) {
+	e.GrlText = grlText
+}
+
+// Evaluate will evaluate the predicate for the elements of the collection. It returns a bool,
+// or an int64 for the count quantifier.
+func (e *Quantifier) Evaluate(dataContext IDataContext, memory *WorkingMemory) (reflect.Value, error) {
+	_, err := e.Collection.Evaluate(dataContext, memory)
+	if err != nil {
+
+		return reflect.Value{}, err
+	}
+	elements, err := quantifiedElements(e.Collection.ValueNode)
+	if err != nil {
+
+		return reflect.Value{}, err
+	}
+	count := int64(0)
+	for _, element := range elements {
+		holds, err := e.holds(element, dataContext, memory)
+		if err != nil {
+
+			return reflect.Value{}, err
+		}
+		switch {
+		case holds && (e.Kind == QuantifierExists || e.Kind == QuantifierAny):
+
+			return reflect.ValueOf(true), nil
+		case !holds && e.Kind == QuantifierForAll:
+
+			return reflect.ValueOf(false), nil
+		case holds:
+			count++
+		}
+	}
+	switch e.Kind {
+	case QuantifierCount:
+
+		return reflect.ValueOf(count), nil
+	case QuantifierForAll:
+
+		return reflect.ValueOf(true), nil
+	}
+
+	return reflect.ValueOf(false), nil
+}
+
+// holds binds the element to the variable and evaluates the predicate for it.
+func (e *Quantifier) holds(element model.ValueNode, dataContext IDataContext, memory *WorkingMemory) (bool, error) {
+	memory.setLocal(e.Variable.Local, element)
+	// the memoized values of the predicate belong to the previous element.
+	unsetExpression(e.Predicate)
+	val, err := e.Predicate.Evaluate(dataContext, memory)
+	if err != nil {
+
+		return false, err
+	}
+	if val.Kind() != reflect.Bool {
+
+		return false, fmt.Errorf("predicate of %s must evaluate to a boolean, got %s", e.GrlText, val.Kind().String())
+	}
+
+	return val.Bool(), nil
+}
+
+// quantifiedElements returns the nodes of the elements of an array, slice or map node. The elements of a map are its
+// values, its keys are not bound.
+func quantifiedElements(collection model.ValueNode) ([]model.ValueNode, error) {
+	if collection == nil {
+
+		return nil, errors.New("quantified collection is not evaluated")
+	}
+	if collection.IsArray() {
+		length, err := collection.Length()
+		if err != nil {
+
+			return nil, err
+		}
+		elements := make([]model.ValueNode, length)
+		for i := range elements {
+			elements[i], err = collection.GetChildNodeByIndex(i)
+			if err != nil {
+
+				return nil, err
+			}
+		}
+
+		return elements, nil
+	}
+	if collection.IsMap() {
+		keys := collection.Value().MapKeys()
+		elements := make([]model.ValueNode, len(keys))
+		for i, key := range keys {
+			element, err := collection.GetChildNodeBySelector(key)
+			if err != nil {
+
+				return nil, err
+			}
+			elements[i] = element
+		}
+
+		return elements, nil
+	}
+
+	return nil, fmt.Errorf("%s is not an array nor map", collection.IdentifiedAs())
+}
+
+// unsetExpression clears the evaluated status of the expression and its sub graphs.
+func unsetExpression(expr *Expression) {
+	if expr == nil {
+
+		return
+	}
+	expr.Evaluated = false
+	unsetExpression(expr.LeftExpression)
+	unsetExpression(expr.RightExpression)
+	unsetExpression(expr.SingleExpression)
+	unsetExpression(expr.UpperExpression)
+	unsetExpression(expr.ElseExpression)
+	unsetExpressionAtom(expr.ExpressionAtom)
+}
+
+func unsetExpressionAtom(atom *ExpressionAtom) {
+	if atom == nil {
+
+		return
+	}
+	atom.Evaluated = false
+	unsetExpressionAtom(atom.ExpressionAtom)
+	unsetVariable(atom.Variable)
+	if atom.ArrayMapSelector != nil {
+		unsetExpression(atom.ArrayMapSelector.Expression)
+	}
+	if atom.FunctionCall != nil && atom.FunctionCall.ArgumentList != nil {
+		for _, arg := range atom.FunctionCall.ArgumentList.Arguments {
+			unsetExpression(arg)
+		}
+	}
+	if atom.Quantifier != nil {
+		unsetExpressionAtom(atom.Quantifier.Collection)
+	}
+	if atom.Aggregate != nil {
+		unsetExpressionAtom(atom.Aggregate.Collection)
+	}
+}
+
+func unsetVariable(variable *Variable) {
+	if variable == nil {
+
+		return
+	}
+	unsetVariable(variable.Variable)
+	unsetExpression(variable.Binding)
+	if variable.ArrayMapSelector != nil {
+		unsetExpression(variable.ArrayMapSelector.Expression)
+	}
+}
@@ -0,0 +1,557 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"container/heap"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// reteNode is implemented by every node in the ReteNetwork.
+type reteNode interface {
+	// index is the creation order of the node. Inputs are always created before their successors,
+	// so processing nodes by index is a topological order of the network.
+	index() int
+	// update recomputes the node state. It returns true if the node state changed.
+	update(network *ReteNetwork) bool
+	// state returns whether the node is satisfied, and the error raised while evaluating it.
+	state() (bool, error)
+	// addSuccessor links a node that takes this node as its input.
+	addSuccessor(node reteNode)
+	successorNodes() []reteNode
+	// forget clears the node state, its next state is always a change.
+	forget()
+}
+
+type reteNodeBase struct {
+	id         int
+	known      bool
+	satisfied  bool
+	err        error
+	successors []reteNode
+}
+
+func (n *reteNodeBase) index() int {
+
+	return n.id
+}
+
+func (n *reteNodeBase) state() (bool, error) {
+
+	return n.satisfied, n.err
+}
+
+func (n *reteNodeBase) forget() {
+	n.known = false
+	n.satisfied = false
+	n.err = nil
+}
+
+func (n *reteNodeBase) addSuccessor(node reteNode) {
+	n.successors = append(n.successors, node)
+}
+
+func (n *reteNodeBase) successorNodes() []reteNode {
+
+	return n.successors
+}
+
+// setState sets the node state and tells whether it is different from the previous one.
+// The first state of a node is always a change.
+func (n *reteNodeBase) setState(satisfied bool, err error) bool {
+	changed := !n.known || n.satisfied != satisfied || (n.err == nil) != (err == nil)
+	n.known = true
+	n.satisfied = satisfied
+	n.err = err
+
+	return changed
+}
+
+// AlphaNode tests a condition involving at most one fact.
+type AlphaNode struct {
+	reteNodeBase
+	Expression *Expression
+	// Fact is the key of the fact tested by this node, empty if the condition does not refer to any fact.
+	Fact string
+}
+
+func (n *AlphaNode) update(network *ReteNetwork) bool {
+	if len(n.Fact) > 0 && network.dataContext.IsRetracted(n.Fact) {
+
+		return n.setState(false, nil)
+	}
+
+	return n.setState(network.evaluateCondition(n.Expression))
+}
+
+// BetaNode combines facts. It either joins its Left and Right inputs, being satisfied when both are,
+// or tests a condition involving several Facts.
+type BetaNode struct {
+	reteNodeBase
+	Expression *Expression
+	Left       reteNode
+	Right      reteNode
+	Facts      []string
+}
+
+func (n *BetaNode) update(network *ReteNetwork) bool {
+	if n.Left == nil {
+		for _, fact := range n.Facts {
+			if network.dataContext.IsRetracted(fact) {
+
+				return n.setState(false, nil)
+			}
+		}
+
+		return n.setState(network.evaluateCondition(n.Expression))
+	}
+	// mirrors the short circuit of the && operator, the right input error only matters if the left input is satisfied.
+	left, err := n.Left.state()
+	if err != nil || !left {
+
+		return n.setState(false, err)
+	}
+
+	return n.setState(n.Right.state())
+}
+
+// TerminalNode is the end of the network for a rule entry. It is satisfied when the rule entry can be activated.
+type TerminalNode struct {
+	reteNodeBase
+	RuleEntry *RuleEntry
+	Input     reteNode
+}
+
+func (n *TerminalNode) update(network *ReteNetwork) bool {
+
+	return n.setState(n.Input.state())
+}
+
+// Satisfied returns true if the rule entry's when scope is satisfied by the facts.
+func (n *TerminalNode) Satisfied() bool {
+
+	return n.satisfied && n.err == nil
+}
+
+// Err returns the error raised while evaluating the rule entry's when scope, if any.
+func (n *TerminalNode) Err() error {
+
+	return n.err
+}
+
+// NewReteNetwork will compile the when scope of all rule entries of the knowledge base into a RETE network.
+// Conditions are shared between rule entries the same way their Expression are shared in the WorkingMemory.
+func NewReteNetwork(knowledge *KnowledgeBase) *ReteNetwork {
+	network := &ReteNetwork{
+		memory:     knowledge.WorkingMemory,
+		nodes:      make(map[*Expression]reteNode),
+		conditions: make(map[*Expression]reteNode),
+		factNodes:  make(map[string][]reteNode),
+		touched:    make(map[reteNode]bool),
+	}
+	for _, ruleEntry := range knowledge.RuleEntries {
+		if ruleEntry.Deleted || ruleEntry.WhenScope == nil || ruleEntry.WhenScope.Expression == nil {
+			continue
+		}
+		input := network.build(ruleEntry.WhenScope.Expression)
+		terminal := &TerminalNode{
+			reteNodeBase: reteNodeBase{id: network.nextID()},
+			RuleEntry:    ruleEntry,
+			Input:        input,
+		}
+		input.addSuccessor(terminal)
+		network.terminals = append(network.terminals, terminal)
+	}
+	AstLog.Debugf("RETE network of '%s' has %d alpha nodes, %d beta nodes and %d terminal nodes", knowledge.Name, network.AlphaNodeCount(), network.BetaNodeCount(), len(network.terminals))
+
+	return network
+}
+
+// ReteNetwork is the RETE network of a KnowledgeBase.
+// Condition nodes are evaluated when the facts they refer to are added, modified or retracted, and the changes
+// flow through the network down to the terminal nodes, which tell which rule entries can be activated.
+type ReteNetwork struct {
+	memory      *WorkingMemory
+	dataContext IDataContext
+	// observed tells whether the fact changes of the data context are notified to the network.
+	observed bool
+	ids      int
+
+	// nodes maps the expressions of the when scopes to their node.
+	nodes map[*Expression]reteNode
+	// conditions maps the expressions evaluated by the alpha nodes and the beta nodes testing conditions.
+	conditions map[*Expression]reteNode
+	// factNodes maps a fact key to the condition nodes referring it.
+	factNodes map[string][]reteNode
+	terminals []*TerminalNode
+
+	touched map[reteNode]bool
+}
+
+func (network *ReteNetwork) nextID() int {
+	network.ids++
+
+	return network.ids
+}
+
+// build returns the node of the expression, creating it if it does not exist yet.
+func (network *ReteNetwork) build(expr *Expression) reteNode {
+	if node, ok := network.nodes[expr]; ok {
+
+		return node
+	}
+	var node reteNode
+	switch {
+	case expr.SingleExpression != nil && !expr.Negated && !expr.Negative:
+		node = network.build(expr.SingleExpression)
+	case expr.Operator == OpAnd && expr.LeftExpression != nil && expr.RightExpression != nil:
+		left := network.build(expr.LeftExpression)
+		right := network.build(expr.RightExpression)
+		beta := &BetaNode{
+			reteNodeBase: reteNodeBase{id: network.nextID()},
+			Expression:   expr,
+			Left:         left,
+			Right:        right,
+		}
+		left.addSuccessor(beta)
+		if right != left {
+			right.addSuccessor(beta)
+		}
+		node = beta
+	default:
+		facts := expressionFacts(expr, network.memory)
+		if len(facts) > 1 {
+			node = &BetaNode{
+				reteNodeBase: reteNodeBase{id: network.nextID()},
+				Expression:   expr,
+				Facts:        facts,
+			}
+		} else {
+			alpha := &AlphaNode{
+				reteNodeBase: reteNodeBase{id: network.nextID()},
+				Expression:   expr,
+			}
+			if len(facts) == 1 {
+				alpha.Fact = facts[0]
+			}
+			node = alpha
+		}
+		network.conditions[expr] = node
+		for _, fact := range facts {
+			network.factNodes[fact] = append(network.factNodes[fact], node)
+		}
+	}
+	network.nodes[expr] = node
+
+	return node
+}
+
+// expressionFacts returns the sorted keys of the facts referred by the expression, including the facts referred
+// by the body of the functions declared in GRL it calls.
+func expressionFacts(expr *Expression, memory *WorkingMemory) []string {
+	collector := &factCollector{
+		memory:    memory,
+		facts:     make(map[string]bool),
+		functions: make(map[*Function]bool),
+	}
+	collector.collectExpression(expr)
+	keys := make([]string, 0, len(collector.facts))
+	for key := range collector.facts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// factCollector collects the keys of the facts referred by expressions.
+type factCollector struct {
+	memory *WorkingMemory
+	facts  map[string]bool
+	// functions are the functions declared in GRL whose body is already collected.
+	functions map[*Function]bool
+}
+
+func (c *factCollector) collectExpression(expr *Expression) {
+	if expr == nil {
+
+		return
+	}
+	c.collectExpression(expr.LeftExpression)
+	c.collectExpression(expr.RightExpression)
+	c.collectExpression(expr.SingleExpression)
+	c.collectExpression(expr.UpperExpression)
+	c.collectExpression(expr.ElseExpression)
+	c.collectExpressionAtom(expr.ExpressionAtom)
+}
+
+func (c *factCollector) collectExpressionAtom(atom *ExpressionAtom) {
+	if atom == nil {
+
+		return
+	}
+	c.collectVariable(atom.Variable)
+	c.collectExpressionAtom(atom.ExpressionAtom)
+	if atom.ArrayMapSelector != nil {
+		c.collectExpression(atom.ArrayMapSelector.Expression)
+	}
+	if atom.FunctionCall != nil {
+		if atom.FunctionCall.ArgumentList != nil {
+			for _, arg := range atom.FunctionCall.ArgumentList.Arguments {
+				c.collectExpression(arg)
+			}
+		}
+		c.collectFunction(atom.FunctionCall.GetFunction(c.memory))
+	}
+	if atom.Quantifier != nil {
+		c.collectExpressionAtom(atom.Quantifier.Collection)
+		c.collectExpression(atom.Quantifier.Predicate)
+	}
+	if atom.Aggregate != nil {
+		c.collectExpressionAtom(atom.Aggregate.Collection)
+		c.collectExpression(atom.Aggregate.Value)
+		c.collectExpression(atom.Aggregate.Filter)
+	}
+}
+
+// collectFunction collects the facts referred by the body of a function declared in GRL, once.
+func (c *factCollector) collectFunction(function *Function) {
+	if function == nil || c.functions[function] {
+
+		return
+	}
+	c.functions[function] = true
+	for _, letStatement := range function.LetStatements {
+		c.collectExpression(letStatement.Expression)
+	}
+	c.collectExpression(function.Expression)
+}
+
+func (c *factCollector) collectVariable(variable *Variable) {
+	if variable == nil {
+
+		return
+	}
+	if variable.Binding != nil {
+		c.collectExpression(variable.Binding)
+
+		return
+	}
+	if variable.Variable == nil {
+		if len(variable.Name) > 0 && len(variable.Local) == 0 {
+			c.facts[variable.Name] = true
+		}
+
+		return
+	}
+	c.collectVariable(variable.Variable)
+	if variable.ArrayMapSelector != nil {
+		c.collectExpression(variable.ArrayMapSelector.Expression)
+	}
+}
+
+// AlphaNodeCount returns the number of alpha nodes in the network.
+func (network *ReteNetwork) AlphaNodeCount() int {
+	count := 0
+	for _, node := range network.conditions {
+		if _, ok := node.(*AlphaNode); ok {
+			count++
+		}
+	}
+
+	return count
+}
+
+// BetaNodeCount returns the number of beta nodes in the network.
+func (network *ReteNetwork) BetaNodeCount() int {
+	count := 0
+	for expr, node := range network.nodes {
+		if beta, ok := node.(*BetaNode); ok && beta.Expression == expr {
+			count++
+		}
+	}
+
+	return count
+}
+
+// TerminalNodes returns the terminal nodes of the network, one for every rule entry that is not deleted.
+func (network *ReteNetwork) TerminalNodes() []*TerminalNode {
+
+	return network.terminals
+}
+
+// Attach will start listening to the fact changes of the data context, and schedule all condition nodes
+// to be evaluated by the next Propagate. The states left by a previous data context are forgotten.
+func (network *ReteNetwork) Attach(dataContext IDataContext) {
+	network.dataContext = dataContext
+	network.observed = false
+	if observable, ok := dataContext.(ObservableDataContext); ok {
+		observable.AddListener(network)
+		network.observed = true
+	}
+	for _, node := range network.nodes {
+		node.forget()
+	}
+	for _, terminal := range network.terminals {
+		terminal.forget()
+	}
+	network.touchConditions()
+	// every condition is evaluated anyway, forget what was reset before.
+	network.memory.DrainResetExpressions()
+}
+
+// Detach will stop listening to the fact changes of the data context.
+func (network *ReteNetwork) Detach() {
+	if observable, ok := network.dataContext.(ObservableDataContext); ok && network.observed {
+		observable.RemoveListener(network)
+	}
+	network.dataContext = nil
+	network.observed = false
+	network.touched = make(map[reteNode]bool)
+}
+
+func (network *ReteNetwork) touchConditions() {
+	for _, node := range network.conditions {
+		network.touched[node] = true
+	}
+}
+
+// FactAdded implements DataContextListener
+func (network *ReteNetwork) FactAdded(key string) {
+	network.factChanged(key)
+}
+
+// FactModified implements DataContextListener
+func (network *ReteNetwork) FactModified(key string) {
+	network.factChanged(key)
+}
+
+// FactRetracted implements DataContextListener
+func (network *ReteNetwork) FactRetracted(key string) {
+	for _, node := range network.factNodes[key] {
+		network.touched[node] = true
+	}
+}
+
+func (network *ReteNetwork) factChanged(key string) {
+	// the memoized values of the previous fact are no longer valid.
+	network.memory.Reset(key)
+	for _, node := range network.factNodes[key] {
+		network.touched[node] = true
+	}
+}
+
+// Propagate will evaluate the condition nodes affected by the fact changes since the last call, and flow the changes
+// through the network. It returns the terminal nodes reached by the changes, in declaration order of their rule entries.
+// A node whose satisfaction did not change only passes the change on if it is satisfied, since the facts it matched were modified.
+func (network *ReteNetwork) Propagate() []*TerminalNode {
+	if !network.observed {
+		// the fact changes are not notified, any condition may have changed.
+		network.memory.ResetAll()
+		network.touchConditions()
+	}
+	for _, expr := range network.memory.DrainResetExpressions() {
+		if node, ok := network.conditions[expr]; ok {
+			network.touched[node] = true
+		}
+	}
+	queue := make(reteNodeQueue, 0, len(network.touched))
+	for node := range network.touched {
+		queue = append(queue, node)
+	}
+	heap.Init(&queue)
+	// the touched nodes are already scheduled.
+	scheduled := network.touched
+	network.touched = make(map[reteNode]bool)
+
+	reached := make([]*TerminalNode, 0)
+	for queue.Len() > 0 {
+		// always process the node with the lowest index, so every input is updated before its successors.
+		node := heap.Pop(&queue).(reteNode)
+		changed := node.update(network)
+		if terminal, ok := node.(*TerminalNode); ok {
+			reached = append(reached, terminal)
+
+			continue
+		}
+		if satisfied, _ := node.state(); !changed && !satisfied {
+			continue
+		}
+		for _, successor := range node.successorNodes() {
+			if !scheduled[successor] {
+				scheduled[successor] = true
+				heap.Push(&queue, successor)
+			}
+		}
+	}
+	sort.Slice(reached, func(i, j int) bool {
+
+		return reached[i].RuleEntry.Sequence < reached[j].RuleEntry.Sequence
+	})
+
+	return reached
+}
+
+// reteNodeQueue is a heap of nodes ordered by their index.
+type reteNodeQueue []reteNode
+
+func (q reteNodeQueue) Len() int {
+
+	return len(q)
+}
+
+func (q reteNodeQueue) Less(i, j int) bool {
+
+	return q[i].index() < q[j].index()
+}
+
+func (q reteNodeQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *reteNodeQueue) Push(x interface{}) {
+	*q = append(*q, x.(reteNode))
+}
+
+func (q *reteNodeQueue) Pop() interface{} {
+	old := *q
+	node := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return node
+}
+
+// evaluateCondition evaluates the expression of a condition node.
+func (network *ReteNetwork) evaluateCondition(expr *Expression) (satisfied bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			satisfied = false
+			err = fmt.Errorf("error while evaluating expression %s, panic recovered", expr.GrlText)
+		}
+	}()
+	val, err := expr.Evaluate(network.dataContext, network.memory)
+	if err != nil {
+
+		return false, err
+	}
+	if val.Kind() != reflect.Bool {
+
+		return false, fmt.Errorf("expression %s is not a boolean expression", expr.GrlText)
+	}
+
+	return val.Bool(), nil
+}
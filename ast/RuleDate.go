@@ -0,0 +1,72 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DateEffectiveAttribute is the date-effective rule attribute
+	DateEffectiveAttribute = "date-effective"
+	// DateExpiresAttribute is the date-expires rule attribute
+	DateExpiresAttribute = "date-expires"
+)
+
+// RuleDateLayouts are the layouts accepted by the date-effective and date-expires attributes.
+// A date without time zone is in the local time zone.
+var RuleDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// NewRuleDate create new RuleDate AST object for a date-effective or a date-expires attribute
+func NewRuleDate(name string) *RuleDate {
+
+	return &RuleDate{
+		Name: name,
+	}
+}
+
+// RuleDate is a simple AST object that stores the date-effective or the date-expires attribute of a rule entry
+type RuleDate struct {
+	Name string
+	Text string
+}
+
+// RuleDateReceiver must be implemented by any AST object that stores rule dates
+type RuleDateReceiver interface {
+	AcceptRuleDate(date *RuleDate) error
+}
+
+// AcceptStringLiteral accept the date text
+func (date *RuleDate) AcceptStringLiteral(lit *StringLiteral) {
+	date.Text = lit.String
+}
+
+// Time parses the date text using one of the RuleDateLayouts
+func (date *RuleDate) Time() (time.Time, error) {
+	for _, layout := range RuleDateLayouts {
+		if t, err := time.ParseInLocation(layout, date.Text, time.Local); err == nil {
+
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%s \"%s\" is not a valid date, the expected format is yyyy-mm-dd [hh:mm[:ss]] or RFC3339", date.Name, date.Text)
+}
@@ -19,7 +19,9 @@ import (
 	"fmt"
 	"github.com/DataWiseHQ/grule-rule-engine/ast/unique"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/DataWiseHQ/grule-rule-engine/pkg"
 )
@@ -40,14 +42,28 @@ type RuleEntry struct {
 	AstID   string
 	GrlText string
 
-	RuleName        string
+	RuleName        string // qualified with the package of the GRL, e.g. com.acme.pricing.Discount
+	Package         string // package declared by the GRL of this rule entry, empty if it has none
+	Extends         string // name of the parent rule entry, whose when scope is ANDed with the one of this rule entry
 	RuleDescription string
 	Salience        int
+	Sequence        int // declaration order of this rule entry within its KnowledgeBase
+	AgendaGroup     string
+	ActivationGroup string
+	NoLoop          bool                // the changes made by its own then scope do not activate this rule entry again
+	LockOnActive    bool                // once executed, this rule entry is not activated again until its agenda group loses the focus
+	DateEffective   time.Time           // this rule entry is inactive before this date, unless it is zero
+	DateExpires     time.Time           // this rule entry is inactive from this date, unless it is zero
+	Disabled        bool                // set by the enabled attribute, a disabled rule entry is always inactive
+	Metadata        map[string][]string // values of the metadata annotations, e.g. @tag("pricing"), by name
 	WhenScope       *WhenScope
 	ThenScope       *ThenScope
 
 	Retracted bool
 	Deleted   bool //If this is true, it will be ignored while execution and fetching the matching rules
+
+	// specifiedAttributes detects the attributes specified more than once while parsing.
+	specifiedAttributes map[string]bool
 }
 
 // MakeCatalog will create a catalog entry from RuleEntry node.
@@ -69,8 +85,19 @@ func (e *RuleEntry) MakeCatalog(cat *Catalog) {
 			e.ThenScope.MakeCatalog(cat)
 		}
 		meta.RuleName = e.RuleName
+		meta.Package = e.Package
+		meta.Extends = e.Extends
 		meta.RuleDescription = e.RuleDescription
 		meta.Salience = e.Salience
+		meta.Sequence = e.Sequence
+		meta.AgendaGroup = e.AgendaGroup
+		meta.ActivationGroup = e.ActivationGroup
+		meta.NoLoop = e.NoLoop
+		meta.LockOnActive = e.LockOnActive
+		meta.DateEffective = timeToMeta(e.DateEffective)
+		meta.DateExpires = timeToMeta(e.DateExpires)
+		meta.Disabled = e.Disabled
+		meta.Metadata = e.Metadata
 	}
 }
 
@@ -86,6 +113,160 @@ func (e *RuleEntry) AcceptSalience(salience *Salience) error {
 	return nil
 }
 
+// AcceptRuleGroup will accept the agenda group or the activation group
+func (e *RuleEntry) AcceptRuleGroup(group *RuleGroup) error {
+	if group.Activation {
+		if len(e.ActivationGroup) > 0 {
+
+			return fmt.Errorf("activation-group %s is already specified", e.ActivationGroup)
+		}
+		e.ActivationGroup = group.Name
+
+		return nil
+	}
+	if len(e.AgendaGroup) > 0 {
+
+		return fmt.Errorf("agenda-group %s is already specified", e.AgendaGroup)
+	}
+	e.AgendaGroup = group.Name
+
+	return nil
+}
+
+// specifyAttribute returns an error if the attribute is already specified
+func (e *RuleEntry) specifyAttribute(attribute string) error {
+	if e.specifiedAttributes == nil {
+		e.specifiedAttributes = make(map[string]bool)
+	}
+	if e.specifiedAttributes[attribute] {
+
+		return fmt.Errorf("%s is already specified", attribute)
+	}
+	e.specifiedAttributes[attribute] = true
+
+	return nil
+}
+
+// AcceptRuleFlag will accept the no-loop, lock-on-active or enabled attribute
+func (e *RuleEntry) AcceptRuleFlag(flag *RuleFlag) error {
+	err := e.specifyAttribute(flag.Name)
+	if err != nil {
+
+		return err
+	}
+	switch flag.Name {
+	case NoLoopFlag:
+		e.NoLoop = flag.Value
+	case LockOnActiveFlag:
+		e.LockOnActive = flag.Value
+	case EnabledFlag:
+		e.Disabled = !flag.Value
+	default:
+
+		return fmt.Errorf("unknown rule attribute %s", flag.Name)
+	}
+
+	return nil
+}
+
+// AcceptRuleDate will accept the date-effective or date-expires attribute
+func (e *RuleEntry) AcceptRuleDate(date *RuleDate) error {
+	err := e.specifyAttribute(date.Name)
+	if err != nil {
+
+		return err
+	}
+	t, err := date.Time()
+	if err != nil {
+
+		return err
+	}
+	switch date.Name {
+	case DateEffectiveAttribute:
+		e.DateEffective = t
+	case DateExpiresAttribute:
+		e.DateExpires = t
+	default:
+
+		return fmt.Errorf("unknown rule attribute %s", date.Name)
+	}
+
+	return nil
+}
+
+// AcceptRuleMetadata will accept a metadata annotation, the values of annotations with the same name are appended
+func (e *RuleEntry) AcceptRuleMetadata(metadata *RuleMetadata) error {
+	if e.Metadata == nil {
+		e.Metadata = make(map[string][]string)
+	}
+	values, ok := e.Metadata[metadata.Name]
+	if !ok {
+		values = make([]string, 0, len(metadata.Values))
+	}
+	e.Metadata[metadata.Name] = append(values, metadata.Values...)
+
+	return nil
+}
+
+// GetMetadata returns the values of the metadata annotations with the given name
+func (e *RuleEntry) GetMetadata(name string) []string {
+
+	return e.Metadata[name]
+}
+
+// HasMetadata returns true if this rule entry has a metadata annotation with the given name
+func (e *RuleEntry) HasMetadata(name string) bool {
+	_, ok := e.Metadata[name]
+
+	return ok
+}
+
+// GetTags returns the values of the @tag annotations
+func (e *RuleEntry) GetTags() []string {
+
+	return e.GetMetadata(TagMetadata)
+}
+
+// HasTag returns true if one of the @tag annotations has the given value
+func (e *RuleEntry) HasTag(tag string) bool {
+	for _, t := range e.GetTags() {
+		if t == tag {
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsActiveAt returns true if this rule entry is enabled and the instant is within its effective dates
+func (e *RuleEntry) IsActiveAt(instant time.Time) bool {
+	if e.Disabled {
+
+		return false
+	}
+	if !e.DateEffective.IsZero() && instant.Before(e.DateEffective) {
+
+		return false
+	}
+	if !e.DateExpires.IsZero() && !instant.Before(e.DateExpires) {
+
+		return false
+	}
+
+	return true
+}
+
+// GetAgendaGroup returns the agenda group of this rule entry, MainAgendaGroup if it does not specify one
+func (e *RuleEntry) GetAgendaGroup() string {
+	if len(e.AgendaGroup) == 0 {
+
+		return MainAgendaGroup
+	}
+
+	return e.AgendaGroup
+}
+
 // AcceptWhenScope will accept WhenScope AST Graph into this AST Graph
 func (e *RuleEntry) AcceptWhenScope(when *WhenScope) error {
 	e.WhenScope = when
@@ -106,8 +287,19 @@ func (e *RuleEntry) Clone(cloneTable *pkg.CloneTable) *RuleEntry {
 		AstID:           unique.NewID(),
 		GrlText:         e.GrlText,
 		RuleName:        e.RuleName,
+		Package:         e.Package,
+		Extends:         e.Extends,
 		RuleDescription: e.RuleDescription,
 		Salience:        e.Salience,
+		Sequence:        e.Sequence,
+		AgendaGroup:     e.AgendaGroup,
+		ActivationGroup: e.ActivationGroup,
+		NoLoop:          e.NoLoop,
+		LockOnActive:    e.LockOnActive,
+		DateEffective:   e.DateEffective,
+		DateExpires:     e.DateExpires,
+		Disabled:        e.Disabled,
+		Metadata:        cloneMetadata(e.Metadata),
 		Retracted:       false,
 		Deleted:         e.Deleted,
 	}
@@ -134,6 +326,20 @@ func (e *RuleEntry) Clone(cloneTable *pkg.CloneTable) *RuleEntry {
 	return clone
 }
 
+// cloneMetadata copies the metadata, so the clone does not share its value slices.
+func cloneMetadata(metadata map[string][]string) map[string][]string {
+	if metadata == nil {
+
+		return nil
+	}
+	clone := make(map[string][]string, len(metadata))
+	for name, values := range metadata {
+		clone[name] = append(make([]string, 0, len(values)), values...)
+	}
+
+	return clone
+}
+
 // GetAstID get the UUID asigned for this AST graph node
 func (e *RuleEntry) GetAstID() string {
 
@@ -151,7 +357,42 @@ func (e *RuleEntry) GetSnapshot() string {
 	var buff strings.Builder
 	buff.WriteString(RULEENTRY)
 	buff.WriteString("(")
-	buff.WriteString(fmt.Sprintf("N:%s DEC:\"%s\" SAL:%d W:%s T:%s}", e.RuleName, e.RuleDescription, e.Salience, e.WhenScope.GetSnapshot(), e.ThenScope.GetSnapshot()))
+	buff.WriteString(fmt.Sprintf("N:%s DEC:\"%s\" SAL:%d", e.RuleName, e.RuleDescription, e.Salience))
+	if len(e.Extends) > 0 {
+		buff.WriteString(fmt.Sprintf(" EXT:%s", e.Extends))
+	}
+	if len(e.AgendaGroup) > 0 {
+		buff.WriteString(fmt.Sprintf(" AG:\"%s\"", e.AgendaGroup))
+	}
+	if len(e.ActivationGroup) > 0 {
+		buff.WriteString(fmt.Sprintf(" ACG:\"%s\"", e.ActivationGroup))
+	}
+	if e.NoLoop {
+		buff.WriteString(" NL")
+	}
+	if e.LockOnActive {
+		buff.WriteString(" LOA")
+	}
+	if !e.DateEffective.IsZero() {
+		buff.WriteString(fmt.Sprintf(" DE:%s", e.DateEffective.UTC().Format(time.RFC3339Nano)))
+	}
+	if !e.DateExpires.IsZero() {
+		buff.WriteString(fmt.Sprintf(" DX:%s", e.DateExpires.UTC().Format(time.RFC3339Nano)))
+	}
+	if e.Disabled {
+		buff.WriteString(" DIS")
+	}
+	if len(e.Metadata) > 0 {
+		names := make([]string, 0, len(e.Metadata))
+		for name := range e.Metadata {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			buff.WriteString(fmt.Sprintf(" @%s%q", name, e.Metadata[name]))
+		}
+	}
+	buff.WriteString(fmt.Sprintf(" W:%s T:%s}", e.WhenScope.GetSnapshot(), e.ThenScope.GetSnapshot()))
 	buff.WriteString(")")
 
 	return buff.String()
@@ -0,0 +1,50 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+const (
+	// NoLoopFlag is the no-loop rule attribute
+	NoLoopFlag = "no-loop"
+	// LockOnActiveFlag is the lock-on-active rule attribute
+	LockOnActiveFlag = "lock-on-active"
+	// EnabledFlag is the enabled rule attribute
+	EnabledFlag = "enabled"
+)
+
+// NewRuleFlag create new RuleFlag AST object for a boolean rule attribute, such as NoLoopFlag
+func NewRuleFlag(name string) *RuleFlag {
+
+	return &RuleFlag{
+		Name:  name,
+		Value: true,
+	}
+}
+
+// RuleFlag is a simple AST object that stores a boolean attribute of a rule entry, such as no-loop.
+// The attribute is true when it is specified without a boolean literal.
+type RuleFlag struct {
+	Name  string
+	Value bool
+}
+
+// RuleFlagReceiver must be implemented by any AST object that stores rule flags
+type RuleFlagReceiver interface {
+	AcceptRuleFlag(flag *RuleFlag) error
+}
+
+// AcceptBooleanLiteral accept the flag value
+func (flag *RuleFlag) AcceptBooleanLiteral(lit *BooleanLiteral) {
+	flag.Value = lit.Boolean
+}
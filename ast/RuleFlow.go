@@ -0,0 +1,269 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast/unique"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+)
+
+// NewRuleFlow creates new instance of RuleFlow
+func NewRuleFlow(name string) *RuleFlow {
+
+	return &RuleFlow{
+		AstID: unique.NewID(),
+		Name:  name,
+	}
+}
+
+// RuleFlow is an ordered flow of stages. Each stage executes the rule entries of an agenda group until none of them
+// can be executed anymore, then the flow moves on to the next stage.
+type RuleFlow struct {
+	AstID   string
+	GrlText string
+
+	Name   string
+	Stages []*RuleFlowStage
+}
+
+// RuleFlowStage is a stage of a RuleFlow.
+type RuleFlowStage struct {
+	Name        string
+	AgendaGroup string
+	// Transitions are tested in order once the stage is done, the first satisfied one selects the next stage.
+	// If there is none, the flow goes on with the following stage in declaration order.
+	Transitions []*RuleFlowTransition
+}
+
+// RuleFlowTransition moves the flow to another stage, the flow ends if To is empty.
+type RuleFlowTransition struct {
+	When string
+	To   string
+	// Condition is the When condition, compiled in the working memory of the knowledge base of the flow. It is nil if
+	// there is none.
+	Condition *Expression
+}
+
+// GetAgendaGroup returns the agenda group executed by this stage, the stage name if it does not specify one
+func (stage *RuleFlowStage) GetAgendaGroup() string {
+	if len(stage.AgendaGroup) == 0 {
+
+		return stage.Name
+	}
+
+	return stage.AgendaGroup
+}
+
+// GetStage returns the stage with the given name, nil if there is none
+func (flow *RuleFlow) GetStage(name string) *RuleFlowStage {
+	for _, stage := range flow.Stages {
+		if stage.Name == name {
+
+			return stage
+		}
+	}
+
+	return nil
+}
+
+// Validate will check that the flow has stages and that all the transitions lead to one of them
+func (flow *RuleFlow) Validate() error {
+	if len(flow.Stages) == 0 {
+
+		return fmt.Errorf("rule flow %s has no stage", flow.Name)
+	}
+	names := make(map[string]bool)
+	for _, stage := range flow.Stages {
+		if len(stage.Name) == 0 {
+
+			return fmt.Errorf("rule flow %s has a stage without name", flow.Name)
+		}
+		if names[stage.Name] {
+
+			return fmt.Errorf("rule flow %s has more than one stage %s", flow.Name, stage.Name)
+		}
+		names[stage.Name] = true
+	}
+	for _, stage := range flow.Stages {
+		for _, transition := range stage.Transitions {
+			if len(transition.To) > 0 && !names[transition.To] {
+
+				return fmt.Errorf("rule flow %s stage %s has a transition to the unknown stage %s", flow.Name, stage.Name, transition.To)
+			}
+		}
+	}
+
+	return nil
+}
+
+// NextStage returns the stage following the given one, according to the transitions and the facts.
+// It returns nil when the flow ends.
+func (flow *RuleFlow) NextStage(ctx context.Context, stage *RuleFlowStage, dataCtx IDataContext, memory *WorkingMemory) (*RuleFlowStage, error) {
+	if len(stage.Transitions) == 0 {
+		for i, s := range flow.Stages {
+			if s == stage && i+1 < len(flow.Stages) {
+
+				return flow.Stages[i+1], nil
+			}
+		}
+
+		return nil, nil
+	}
+	for _, transition := range stage.Transitions {
+		if transition.Condition != nil {
+			if ctx.Err() != nil {
+
+				return nil, fmt.Errorf("context error on evaluating rule flow %s. got %w", flow.Name, ctx.Err())
+			}
+			// the facts changed since the last test, the conditions are always evaluated from scratch.
+			memory.ResetAll()
+			val, err := transition.Condition.Evaluate(dataCtx, memory)
+			if err != nil {
+
+				return nil, fmt.Errorf("rule flow %s stage %s transition condition \"%s\" raised an error. got %w", flow.Name, stage.Name, transition.When, err)
+			}
+			if val.Kind() != reflect.Bool {
+
+				return nil, fmt.Errorf("rule flow %s stage %s transition condition \"%s\" is not a boolean expression", flow.Name, stage.Name, transition.When)
+			}
+			if !val.Bool() {
+				continue
+			}
+		}
+		if len(transition.To) == 0 {
+
+			return nil, nil
+		}
+
+		return flow.GetStage(transition.To), nil
+	}
+
+	return nil, nil
+}
+
+// MakeCatalog create a catalog entry for this AST Node
+func (flow *RuleFlow) MakeCatalog(cat *Catalog) {
+	meta := &RuleFlowMeta{
+		NodeMeta: NodeMeta{
+			AstID:    flow.AstID,
+			GrlText:  flow.GrlText,
+			Snapshot: flow.GetSnapshot(),
+		},
+	}
+	if cat.AddMeta(flow.AstID, meta) {
+		meta.Name = flow.Name
+		meta.Stages = make([]RuleFlowStageMeta, len(flow.Stages))
+		for i, stage := range flow.Stages {
+			meta.Stages[i] = RuleFlowStageMeta{
+				Name:        stage.Name,
+				AgendaGroup: stage.AgendaGroup,
+				Transitions: make([]RuleFlowTransitionMeta, len(stage.Transitions)),
+			}
+			for j, transition := range stage.Transitions {
+				meta.Stages[i].Transitions[j] = RuleFlowTransitionMeta{
+					When: transition.When,
+					To:   transition.To,
+				}
+				if transition.Condition != nil {
+					meta.Stages[i].Transitions[j].ConditionID = transition.Condition.AstID
+					transition.Condition.MakeCatalog(cat)
+				}
+			}
+		}
+	}
+}
+
+// Clone will clone this RuleFlow. The new clone will have an identical structure
+func (flow *RuleFlow) Clone(cloneTable *pkg.CloneTable) *RuleFlow {
+	clone := &RuleFlow{
+		AstID:   unique.NewID(),
+		GrlText: flow.GrlText,
+		Name:    flow.Name,
+		Stages:  make([]*RuleFlowStage, len(flow.Stages)),
+	}
+	for i, stage := range flow.Stages {
+		clonedStage := &RuleFlowStage{
+			Name:        stage.Name,
+			AgendaGroup: stage.AgendaGroup,
+			Transitions: make([]*RuleFlowTransition, len(stage.Transitions)),
+		}
+		for j, transition := range stage.Transitions {
+			clonedTransition := &RuleFlowTransition{
+				When: transition.When,
+				To:   transition.To,
+			}
+			if transition.Condition != nil {
+				if cloneTable.IsCloned(transition.Condition.AstID) {
+					clonedTransition.Condition = cloneTable.Records[transition.Condition.AstID].CloneInstance.(*Expression)
+				} else {
+					cloned := transition.Condition.Clone(cloneTable)
+					clonedTransition.Condition = cloned
+					cloneTable.MarkCloned(transition.Condition.AstID, cloned.AstID, transition.Condition, cloned)
+				}
+			}
+			clonedStage.Transitions[j] = clonedTransition
+		}
+		clone.Stages[i] = clonedStage
+	}
+
+	return clone
+}
+
+// GetAstID get the UUID asigned for this AST graph node
+func (flow *RuleFlow) GetAstID() string {
+
+	return flow.AstID
+}
+
+// GetGrlText get the expression syntax related to this graph when it wast constructed
+func (flow *RuleFlow) GetGrlText() string {
+
+	return flow.GrlText
+}
+
+// GetSnapshot will create a structure signature or AST graph
+func (flow *RuleFlow) GetSnapshot() string {
+	var buff strings.Builder
+	buff.WriteString(RULEFLOW)
+	buff.WriteString("(")
+	buff.WriteString(flow.Name)
+	for _, stage := range flow.Stages {
+		buff.WriteString(";")
+		buff.WriteString(stage.Name)
+		buff.WriteString(":")
+		buff.WriteString(stage.AgendaGroup)
+		for _, transition := range stage.Transitions {
+			buff.WriteString("|")
+			if transition.Condition != nil {
+				buff.WriteString(transition.Condition.GetSnapshot())
+			}
+			buff.WriteString("->")
+			buff.WriteString(transition.To)
+		}
+	}
+	buff.WriteString(")")
+
+	return buff.String()
+}
+
+// SetGrlText set the rule flow source it was built from.
+func (flow *RuleFlow) SetGrlText(grlText string) {
+	flow.GrlText = grlText
+}
@@ -0,0 +1,49 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+// MainAgendaGroup is the agenda group of the rule entries that do not specify one.
+// It is always at the bottom of the focus stack.
+const MainAgendaGroup = "MAIN"
+
+// NewAgendaGroup create new RuleGroup AST object for an agenda-group attribute
+func NewAgendaGroup() *RuleGroup {
+
+	return &RuleGroup{}
+}
+
+// NewActivationGroup create new RuleGroup AST object for an activation-group attribute
+func NewActivationGroup() *RuleGroup {
+
+	return &RuleGroup{
+		Activation: true,
+	}
+}
+
+// RuleGroup is a simple AST object that stores the agenda group or the activation group of a rule entry
+type RuleGroup struct {
+	Activation bool
+	Name       string
+}
+
+// RuleGroupReceiver must be implemented by any AST object that stores rule groups
+type RuleGroupReceiver interface {
+	AcceptRuleGroup(group *RuleGroup) error
+}
+
+// AcceptStringLiteral accept the group name
+func (group *RuleGroup) AcceptStringLiteral(lit *StringLiteral) {
+	group.Name = lit.String
+}
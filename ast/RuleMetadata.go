@@ -0,0 +1,43 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+// TagMetadata is the name of the metadata holding the tags of a rule entry, e.g. @tag("pricing")
+const TagMetadata = "tag"
+
+// NewRuleMetadata create new RuleMetadata AST object for a metadata annotation, such as @owner("team-x")
+func NewRuleMetadata(name string) *RuleMetadata {
+
+	return &RuleMetadata{
+		Name:   name,
+		Values: make([]string, 0),
+	}
+}
+
+// RuleMetadata is a simple AST object that stores a metadata annotation of a rule entry
+type RuleMetadata struct {
+	Name   string
+	Values []string
+}
+
+// RuleMetadataReceiver must be implemented by any AST object that stores rule metadata
+type RuleMetadataReceiver interface {
+	AcceptRuleMetadata(metadata *RuleMetadata) error
+}
+
+// AcceptStringLiteral accept a metadata value
+func (metadata *RuleMetadata) AcceptStringLiteral(lit *StringLiteral) {
+	metadata.Values = append(metadata.Values, lit.String)
+}
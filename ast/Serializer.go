@@ -23,6 +23,8 @@ import (
 	"io"
 	"math"
 	"reflect"
+	"sort"
+	"time"
 )
 
 // NodeType is to label a Meta information within catalog
@@ -58,6 +60,20 @@ const (
 	TypeVariable
 	// TypeWhenScope meta type of WhenScope
 	TypeWhenScope
+	// TypeIfStatement meta type of IfStatement
+	TypeIfStatement
+	// TypeLetStatement meta type of LetStatement
+	TypeLetStatement
+	// TypeQuantifier meta type of Quantifier
+	TypeQuantifier
+	// TypeAggregate meta type of Aggregate
+	TypeAggregate
+	// TypeFunction meta type of Function
+	TypeFunction
+	// TypeDeclaration meta type of Declaration
+	TypeDeclaration
+	// TypeRuleFlow meta type of RuleFlow
+	TypeRuleFlow
 
 	// TypeString variable type string label
 	TypeString ValueType = iota
@@ -67,9 +83,21 @@ const (
 	TypeFloat
 	// TypeBoolean variable type boolean label
 	TypeBoolean
+	// TypeList variable type list label
+	TypeList
+	// TypeMap variable type map label
+	TypeMap
+	// TypeNil variable type nil label
+	TypeNil
+	// TypeDuration variable type time.Duration label
+	TypeDuration
+	// TypePeriod variable type pkg.Period label
+	TypePeriod
+	// TypeTime variable type time.Time label
+	TypeTime
 
 	// Version will be written to the stream and used for compatibility check
-	Version = "1.8"
+	Version = "1.9"
 )
 
 // Catalog used to catalog all AST nodes in a KnowledgeBase.
@@ -110,6 +138,7 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 		variableSnapshotMap:       make(map[string]*Variable),
 		expressionVariableMap:     make(map[*Variable][]*Expression),
 		expressionAtomVariableMap: make(map[*Variable][]*ExpressionAtom),
+		functions:                 make(map[string]*Function),
 		ID:                        unique.NewID(),
 	}
 	knowledgeBase := &KnowledgeBase{
@@ -164,6 +193,7 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 				ExpressionAtom:   nil,
 				Operator:         amet.Operator,
 				Negated:          amet.Negated,
+				Negative:         amet.Negative,
 			}
 			importTable[amet.AstID] = expression
 		case TypeConstant:
@@ -175,47 +205,11 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 				Value:    reflect.Value{},
 				IsNil:    amet.IsNil,
 			}
-			buffer := bytes.NewBuffer(amet.ValueBytes)
-			switch amet.ValueType {
-			case TypeString:
-				length := make([]byte, 8)
-				_, err := buffer.Read(length)
-				if err != nil {
-					return nil, err
-				}
-				dLen := binary.LittleEndian.Uint64(length)
-				byteArr := make([]byte, dLen)
-				_, byteArrErr := buffer.Read(byteArr)
-				if byteArrErr != nil {
-					return nil, byteArrErr
-				}
-				newConst.Value = reflect.ValueOf(string(byteArr))
-			case TypeBoolean:
-				arr := make([]byte, 1)
-				_, err := buffer.Read(arr)
-				if err != nil {
-					return nil, err
-				}
-				newConst.Value = reflect.ValueOf(arr[0] == 1)
-			case TypeInteger:
-				arr := make([]byte, 8)
-				_, err := buffer.Read(arr)
-				if err != nil {
-					return nil, err
-				}
-				newConst.Value = reflect.ValueOf(int64(binary.LittleEndian.Uint64(arr)))
-			case TypeFloat:
-				arr := make([]byte, 8)
-				_, err := buffer.Read(arr)
-				if err != nil {
-					return nil, err
-				}
-				bits := binary.LittleEndian.Uint64(arr)
-				float := math.Float64frombits(bits)
-				newConst.Value = reflect.ValueOf(float)
-			default:
-				return nil, fmt.Errorf("unrecognized type %d", amet.ValueType)
+			value, err := readConstantValue(bytes.NewBuffer(amet.ValueBytes), amet.ValueType)
+			if err != nil {
+				return nil, err
 			}
+			newConst.Value = value
 			importTable[amet.AstID] = newConst
 		case TypeExpressionAtom:
 			amet := meta.(*ExpressionAtomMeta)
@@ -227,8 +221,11 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 				FunctionCall:     nil,
 				Variable:         nil,
 				Negated:          amet.Negated,
+				NullSafe:         amet.NullSafe,
 				ExpressionAtom:   nil,
 				ArrayMapSelector: nil,
+				Quantifier:       nil,
+				Aggregate:        nil,
 			}
 			importTable[amet.AstID] = expressionAtm
 		case TypeFunctionCall:
@@ -237,6 +234,7 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 				AstID:        amet.AstID,
 				GrlText:      amet.GrlText,
 				FunctionName: amet.FunctionName,
+				Package:      amet.Package,
 				ArgumentList: nil,
 			}
 			importTable[amet.AstID] = funcCall
@@ -246,8 +244,19 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 				AstID:           amet.AstID,
 				GrlText:         amet.GrlText,
 				RuleName:        amet.RuleName,
+				Package:         amet.Package,
+				Extends:         amet.Extends,
 				RuleDescription: amet.RuleDescription,
 				Salience:        amet.Salience,
+				Sequence:        amet.Sequence,
+				AgendaGroup:     amet.AgendaGroup,
+				ActivationGroup: amet.ActivationGroup,
+				NoLoop:          amet.NoLoop,
+				LockOnActive:    amet.LockOnActive,
+				DateEffective:   timeFromMeta(amet.DateEffective),
+				DateExpires:     timeFromMeta(amet.DateExpires),
+				Disabled:        amet.Disabled,
+				Metadata:        amet.Metadata,
 				WhenScope:       nil,
 				ThenScope:       nil,
 			}
@@ -258,8 +267,11 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 			thenExp := &ThenExpression{
 				AstID:          amet.AstID,
 				GrlText:        amet.GrlText,
+				Line:           amet.Line,
 				Assignment:     nil,
 				ExpressionAtom: nil,
+				IfStatement:    nil,
+				LetStatement:   nil,
 			}
 			importTable[amet.AstID] = thenExp
 		case TypeThenExpressionList:
@@ -286,16 +298,100 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 				Name:             amet.Name,
 				Variable:         nil,
 				ArrayMapSelector: nil,
+				NullSafe:         amet.NullSafe,
+				Local:            amet.Local,
+				Binding:          nil,
 			}
 			importTable[amet.AstID] = variable
 		case TypeWhenScope:
 			amet := meta.(*WhenScopeMeta)
 			n := &WhenScope{
+				AstID:         amet.AstID,
+				GrlText:       amet.GrlText,
+				LetStatements: nil,
+				Expression:    nil,
+			}
+			importTable[amet.AstID] = n
+		case TypeIfStatement:
+			amet := meta.(*IfStatementMeta)
+			n := &IfStatement{
+				AstID:     amet.AstID,
+				GrlText:   amet.GrlText,
+				Line:      amet.Line,
+				Condition: nil,
+				Then:      nil,
+				Else:      nil,
+			}
+			importTable[amet.AstID] = n
+		case TypeLetStatement:
+			amet := meta.(*LetStatementMeta)
+			n := &LetStatement{
 				AstID:      amet.AstID,
 				GrlText:    amet.GrlText,
+				Line:       amet.Line,
+				Name:       amet.Name,
+				Variable:   nil,
 				Expression: nil,
 			}
 			importTable[amet.AstID] = n
+		case TypeQuantifier:
+			amet := meta.(*QuantifierMeta)
+			n := &Quantifier{
+				AstID:      amet.AstID,
+				GrlText:    amet.GrlText,
+				Kind:       amet.Kind,
+				Variable:   nil,
+				Collection: nil,
+				Predicate:  nil,
+			}
+			importTable[amet.AstID] = n
+		case TypeAggregate:
+			amet := meta.(*AggregateMeta)
+			n := &Aggregate{
+				AstID:      amet.AstID,
+				GrlText:    amet.GrlText,
+				Kind:       amet.Kind,
+				Variable:   nil,
+				Collection: nil,
+				Value:      nil,
+				Filter:     nil,
+			}
+			importTable[amet.AstID] = n
+		case TypeDeclaration:
+			amet := meta.(*DeclarationMeta)
+			declaration := &Declaration{
+				AstID:   amet.AstID,
+				GrlText: amet.GrlText,
+				Kind:    amet.Kind,
+				Name:    amet.Name,
+				Type:    amet.Type,
+			}
+			importTable[amet.AstID] = declaration
+			if knowledgeBase.Declarations == nil {
+				knowledgeBase.Declarations = make(map[string]*Declaration)
+			}
+			knowledgeBase.Declarations[declaration.Name] = declaration
+		case TypeFunction:
+			amet := meta.(*FunctionMeta)
+			function := &Function{
+				AstID:   amet.AstID,
+				GrlText: amet.GrlText,
+				Name:    amet.Name,
+			}
+			importTable[amet.AstID] = function
+			workingMem.functions[function.Name] = function
+		case TypeRuleFlow:
+			amet := meta.(*RuleFlowMeta)
+			flow := &RuleFlow{
+				AstID:   amet.AstID,
+				GrlText: amet.GrlText,
+				Name:    amet.Name,
+			}
+			importTable[amet.AstID] = flow
+			if knowledgeBase.RuleFlows == nil {
+				knowledgeBase.RuleFlows = make(map[string]*RuleFlow)
+			}
+			knowledgeBase.RuleFlows[flow.Name] = flow
 		default:
 			return nil, fmt.Errorf("unrecognized meta type %d", meta.GetASTType())
 		}
@@ -344,6 +440,12 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 			if len(amet.ExpressionAtomID) > 0 {
 				expr.ExpressionAtom = importTable[amet.ExpressionAtomID].(*ExpressionAtom)
 			}
+			if len(amet.UpperExpressionID) > 0 {
+				expr.UpperExpression = importTable[amet.UpperExpressionID].(*Expression)
+			}
+			if len(amet.ElseExpressionID) > 0 {
+				expr.ElseExpression = importTable[amet.ElseExpressionID].(*Expression)
+			}
 		case TypeConstant:
 			// nothing todo
 
@@ -365,6 +467,12 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 			if len(amet.ArrayMapSelectorID) > 0 {
 				expressAtm.ArrayMapSelector = importTable[amet.ArrayMapSelectorID].(*ArrayMapSelector)
 			}
+			if len(amet.QuantifierID) > 0 {
+				expressAtm.Quantifier = importTable[amet.QuantifierID].(*Quantifier)
+			}
+			if len(amet.AggregateID) > 0 {
+				expressAtm.Aggregate = importTable[amet.AggregateID].(*Aggregate)
+			}
 		case TypeFunctionCall:
 			funcCall := node.(*FunctionCall)
 			amet := meta.(*FunctionCallMeta)
@@ -389,6 +497,12 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 			if len(amet.ExpressionAtomID) > 0 {
 				thenExpr.ExpressionAtom = importTable[amet.ExpressionAtomID].(*ExpressionAtom)
 			}
+			if len(amet.IfStatementID) > 0 {
+				thenExpr.IfStatement = importTable[amet.IfStatementID].(*IfStatement)
+			}
+			if len(amet.LetStatementID) > 0 {
+				thenExpr.LetStatement = importTable[amet.LetStatementID].(*LetStatement)
+			}
 		case TypeThenExpressionList:
 			ThenExprList := node.(*ThenExpressionList)
 			amet := meta.(*ThenExpressionListMeta)
@@ -417,17 +531,125 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 			if len(amet.ArrayMapSelectorID) > 0 {
 				variable.ArrayMapSelector = importTable[amet.ArrayMapSelectorID].(*ArrayMapSelector)
 			}
+			if len(amet.BindingID) > 0 {
+				variable.Binding = importTable[amet.BindingID].(*Expression)
+			}
 		case TypeWhenScope:
 			whenScope := node.(*WhenScope)
 			amet := meta.(*WhenScopeMeta)
 			if len(amet.ExpressionID) > 0 {
 				whenScope.Expression = importTable[amet.ExpressionID].(*Expression)
 			}
+			if len(amet.LetStatementIDs) > 0 {
+				whenScope.LetStatements = make([]*LetStatement, len(amet.LetStatementIDs))
+				for k, v := range amet.LetStatementIDs {
+					whenScope.LetStatements[k] = importTable[v].(*LetStatement)
+				}
+			}
+		case TypeIfStatement:
+			ifStatement := node.(*IfStatement)
+			amet := meta.(*IfStatementMeta)
+			if len(amet.ConditionID) > 0 {
+				ifStatement.Condition = importTable[amet.ConditionID].(*Expression)
+			}
+			if len(amet.ThenID) > 0 {
+				ifStatement.Then = importTable[amet.ThenID].(*ThenExpressionList)
+			}
+			if len(amet.ElseID) > 0 {
+				ifStatement.Else = importTable[amet.ElseID].(*ThenExpressionList)
+			}
+		case TypeLetStatement:
+			letStatement := node.(*LetStatement)
+			amet := meta.(*LetStatementMeta)
+			if len(amet.VariableID) > 0 {
+				letStatement.Variable = importTable[amet.VariableID].(*Variable)
+			}
+			if len(amet.ExpressionID) > 0 {
+				letStatement.Expression = importTable[amet.ExpressionID].(*Expression)
+			}
+		case TypeQuantifier:
+			quantifier := node.(*Quantifier)
+			amet := meta.(*QuantifierMeta)
+			if len(amet.VariableID) > 0 {
+				quantifier.Variable = importTable[amet.VariableID].(*Variable)
+			}
+			if len(amet.CollectionID) > 0 {
+				quantifier.Collection = importTable[amet.CollectionID].(*ExpressionAtom)
+			}
+			if len(amet.PredicateID) > 0 {
+				quantifier.Predicate = importTable[amet.PredicateID].(*Expression)
+			}
+		case TypeAggregate:
+			aggregate := node.(*Aggregate)
+			amet := meta.(*AggregateMeta)
+			if len(amet.VariableID) > 0 {
+				aggregate.Variable = importTable[amet.VariableID].(*Variable)
+			}
+			if len(amet.CollectionID) > 0 {
+				aggregate.Collection = importTable[amet.CollectionID].(*ExpressionAtom)
+			}
+			if len(amet.ValueID) > 0 {
+				aggregate.Value = importTable[amet.ValueID].(*Expression)
+			}
+			if len(amet.FilterID) > 0 {
+				aggregate.Filter = importTable[amet.FilterID].(*Expression)
+			}
+		case TypeFunction:
+			function := node.(*Function)
+			amet := meta.(*FunctionMeta)
+			function.Parameters = make([]*Variable, len(amet.ParameterIDs))
+			for i, id := range amet.ParameterIDs {
+				function.Parameters[i] = importTable[id].(*Variable)
+			}
+			function.LetStatements = make([]*LetStatement, len(amet.LetStatementIDs))
+			for i, id := range amet.LetStatementIDs {
+				function.LetStatements[i] = importTable[id].(*LetStatement)
+			}
+			if len(amet.ExpressionID) > 0 {
+				function.Expression = importTable[amet.ExpressionID].(*Expression)
+			}
+		case TypeDeclaration:
+			declaration := node.(*Declaration)
+			amet := meta.(*DeclarationMeta)
+			if len(amet.ConstantID) > 0 {
+				declaration.Constant = importTable[amet.ConstantID].(*Constant)
+			}
+		case TypeRuleFlow:
+			flow := node.(*RuleFlow)
+			amet := meta.(*RuleFlowMeta)
+			flow.Stages = make([]*RuleFlowStage, len(amet.Stages))
+			for i, stageMeta := range amet.Stages {
+				stage := &RuleFlowStage{
+					Name:        stageMeta.Name,
+					AgendaGroup: stageMeta.AgendaGroup,
+					Transitions: make([]*RuleFlowTransition, len(stageMeta.Transitions)),
+				}
+				for j, transitionMeta := range stageMeta.Transitions {
+					stage.Transitions[j] = &RuleFlowTransition{
+						When: transitionMeta.When,
+						To:   transitionMeta.To,
+					}
+					if len(transitionMeta.ConditionID) > 0 {
+						stage.Transitions[j].Condition = importTable[transitionMeta.ConditionID].(*Expression)
+					}
+				}
+				flow.Stages[i] = stage
+			}
 		default:
 			return nil, fmt.Errorf("unknown AST type")
 		}
 	}
 
+	// Patterns are compiled once their constant is cross referenced.
+	for _, node := range importTable {
+		if expr, ok := node.(*Expression); ok && expr.Operator == OpMatches {
+			err := expr.CompilePattern()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// Rebuilding Working Memory
 	if cat.MemoryVariableSnapshotMap != nil && len(cat.MemoryVariableSnapshotMap) > 0 {
 		for key, value := range cat.MemoryVariableSnapshotMap {
@@ -656,6 +878,20 @@ func (cat *Catalog) ReadCatalogFromReader(reader io.Reader) error {
 			meta = &VariableMeta{}
 		case TypeWhenScope:
 			meta = &WhenScopeMeta{}
+		case TypeIfStatement:
+			meta = &IfStatementMeta{}
+		case TypeLetStatement:
+			meta = &LetStatementMeta{}
+		case TypeQuantifier:
+			meta = &QuantifierMeta{}
+		case TypeAggregate:
+			meta = &AggregateMeta{}
+		case TypeFunction:
+			meta = &FunctionMeta{}
+		case TypeDeclaration:
+			meta = &DeclarationMeta{}
+		case TypeRuleFlow:
+			meta = &RuleFlowMeta{}
 		default:
 
 			return fmt.Errorf("unknown meta number %d", metaType)
@@ -1582,6 +1818,9 @@ type ExpressionMeta struct {
 	ExpressionAtomID   string
 	Operator           int
 	Negated            bool
+	Negative           bool
+	UpperExpressionID  string
+	ElseExpressionID   string
 }
 
 // Equals basic function to test equality of two MetaNode
@@ -1615,6 +1854,18 @@ func (meta *ExpressionMeta) Equals(that Meta) bool {
 
 			return false
 		}
+		if meta.Negative != ins.Negative {
+
+			return false
+		}
+		if meta.UpperExpressionID != ins.UpperExpressionID {
+
+			return false
+		}
+		if meta.ElseExpressionID != ins.ElseExpressionID {
+
+			return false
+		}
 
 		return true
 	}
@@ -1667,6 +1918,21 @@ func (meta *ExpressionMeta) WriteMetaTo(writer io.Writer) error {
 
 		return err
 	}
+	err = WriteBoolToWriter(writer, meta.Negative)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.UpperExpressionID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.ElseExpressionID)
+	if err != nil {
+
+		return err
+	}
 
 	return nil
 }
@@ -1716,6 +1982,24 @@ func (meta *ExpressionMeta) ReadMetaFrom(reader io.Reader) error {
 		return err
 	}
 	meta.Negated = b
+	b, err = ReadBoolFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Negative = b
+	theString, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.UpperExpressionID = theString
+	theString, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.ElseExpressionID = theString
 
 	return nil
 }
@@ -1728,8 +2012,11 @@ type ExpressionAtomMeta struct {
 	FunctionCallID     string
 	VariableID         string
 	Negated            bool
+	NullSafe           bool
 	ExpressionAtomID   string
 	ArrayMapSelectorID string
+	QuantifierID       string
+	AggregateID        string
 }
 
 // Equals basic function to test equality of two MetaNode
@@ -1759,6 +2046,10 @@ func (meta *ExpressionAtomMeta) Equals(that Meta) bool {
 
 			return false
 		}
+		if meta.NullSafe != ins.NullSafe {
+
+			return false
+		}
 		if meta.ExpressionAtomID != ins.ExpressionAtomID {
 
 			return false
@@ -1768,6 +2059,14 @@ func (meta *ExpressionAtomMeta) Equals(that Meta) bool {
 
 			return false
 		}
+		if meta.QuantifierID != ins.QuantifierID {
+
+			return false
+		}
+		if meta.AggregateID != ins.AggregateID {
+
+			return false
+		}
 
 		return true
 	}
@@ -1815,6 +2114,11 @@ func (meta *ExpressionAtomMeta) WriteMetaTo(writer io.Writer) error {
 
 		return err
 	}
+	err = WriteBoolToWriter(writer, meta.NullSafe)
+	if err != nil {
+
+		return err
+	}
 	err = WriteStringToWriter(writer, meta.ExpressionAtomID)
 	if err != nil {
 
@@ -1825,6 +2129,16 @@ func (meta *ExpressionAtomMeta) WriteMetaTo(writer io.Writer) error {
 
 		return err
 	}
+	err = WriteStringToWriter(writer, meta.QuantifierID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.AggregateID)
+	if err != nil {
+
+		return err
+	}
 
 	return nil
 }
@@ -1868,6 +2182,12 @@ func (meta *ExpressionAtomMeta) ReadMetaFrom(reader io.Reader) error {
 		return err
 	}
 	meta.Negated = b
+	b, err = ReadBoolFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.NullSafe = b
 	stringFromReader, err = ReadStringFromReader(reader)
 	if err != nil {
 
@@ -1880,6 +2200,18 @@ func (meta *ExpressionAtomMeta) ReadMetaFrom(reader io.Reader) error {
 		return err
 	}
 	meta.ArrayMapSelectorID = stringFromReader
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.QuantifierID = stringFromReader
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.AggregateID = stringFromReader
 
 	return nil
 }
@@ -1888,6 +2220,7 @@ func (meta *ExpressionAtomMeta) ReadMetaFrom(reader io.Reader) error {
 type FunctionCallMeta struct {
 	NodeMeta
 	FunctionName   string
+	Package        string
 	ArgumentListID string
 }
 
@@ -1902,6 +2235,10 @@ func (meta *FunctionCallMeta) Equals(that Meta) bool {
 
 			return false
 		}
+		if meta.Package != ins.Package {
+
+			return false
+		}
 		if meta.ArgumentListID != ins.ArgumentListID {
 
 			return false
@@ -1933,6 +2270,11 @@ func (meta *FunctionCallMeta) WriteMetaTo(writer io.Writer) error {
 
 		return err
 	}
+	err = WriteStringToWriter(writer, meta.Package)
+	if err != nil {
+
+		return err
+	}
 	err = WriteStringToWriter(writer, meta.ArgumentListID)
 	if err != nil {
 
@@ -1962,18 +2304,146 @@ func (meta *FunctionCallMeta) ReadMetaFrom(reader io.Reader) error {
 
 		return err
 	}
+	meta.Package = stringFromReader
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
 	meta.ArgumentListID = stringFromReader
 
 	return nil
 }
 
+// timeToMeta converts a rule entry date into its meta data, a zero time stays zero.
+func timeToMeta(t time.Time) int64 {
+	if t.IsZero() {
+
+		return 0
+	}
+
+	return t.UnixNano()
+}
+
+// timeFromMeta converts the meta data of a rule entry date back into a time.
+func timeFromMeta(nano int64) time.Time {
+	if nano == 0 {
+
+		return time.Time{}
+	}
+
+	return time.Unix(0, nano)
+}
+
+// writeMetadataToWriter writes the metadata of a rule entry, sorted by name.
+func writeMetadataToWriter(writer io.Writer, metadata map[string][]string) error {
+	err := WriteIntToWriter(writer, uint64(len(metadata)))
+	if err != nil {
+
+		return err
+	}
+	names := make([]string, 0, len(metadata))
+	for name := range metadata {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		err = WriteStringToWriter(writer, name)
+		if err != nil {
+
+			return err
+		}
+		err = WriteIntToWriter(writer, uint64(len(metadata[name])))
+		if err != nil {
+
+			return err
+		}
+		for _, value := range metadata[name] {
+			err = WriteStringToWriter(writer, value)
+			if err != nil {
+
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// metadataEquals compares the metadata of two rule entries.
+func metadataEquals(this, that map[string][]string) bool {
+	if len(this) != len(that) {
+
+		return false
+	}
+	for name, values := range this {
+		thatValues, ok := that[name]
+		if !ok || len(values) != len(thatValues) {
+
+			return false
+		}
+		for i, value := range values {
+			if thatValues[i] != value {
+
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// readMetadataFromReader reads the metadata of a rule entry, it is nil if there is none.
+func readMetadataFromReader(reader io.Reader) (map[string][]string, error) {
+	count, err := ReadIntFromReader(reader)
+	if err != nil || count == 0 {
+
+		return nil, err
+	}
+	metadata := make(map[string][]string, count)
+	for i := uint64(0); i < count; i++ {
+		name, err := ReadStringFromReader(reader)
+		if err != nil {
+
+			return nil, err
+		}
+		valueCount, err := ReadIntFromReader(reader)
+		if err != nil {
+
+			return nil, err
+		}
+		values := make([]string, valueCount)
+		for j := range values {
+			values[j], err = ReadStringFromReader(reader)
+			if err != nil {
+
+				return nil, err
+			}
+		}
+		metadata[name] = values
+	}
+
+	return metadata, nil
+}
+
 // RuleEntryMeta meta data for an RuleEntry node
 type RuleEntryMeta struct {
 	NodeMeta
 
 	RuleName        string
+	Package         string
+	Extends         string
 	RuleDescription string
 	Salience        int
+	Sequence        int
+	AgendaGroup     string
+	ActivationGroup string
+	NoLoop          bool
+	LockOnActive    bool
+	DateEffective   int64 // unix nano, zero if not specified
+	DateExpires     int64 // unix nano, zero if not specified
+	Disabled        bool
+	Metadata        map[string][]string
 	WhenScopeID     string
 	ThenScopeID     string
 }
@@ -1989,6 +2459,14 @@ func (meta *RuleEntryMeta) Equals(that Meta) bool {
 
 			return false
 		}
+		if meta.Package != ins.Package {
+
+			return false
+		}
+		if meta.Extends != ins.Extends {
+
+			return false
+		}
 		if meta.RuleDescription != ins.RuleDescription {
 
 			return false
@@ -1997,6 +2475,42 @@ func (meta *RuleEntryMeta) Equals(that Meta) bool {
 
 			return false
 		}
+		if meta.Sequence != ins.Sequence {
+
+			return false
+		}
+		if meta.AgendaGroup != ins.AgendaGroup {
+
+			return false
+		}
+		if meta.ActivationGroup != ins.ActivationGroup {
+
+			return false
+		}
+		if meta.NoLoop != ins.NoLoop {
+
+			return false
+		}
+		if meta.LockOnActive != ins.LockOnActive {
+
+			return false
+		}
+		if meta.DateEffective != ins.DateEffective {
+
+			return false
+		}
+		if meta.DateExpires != ins.DateExpires {
+
+			return false
+		}
+		if meta.Disabled != ins.Disabled {
+
+			return false
+		}
+		if !metadataEquals(meta.Metadata, ins.Metadata) {
+
+			return false
+		}
 		if meta.WhenScopeID != ins.WhenScopeID {
 
 			return false
@@ -2032,12 +2546,67 @@ func (meta *RuleEntryMeta) WriteMetaTo(writer io.Writer) error {
 
 		return err
 	}
-	err = WriteStringToWriter(writer, meta.RuleDescription)
+	err = WriteStringToWriter(writer, meta.Package)
 	if err != nil {
 
 		return err
 	}
-	err = WriteIntToWriter(writer, uint64(meta.Salience))
+	err = WriteStringToWriter(writer, meta.Extends)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.RuleDescription)
+	if err != nil {
+
+		return err
+	}
+	err = WriteIntToWriter(writer, uint64(meta.Salience))
+	if err != nil {
+
+		return err
+	}
+	err = WriteIntToWriter(writer, uint64(meta.Sequence))
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.AgendaGroup)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.ActivationGroup)
+	if err != nil {
+
+		return err
+	}
+	err = WriteBoolToWriter(writer, meta.NoLoop)
+	if err != nil {
+
+		return err
+	}
+	err = WriteBoolToWriter(writer, meta.LockOnActive)
+	if err != nil {
+
+		return err
+	}
+	err = WriteIntToWriter(writer, uint64(meta.DateEffective))
+	if err != nil {
+
+		return err
+	}
+	err = WriteIntToWriter(writer, uint64(meta.DateExpires))
+	if err != nil {
+
+		return err
+	}
+	err = WriteBoolToWriter(writer, meta.Disabled)
+	if err != nil {
+
+		return err
+	}
+	err = writeMetadataToWriter(writer, meta.Metadata)
 	if err != nil {
 
 		return err
@@ -2076,6 +2645,18 @@ func (meta *RuleEntryMeta) ReadMetaFrom(reader io.Reader) error {
 
 		return err
 	}
+	meta.Package = stringFromReader
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Extends = stringFromReader
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
 	meta.RuleDescription = stringFromReader
 	i, err := ReadIntFromReader(reader)
 	if err != nil {
@@ -2083,6 +2664,59 @@ func (meta *RuleEntryMeta) ReadMetaFrom(reader io.Reader) error {
 		return err
 	}
 	meta.Salience = int(i)
+	i, err = ReadIntFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Sequence = int(i)
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.AgendaGroup = stringFromReader
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.ActivationGroup = stringFromReader
+	boolReaded, err := ReadBoolFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.NoLoop = boolReaded
+	boolReaded, err = ReadBoolFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.LockOnActive = boolReaded
+	i, err = ReadIntFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.DateEffective = int64(i)
+	i, err = ReadIntFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.DateExpires = int64(i)
+	boolReaded, err = ReadBoolFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Disabled = boolReaded
+	meta.Metadata, err = readMetadataFromReader(reader)
+	if err != nil {
+
+		return err
+	}
 	stringFromReader, err = ReadStringFromReader(reader)
 	if err != nil {
 
@@ -2105,6 +2739,9 @@ type ThenExpressionMeta struct {
 
 	AssignmentID     string
 	ExpressionAtomID string
+	IfStatementID    string
+	LetStatementID   string
+	Line             int
 }
 
 // Equals basic function to test equality of two MetaNode
@@ -2122,6 +2759,18 @@ func (meta *ThenExpressionMeta) Equals(that Meta) bool {
 
 			return false
 		}
+		if meta.IfStatementID != ins.IfStatementID {
+
+			return false
+		}
+		if meta.LetStatementID != ins.LetStatementID {
+
+			return false
+		}
+		if meta.Line != ins.Line {
+
+			return false
+		}
 
 		return true
 	}
@@ -2154,6 +2803,21 @@ func (meta *ThenExpressionMeta) WriteMetaTo(writer io.Writer) error {
 
 		return err
 	}
+	err = WriteStringToWriter(writer, meta.IfStatementID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.LetStatementID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteIntToWriter(writer, uint64(meta.Line))
+	if err != nil {
+
+		return err
+	}
 
 	return nil
 }
@@ -2179,6 +2843,24 @@ func (meta *ThenExpressionMeta) ReadMetaFrom(reader io.Reader) error {
 		return err
 	}
 	meta.ExpressionAtomID = theString
+	theString, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.IfStatementID = theString
+	theString, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.LetStatementID = theString
+	line, err := ReadIntFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Line = int(line)
 
 	return nil
 }
@@ -2350,6 +3032,9 @@ type VariableMeta struct {
 	Name               string
 	VariableID         string
 	ArrayMapSelectorID string
+	NullSafe           bool
+	Local              string
+	BindingID          string
 }
 
 // Equals basic function to test equality of two MetaNode
@@ -2371,6 +3056,18 @@ func (meta *VariableMeta) Equals(that Meta) bool {
 
 			return false
 		}
+		if meta.NullSafe != ins.NullSafe {
+
+			return false
+		}
+		if meta.Local != ins.Local {
+
+			return false
+		}
+		if meta.BindingID != ins.BindingID {
+
+			return false
+		}
 
 		return true
 	}
@@ -2408,6 +3105,21 @@ func (meta *VariableMeta) WriteMetaTo(writer io.Writer) error {
 
 		return err
 	}
+	err = WriteBoolToWriter(writer, meta.NullSafe)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.Local)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.BindingID)
+	if err != nil {
+
+		return err
+	}
 
 	return nil
 }
@@ -2439,6 +3151,24 @@ func (meta *VariableMeta) ReadMetaFrom(reader io.Reader) error {
 		return err
 	}
 	meta.ArrayMapSelectorID = stringFromReader
+	b, err := ReadBoolFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.NullSafe = b
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Local = stringFromReader
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.BindingID = stringFromReader
 
 	return nil
 }
@@ -2446,7 +3176,8 @@ func (meta *VariableMeta) ReadMetaFrom(reader io.Reader) error {
 // WhenScopeMeta meta data for an WhenScope node
 type WhenScopeMeta struct {
 	NodeMeta
-	ExpressionID string
+	ExpressionID    string
+	LetStatementIDs []string
 }
 
 // Equals basic function to test equality of two MetaNode
@@ -2460,6 +3191,16 @@ func (meta *WhenScopeMeta) Equals(that Meta) bool {
 
 			return false
 		}
+		if len(meta.LetStatementIDs) != len(ins.LetStatementIDs) {
+
+			return false
+		}
+		for k, v := range meta.LetStatementIDs {
+			if ins.LetStatementIDs[k] != v {
+
+				return false
+			}
+		}
 
 		return true
 	}
@@ -2487,6 +3228,18 @@ func (meta *WhenScopeMeta) WriteMetaTo(writer io.Writer) error {
 
 		return err
 	}
+	err = WriteIntToWriter(writer, uint64(len(meta.LetStatementIDs)))
+	if err != nil {
+
+		return err
+	}
+	for _, v := range meta.LetStatementIDs {
+		err = WriteStringToWriter(writer, v)
+		if err != nil {
+
+			return err
+		}
+	}
 
 	return nil
 }
@@ -2506,6 +3259,937 @@ func (meta *WhenScopeMeta) ReadMetaFrom(reader io.Reader) error {
 		return err
 	}
 	meta.ExpressionID = s
+	count, err := ReadIntFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	if count > 0 {
+		meta.LetStatementIDs = make([]string, count)
+		for i := range meta.LetStatementIDs {
+			s, err = ReadStringFromReader(reader)
+			if err != nil {
+
+				return err
+			}
+			meta.LetStatementIDs[i] = s
+		}
+	}
+
+	return nil
+}
+
+// IfStatementMeta meta data for an IfStatement node
+type IfStatementMeta struct {
+	NodeMeta
+
+	ConditionID string
+	ThenID      string
+	ElseID      string
+	Line        int
+}
+
+// Equals basic function to test equality of two MetaNode
+func (meta *IfStatementMeta) Equals(that Meta) bool {
+	if ins, ok := that.(*IfStatementMeta); ok {
+		if !meta.NodeMeta.Equals(that) {
+
+			return false
+		}
+		if meta.ConditionID != ins.ConditionID {
+
+			return false
+		}
+		if meta.ThenID != ins.ThenID {
+
+			return false
+		}
+		if meta.ElseID != ins.ElseID {
+
+			return false
+		}
+		if meta.Line != ins.Line {
+
+			return false
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// GetASTType returns the meta type of this AST Node
+func (meta *IfStatementMeta) GetASTType() NodeType {
+
+	return TypeIfStatement
+}
+
+// WriteMetaTo write basic AST Node information meta data into writer.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *IfStatementMeta) WriteMetaTo(writer io.Writer) error {
+	err := meta.NodeMeta.WriteMetaTo(writer)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.ConditionID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.ThenID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.ElseID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteIntToWriter(writer, uint64(meta.Line))
+	if err != nil {
+
+		return err
+	}
+
+	return nil
+}
+
+// ReadMetaFrom write basic AST Node information meta data from reader.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *IfStatementMeta) ReadMetaFrom(reader io.Reader) error {
+	err := meta.NodeMeta.ReadMetaFrom(reader)
+	if err != nil {
+
+		return err
+	}
+	s, err := ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.ConditionID = s
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.ThenID = s
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.ElseID = s
+	line, err := ReadIntFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Line = int(line)
+
+	return nil
+}
+
+// LetStatementMeta meta data for a LetStatement node
+type LetStatementMeta struct {
+	NodeMeta
+
+	Name         string
+	VariableID   string
+	ExpressionID string
+	Line         int
+}
+
+// Equals basic function to test equality of two MetaNode
+func (meta *LetStatementMeta) Equals(that Meta) bool {
+	if ins, ok := that.(*LetStatementMeta); ok {
+		if !meta.NodeMeta.Equals(that) {
+
+			return false
+		}
+		if meta.Name != ins.Name {
+
+			return false
+		}
+		if meta.VariableID != ins.VariableID {
+
+			return false
+		}
+		if meta.ExpressionID != ins.ExpressionID {
+
+			return false
+		}
+		if meta.Line != ins.Line {
+
+			return false
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// GetASTType returns the meta type of this AST Node
+func (meta *LetStatementMeta) GetASTType() NodeType {
+
+	return TypeLetStatement
+}
+
+// WriteMetaTo write basic AST Node information meta data into writer.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *LetStatementMeta) WriteMetaTo(writer io.Writer) error {
+	err := meta.NodeMeta.WriteMetaTo(writer)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.Name)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.VariableID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.ExpressionID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteIntToWriter(writer, uint64(meta.Line))
+	if err != nil {
+
+		return err
+	}
+
+	return nil
+}
+
+// ReadMetaFrom write basic AST Node information meta data from reader.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *LetStatementMeta) ReadMetaFrom(reader io.Reader) error {
+	err := meta.NodeMeta.ReadMetaFrom(reader)
+	if err != nil {
+
+		return err
+	}
+	s, err := ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Name = s
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.VariableID = s
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.ExpressionID = s
+	line, err := ReadIntFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Line = int(line)
+
+	return nil
+}
+
+// QuantifierMeta meta data for a Quantifier node
+type QuantifierMeta struct {
+	NodeMeta
+
+	Kind         string
+	VariableID   string
+	CollectionID string
+	PredicateID  string
+}
+
+// Equals basic function to test equality of two MetaNode
+func (meta *QuantifierMeta) Equals(that Meta) bool {
+	if ins, ok := that.(*QuantifierMeta); ok {
+		if !meta.NodeMeta.Equals(that) {
+
+			return false
+		}
+		if meta.Kind != ins.Kind {
+
+			return false
+		}
+		if meta.VariableID != ins.VariableID {
+
+			return false
+		}
+		if meta.CollectionID != ins.CollectionID {
+
+			return false
+		}
+		if meta.PredicateID != ins.PredicateID {
+
+			return false
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// GetASTType returns the meta type of this AST Node
+func (meta *QuantifierMeta) GetASTType() NodeType {
+
+	return TypeQuantifier
+}
+
+// WriteMetaTo write basic AST Node information meta data into writer.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *QuantifierMeta) WriteMetaTo(writer io.Writer) error {
+	err := meta.NodeMeta.WriteMetaTo(writer)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.Kind)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.VariableID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.CollectionID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.PredicateID)
+	if err != nil {
+
+		return err
+	}
+
+	return nil
+}
+
+// ReadMetaFrom write basic AST Node information meta data from reader.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *QuantifierMeta) ReadMetaFrom(reader io.Reader) error {
+	err := meta.NodeMeta.ReadMetaFrom(reader)
+	if err != nil {
+
+		return err
+	}
+	s, err := ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Kind = s
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.VariableID = s
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.CollectionID = s
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.PredicateID = s
+
+	return nil
+}
+
+// AggregateMeta meta data for an Aggregate node
+type AggregateMeta struct {
+	NodeMeta
+
+	Kind         string
+	VariableID   string
+	CollectionID string
+	ValueID      string
+	FilterID     string
+}
+
+// Equals basic function to test equality of two MetaNode
+func (meta *AggregateMeta) Equals(that Meta) bool {
+	if ins, ok := that.(*AggregateMeta); ok {
+		if !meta.NodeMeta.Equals(that) {
+
+			return false
+		}
+		if meta.Kind != ins.Kind {
+
+			return false
+		}
+		if meta.VariableID != ins.VariableID {
+
+			return false
+		}
+		if meta.CollectionID != ins.CollectionID {
+
+			return false
+		}
+		if meta.ValueID != ins.ValueID {
+
+			return false
+		}
+		if meta.FilterID != ins.FilterID {
+
+			return false
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// GetASTType returns the meta type of this AST Node
+func (meta *AggregateMeta) GetASTType() NodeType {
+
+	return TypeAggregate
+}
+
+// WriteMetaTo write basic AST Node information meta data into writer.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *AggregateMeta) WriteMetaTo(writer io.Writer) error {
+	err := meta.NodeMeta.WriteMetaTo(writer)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.Kind)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.VariableID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.CollectionID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.ValueID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.FilterID)
+	if err != nil {
+
+		return err
+	}
+
+	return nil
+}
+
+// ReadMetaFrom write basic AST Node information meta data from reader.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *AggregateMeta) ReadMetaFrom(reader io.Reader) error {
+	err := meta.NodeMeta.ReadMetaFrom(reader)
+	if err != nil {
+
+		return err
+	}
+	s, err := ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Kind = s
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.VariableID = s
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.CollectionID = s
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.ValueID = s
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.FilterID = s
+
+	return nil
+}
+
+// FunctionMeta meta data for a Function node
+type FunctionMeta struct {
+	NodeMeta
+
+	Name            string
+	ParameterIDs    []string
+	LetStatementIDs []string
+	ExpressionID    string
+}
+
+// Equals basic function to test equality of two MetaNode
+func (meta *FunctionMeta) Equals(that Meta) bool {
+	if ins, ok := that.(*FunctionMeta); ok {
+		if !meta.NodeMeta.Equals(that) {
+
+			return false
+		}
+		if meta.Name != ins.Name {
+
+			return false
+		}
+		if len(meta.ParameterIDs) != len(ins.ParameterIDs) {
+
+			return false
+		}
+		for i, id := range meta.ParameterIDs {
+			if ins.ParameterIDs[i] != id {
+
+				return false
+			}
+		}
+		if len(meta.LetStatementIDs) != len(ins.LetStatementIDs) {
+
+			return false
+		}
+		for i, id := range meta.LetStatementIDs {
+			if ins.LetStatementIDs[i] != id {
+
+				return false
+			}
+		}
+		if meta.ExpressionID != ins.ExpressionID {
+
+			return false
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// GetASTType returns the meta type of this AST Node
+func (meta *FunctionMeta) GetASTType() NodeType {
+
+	return TypeFunction
+}
+
+// WriteMetaTo write basic AST Node information meta data into writer.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *FunctionMeta) WriteMetaTo(writer io.Writer) error {
+	err := meta.NodeMeta.WriteMetaTo(writer)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.Name)
+	if err != nil {
+
+		return err
+	}
+	err = WriteIntToWriter(writer, uint64(len(meta.ParameterIDs)))
+	if err != nil {
+
+		return err
+	}
+	for _, id := range meta.ParameterIDs {
+		err = WriteStringToWriter(writer, id)
+		if err != nil {
+
+			return err
+		}
+	}
+	err = WriteIntToWriter(writer, uint64(len(meta.LetStatementIDs)))
+	if err != nil {
+
+		return err
+	}
+	for _, id := range meta.LetStatementIDs {
+		err = WriteStringToWriter(writer, id)
+		if err != nil {
+
+			return err
+		}
+	}
+	err = WriteStringToWriter(writer, meta.ExpressionID)
+	if err != nil {
+
+		return err
+	}
+
+	return nil
+}
+
+// ReadMetaFrom write basic AST Node information meta data from reader.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *FunctionMeta) ReadMetaFrom(reader io.Reader) error {
+	err := meta.NodeMeta.ReadMetaFrom(reader)
+	if err != nil {
+
+		return err
+	}
+	s, err := ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Name = s
+	count, err := ReadIntFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.ParameterIDs = make([]string, count)
+	for i := range meta.ParameterIDs {
+		meta.ParameterIDs[i], err = ReadStringFromReader(reader)
+		if err != nil {
+
+			return err
+		}
+	}
+	count, err = ReadIntFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.LetStatementIDs = make([]string, count)
+	for i := range meta.LetStatementIDs {
+		meta.LetStatementIDs[i], err = ReadStringFromReader(reader)
+		if err != nil {
+
+			return err
+		}
+	}
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.ExpressionID = s
+
+	return nil
+}
+
+// DeclarationMeta meta data for a Declaration node
+type DeclarationMeta struct {
+	NodeMeta
+
+	Kind       string
+	Name       string
+	Type       string
+	ConstantID string
+}
+
+// Equals basic function to test equality of two MetaNode
+func (meta *DeclarationMeta) Equals(that Meta) bool {
+	if ins, ok := that.(*DeclarationMeta); ok {
+		if !meta.NodeMeta.Equals(that) {
+
+			return false
+		}
+		if meta.Kind != ins.Kind {
+
+			return false
+		}
+		if meta.Name != ins.Name {
+
+			return false
+		}
+		if meta.Type != ins.Type {
+
+			return false
+		}
+		if meta.ConstantID != ins.ConstantID {
+
+			return false
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// GetASTType returns the meta type of this AST Node
+func (meta *DeclarationMeta) GetASTType() NodeType {
+
+	return TypeDeclaration
+}
+
+// WriteMetaTo write basic AST Node information meta data into writer.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *DeclarationMeta) WriteMetaTo(writer io.Writer) error {
+	err := meta.NodeMeta.WriteMetaTo(writer)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.Kind)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.Name)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.Type)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.ConstantID)
+	if err != nil {
+
+		return err
+	}
+
+	return nil
+}
+
+// ReadMetaFrom write basic AST Node information meta data from reader.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *DeclarationMeta) ReadMetaFrom(reader io.Reader) error {
+	err := meta.NodeMeta.ReadMetaFrom(reader)
+	if err != nil {
+
+		return err
+	}
+	s, err := ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Kind = s
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Name = s
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Type = s
+	s, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.ConstantID = s
+
+	return nil
+}
+
+// RuleFlowMeta meta data for a RuleFlow node
+type RuleFlowMeta struct {
+	NodeMeta
+
+	Name   string
+	Stages []RuleFlowStageMeta
+}
+
+// RuleFlowStageMeta meta data for a stage of a RuleFlow node
+type RuleFlowStageMeta struct {
+	Name        string
+	AgendaGroup string
+	Transitions []RuleFlowTransitionMeta
+}
+
+// RuleFlowTransitionMeta meta data for a transition of a RuleFlow node
+type RuleFlowTransitionMeta struct {
+	When        string
+	To          string
+	ConditionID string
+}
+
+// Equals basic function to test equality of two MetaNode
+func (meta *RuleFlowMeta) Equals(that Meta) bool {
+	if ins, ok := that.(*RuleFlowMeta); ok {
+		if !meta.NodeMeta.Equals(that) {
+
+			return false
+		}
+		if meta.Name != ins.Name {
+
+			return false
+		}
+		if len(meta.Stages) != len(ins.Stages) {
+
+			return false
+		}
+		for i, stage := range meta.Stages {
+			thatStage := ins.Stages[i]
+			if stage.Name != thatStage.Name || stage.AgendaGroup != thatStage.AgendaGroup {
+
+				return false
+			}
+			if len(stage.Transitions) != len(thatStage.Transitions) {
+
+				return false
+			}
+			for j, transition := range stage.Transitions {
+				if transition != thatStage.Transitions[j] {
+
+					return false
+				}
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// GetASTType returns the meta type of this AST Node
+func (meta *RuleFlowMeta) GetASTType() NodeType {
+
+	return TypeRuleFlow
+}
+
+// WriteMetaTo write basic AST Node information meta data into writer.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *RuleFlowMeta) WriteMetaTo(writer io.Writer) error {
+	err := meta.NodeMeta.WriteMetaTo(writer)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.Name)
+	if err != nil {
+
+		return err
+	}
+	err = WriteIntToWriter(writer, uint64(len(meta.Stages)))
+	if err != nil {
+
+		return err
+	}
+	for _, stage := range meta.Stages {
+		for _, s := range []string{stage.Name, stage.AgendaGroup} {
+			err = WriteStringToWriter(writer, s)
+			if err != nil {
+
+				return err
+			}
+		}
+		err = WriteIntToWriter(writer, uint64(len(stage.Transitions)))
+		if err != nil {
+
+			return err
+		}
+		for _, transition := range stage.Transitions {
+			for _, s := range []string{transition.When, transition.To, transition.ConditionID} {
+				err = WriteStringToWriter(writer, s)
+				if err != nil {
+
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReadMetaFrom write basic AST Node information meta data from reader.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *RuleFlowMeta) ReadMetaFrom(reader io.Reader) error {
+	err := meta.NodeMeta.ReadMetaFrom(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Name, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	count, err := ReadIntFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Stages = make([]RuleFlowStageMeta, count)
+	for i := range meta.Stages {
+		stage := &meta.Stages[i]
+		for _, s := range []*string{&stage.Name, &stage.AgendaGroup} {
+			*s, err = ReadStringFromReader(reader)
+			if err != nil {
+
+				return err
+			}
+		}
+		count, err = ReadIntFromReader(reader)
+		if err != nil {
+
+			return err
+		}
+		stage.Transitions = make([]RuleFlowTransitionMeta, count)
+		for j := range stage.Transitions {
+			transition := &stage.Transitions[j]
+			for _, s := range []*string{&transition.When, &transition.To, &transition.ConditionID} {
+				*s, err = ReadStringFromReader(reader)
+				if err != nil {
+
+					return err
+				}
+			}
+		}
+	}
 
 	return nil
 }
@@ -32,9 +32,12 @@ func NewThenExpression() *ThenExpression {
 type ThenExpression struct {
 	AstID   string
 	GrlText string
+	Line    int // line of the expression within its GRL resource, zero if unknown
 
 	Assignment     *Assignment
 	ExpressionAtom *ExpressionAtom
+	IfStatement    *IfStatement
+	LetStatement   *LetStatement
 }
 
 // MakeCatalog create a catalog entry for this AST Node
@@ -47,6 +50,7 @@ func (e *ThenExpression) MakeCatalog(cat *Catalog) {
 		},
 	}
 	if cat.AddMeta(e.AstID, meta) {
+		meta.Line = e.Line
 		if e.Assignment != nil {
 			meta.AssignmentID = e.Assignment.AstID
 			e.Assignment.MakeCatalog(cat)
@@ -55,6 +59,14 @@ func (e *ThenExpression) MakeCatalog(cat *Catalog) {
 			meta.ExpressionAtomID = e.ExpressionAtom.AstID
 			e.ExpressionAtom.MakeCatalog(cat)
 		}
+		if e.IfStatement != nil {
+			meta.IfStatementID = e.IfStatement.AstID
+			e.IfStatement.MakeCatalog(cat)
+		}
+		if e.LetStatement != nil {
+			meta.LetStatementID = e.LetStatement.AstID
+			e.LetStatement.MakeCatalog(cat)
+		}
 	}
 }
 
@@ -68,6 +80,7 @@ func (e *ThenExpression) Clone(cloneTable *pkg.CloneTable) *ThenExpression {
 	clone := &ThenExpression{
 		AstID:   unique.NewID(),
 		GrlText: e.GrlText,
+		Line:    e.Line,
 	}
 
 	if e.Assignment != nil {
@@ -90,6 +103,26 @@ func (e *ThenExpression) Clone(cloneTable *pkg.CloneTable) *ThenExpression {
 		}
 	}
 
+	if e.IfStatement != nil {
+		if cloneTable.IsCloned(e.IfStatement.AstID) {
+			clone.IfStatement = cloneTable.Records[e.IfStatement.AstID].CloneInstance.(*IfStatement)
+		} else {
+			cloned := e.IfStatement.Clone(cloneTable)
+			clone.IfStatement = cloned
+			cloneTable.MarkCloned(e.IfStatement.AstID, cloned.AstID, e.IfStatement, cloned)
+		}
+	}
+
+	if e.LetStatement != nil {
+		if cloneTable.IsCloned(e.LetStatement.AstID) {
+			clone.LetStatement = cloneTable.Records[e.LetStatement.AstID].CloneInstance.(*LetStatement)
+		} else {
+			cloned := e.LetStatement.Clone(cloneTable)
+			clone.LetStatement = cloned
+			cloneTable.MarkCloned(e.LetStatement.AstID, cloned.AstID, e.LetStatement, cloned)
+		}
+	}
+
 	return clone
 }
 
@@ -107,6 +140,20 @@ func (e *ThenExpression) AcceptExpressionAtom(exp *ExpressionAtom) error {
 	return nil
 }
 
+// AcceptIfStatement will accept an IfStatement AST graph into this ast graph
+func (e *ThenExpression) AcceptIfStatement(ifStatement *IfStatement) error {
+	e.IfStatement = ifStatement
+
+	return nil
+}
+
+// AcceptLetStatement will accept a LetStatement AST graph into this ast graph
+func (e *ThenExpression) AcceptLetStatement(letStatement *LetStatement) error {
+	e.LetStatement = letStatement
+
+	return nil
+}
+
 // GetAstID get the UUID asigned for this AST graph node
 func (e *ThenExpression) GetAstID() string {
 
@@ -130,6 +177,12 @@ func (e *ThenExpression) GetSnapshot() string {
 	if e.ExpressionAtom != nil {
 		buff.WriteString(e.ExpressionAtom.GetSnapshot())
 	}
+	if e.IfStatement != nil {
+		buff.WriteString(e.IfStatement.GetSnapshot())
+	}
+	if e.LetStatement != nil {
+		buff.WriteString(e.LetStatement.GetSnapshot())
+	}
 	buff.WriteString(")")
 
 	return buff.String()
@@ -164,6 +217,20 @@ func (e *ThenExpression) Execute(dataContext IDataContext, memory *WorkingMemory
 
 		return nil
 	}
+	if e.IfStatement != nil {
+
+		return e.IfStatement.Execute(dataContext, memory)
+	}
+	if e.LetStatement != nil {
+
+		return e.LetStatement.Execute(dataContext, memory)
+	}
 
 	return nil
 }
+
+// ThenExpressionListener is notified before each then expression is executed.
+type ThenExpressionListener interface {
+	// ExecuteThenExpression will be called before the then expression is executed. Returning an error aborts the then scope.
+	ExecuteThenExpression(thenExpression *ThenExpression) error
+}
@@ -72,6 +72,26 @@ func (e *ThenExpressionList) AcceptThenExpression(expr *ThenExpression) error {
 	return nil
 }
 
+// AcceptIfStatement will accept an IfStatement AST graph as the next statement of this ExpressionList
+func (e *ThenExpressionList) AcceptIfStatement(ifStatement *IfStatement) error {
+	thenExpr := NewThenExpression()
+	thenExpr.GrlText = ifStatement.GrlText
+	thenExpr.Line = ifStatement.Line
+	thenExpr.IfStatement = ifStatement
+
+	return e.AcceptThenExpression(thenExpr)
+}
+
+// AcceptLetStatement will accept a LetStatement AST graph as the next statement of this ExpressionList
+func (e *ThenExpressionList) AcceptLetStatement(letStatement *LetStatement) error {
+	thenExpr := NewThenExpression()
+	thenExpr.GrlText = letStatement.GrlText
+	thenExpr.Line = letStatement.Line
+	thenExpr.LetStatement = letStatement
+
+	return e.AcceptThenExpression(thenExpr)
+}
+
 // Clone will clone this ThenExpressionList. The new clone will have an identical structure
 func (e *ThenExpressionList) Clone(cloneTable *pkg.CloneTable) *ThenExpressionList {
 	clone := &ThenExpressionList{
@@ -134,7 +154,12 @@ func (e *ThenExpressionList) SetGrlText(grlText string) {
 // Execute will execute this graph in the Then scope
 func (e *ThenExpressionList) Execute(dataContext IDataContext, memory *WorkingMemory) error {
 	for _, es := range e.ThenExpressions {
-		err := es.Execute(dataContext, memory)
+		err := memory.notifyThenExpression(es)
+		if err != nil {
+
+			return err
+		}
+		err = es.Execute(dataContext, memory)
 		if err != nil {
 
 			return err
@@ -40,6 +40,13 @@ type Variable struct {
 	Name             string
 	Variable         *Variable
 	ArrayMapSelector *ArrayMapSelector
+	// NullSafe marks a member access using ?. which yields nil instead of an error when the object is nil.
+	NullSafe bool
+
+	// Local is the key of the local variable declared by a let statement, empty if the variable refers to a fact.
+	Local string
+	// Binding is the expression bound to a local variable declared in the when scope.
+	Binding *Expression
 
 	ValueNode model.ValueNode
 	Value     reflect.Value
@@ -63,16 +70,24 @@ func (e *Variable) MakeCatalog(cat *Catalog) {
 			meta.ArrayMapSelectorID = e.ArrayMapSelector.AstID
 			e.ArrayMapSelector.MakeCatalog(cat)
 		}
+		if e.Binding != nil {
+			meta.BindingID = e.Binding.AstID
+			e.Binding.MakeCatalog(cat)
+		}
 		meta.Name = e.Name
+		meta.NullSafe = e.NullSafe
+		meta.Local = e.Local
 	}
 }
 
 // Clone will clone this Variable. The new clone will have an identical structure
 func (e *Variable) Clone(cloneTable *pkg.CloneTable) *Variable {
 	clone := &Variable{
-		AstID:   unique.NewID(),
-		GrlText: e.GrlText,
-		Name:    e.Name,
+		AstID:    unique.NewID(),
+		GrlText:  e.GrlText,
+		Name:     e.Name,
+		NullSafe: e.NullSafe,
+		Local:    e.Local,
 	}
 
 	if e.Variable != nil {
@@ -94,6 +109,16 @@ func (e *Variable) Clone(cloneTable *pkg.CloneTable) *Variable {
 		}
 	}
 
+	if e.Binding != nil {
+		if cloneTable.IsCloned(e.Binding.AstID) {
+			clone.Binding = cloneTable.Records[e.Binding.AstID].CloneInstance.(*Expression)
+		} else {
+			cloned := e.Binding.Clone(cloneTable)
+			clone.Binding = cloned
+			cloneTable.MarkCloned(e.Binding.AstID, cloned.AstID, e.Binding, cloned)
+		}
+	}
+
 	return clone
 }
 
@@ -143,11 +168,23 @@ func (e *Variable) GetSnapshot() string {
 	var buff strings.Builder
 	buff.WriteString(VARIABLE)
 	buff.WriteString("(")
-	if len(e.Name) > 0 && e.Variable == nil {
+	if len(e.Local) > 0 {
+		// the binding is part of the signature, so the expressions using the local variable are reset with its facts.
+		buff.WriteString("L:")
+		buff.WriteString(e.Local)
+		if e.Binding != nil {
+			buff.WriteString("=")
+			buff.WriteString(e.Binding.GetSnapshot())
+		}
+	} else if len(e.Name) > 0 && e.Variable == nil {
 		buff.WriteString("N:")
 		buff.WriteString(e.Name)
 	} else if e.Variable != nil && len(e.Name) > 0 {
-		buff.WriteString(fmt.Sprintf("O:%s->%s", e.Variable.GetSnapshot(), e.Name))
+		if e.NullSafe {
+			buff.WriteString(fmt.Sprintf("O:%s?->%s", e.Variable.GetSnapshot(), e.Name))
+		} else {
+			buff.WriteString(fmt.Sprintf("O:%s->%s", e.Variable.GetSnapshot(), e.Name))
+		}
 	} else if e.Variable != nil && e.ArrayMapSelector != nil {
 		buff.WriteString(fmt.Sprintf("O:%s->%s", e.Variable.GetSnapshot(), e.ArrayMapSelector.GetSnapshot()))
 	}
@@ -162,8 +199,26 @@ func (e *Variable) SetGrlText(grlText string) {
 	e.GrlText = grlText
 }
 
-// Assign will assign the specified value to the variable
+// Assign will assign the specified value to the variable. A null-safe member, such as Fact.Cust?.Name, is left
+// unassigned when the object it belongs to is nil.
 func (e *Variable) Assign(newVal reflect.Value, dataContext IDataContext, memory *WorkingMemory) error {
+
+	return e.assign(newVal, false, dataContext, memory)
+}
+
+// assign will assign the specified value to the variable. When the value comes from a null-safe chain, a nil is
+// assigned as the zero value of the member, element or map value type.
+func (e *Variable) assign(newVal reflect.Value, nullSafe bool, dataContext IDataContext, memory *WorkingMemory) error {
+	if len(e.Local) > 0 {
+		if e.Binding != nil {
+
+			return fmt.Errorf("local variable %s is bound in the when scope and can not be assigned", e.Name)
+		}
+		memory.setLocal(e.Local, model.NewGoValueNode(newVal, e.Name))
+		memory.ResetVariable(e)
+
+		return nil
+	}
 	if len(e.Name) > 0 && e.Variable == nil {
 		err := dataContext.Add(e.Name, pkg.ValueToInterface(newVal))
 		if err == nil {
@@ -177,6 +232,14 @@ func (e *Variable) Assign(newVal reflect.Value, dataContext IDataContext, memory
 		if err != nil {
 			return err
 		}
+		if e.NullSafe && pkg.IsNilValue(e.Variable.Value) {
+
+			return nil
+		}
+		if nullSafe && !newVal.IsValid() {
+			typ, _ := e.Variable.ValueNode.GetObjectTypeByField(e.Name)
+			newVal = zeroValueOf(typ)
+		}
 		err = e.Variable.ValueNode.SetObjectValueByField(e.Name, newVal)
 		if err == nil {
 			dataContext.IncrementVariableChangeCount()
@@ -196,6 +259,9 @@ func (e *Variable) Assign(newVal reflect.Value, dataContext IDataContext, memory
 
 			return err
 		}
+		if nullSafe && !newVal.IsValid() && (e.Variable.ValueNode.IsArray() || e.Variable.ValueNode.IsMap()) {
+			newVal = zeroValueOf(e.Variable.ValueNode.Value().Type().Elem())
+		}
 		if e.Variable.ValueNode.IsArray() {
 			err := e.Variable.ValueNode.SetArrayValueAt(int(e.ArrayMapSelector.Value.Int()), newVal)
 			if err == nil {
@@ -217,8 +283,46 @@ func (e *Variable) Assign(newVal reflect.Value, dataContext IDataContext, memory
 	return fmt.Errorf("this code part should not be reached")
 }
 
+// isNullSafe tells whether the variable is reached through a null-safe member access.
+func (e *Variable) isNullSafe() bool {
+
+	return e.NullSafe || (e.Variable != nil && e.Variable.isNullSafe())
+}
+
+// zeroValueOf returns the zero value of the type, a nil interface if the type is unknown as for a JSON field.
+func zeroValueOf(typ reflect.Type) reflect.Value {
+	if typ == nil {
+
+		return reflect.Zero(reflect.TypeOf((*interface{})(nil)).Elem())
+	}
+
+	return reflect.Zero(typ)
+}
+
 // Evaluate will evaluate this AST graph for when scope evaluation
 func (e *Variable) Evaluate(dataContext IDataContext, memory *WorkingMemory) (reflect.Value, error) {
+	if len(e.Local) > 0 {
+		if e.Binding != nil {
+			val, err := e.Binding.Evaluate(dataContext, memory)
+			if err != nil {
+
+				return reflect.Value{}, err
+			}
+			e.ValueNode = model.NewGoValueNode(val, e.Name)
+			e.Value = val
+
+			return e.Value, nil
+		}
+		local, ok := memory.getLocal(e.Local)
+		if !ok {
+
+			return reflect.Value{}, fmt.Errorf("local variable %s is not set", e.Name)
+		}
+		e.ValueNode = local
+		e.Value = local.Value()
+
+		return e.Value, nil
+	}
 	if len(e.Name) > 0 && e.Variable == nil {
 		valueNode := dataContext.Get(e.Name)
 		if valueNode == nil {
@@ -236,6 +340,12 @@ func (e *Variable) Evaluate(dataContext IDataContext, memory *WorkingMemory) (re
 
 			return reflect.Value{}, err
 		}
+		if e.NullSafe && isAbsentMember(e.Variable.ValueNode, e.Name) {
+			e.ValueNode = e.Variable.ValueNode.ContinueWithValue(reflect.Value{}, e.Name)
+			e.Value = reflect.Value{}
+
+			return e.Value, nil
+		}
 		valueNode, err := e.Variable.ValueNode.GetChildNodeByField(e.Name)
 		if err != nil {
 
@@ -283,3 +393,19 @@ func (e *Variable) Evaluate(dataContext IDataContext, memory *WorkingMemory) (re
 
 	return reflect.ValueOf(nil), fmt.Errorf("this code part should not be reached")
 }
+
+// isAbsentMember will check if a null-safe access to the field of the node yields nil. That is when the node is nil,
+// or when it is a map, such as a JSON object, without the field.
+func isAbsentMember(node model.ValueNode, field string) bool {
+	val := node.Value()
+	if pkg.IsNilValue(val) {
+
+		return true
+	}
+	if val.Kind() == reflect.Map && val.Type().Key().Kind() == reflect.String {
+
+		return !val.MapIndex(reflect.ValueOf(field).Convert(val.Type().Key())).IsValid()
+	}
+
+	return false
+}
@@ -36,7 +36,8 @@ type WhenScope struct {
 	AstID   string
 	GrlText string
 
-	Expression *Expression
+	LetStatements []*LetStatement
+	Expression    *Expression
 }
 
 // MakeCatalog create a catalog entry for this AST Node
@@ -49,6 +50,13 @@ func (e *WhenScope) MakeCatalog(cat *Catalog) {
 		},
 	}
 	if cat.AddMeta(e.AstID, meta) {
+		if len(e.LetStatements) > 0 {
+			meta.LetStatementIDs = make([]string, len(e.LetStatements))
+			for i, v := range e.LetStatements {
+				meta.LetStatementIDs[i] = v.AstID
+				v.MakeCatalog(cat)
+			}
+		}
 		if e.Expression != nil {
 			meta.ExpressionID = e.Expression.AstID
 			e.Expression.MakeCatalog(cat)
@@ -78,6 +86,19 @@ func (e *WhenScope) Clone(cloneTable *pkg.CloneTable) *WhenScope {
 		}
 	}
 
+	if e.LetStatements != nil {
+		clone.LetStatements = make([]*LetStatement, len(e.LetStatements))
+		for k, let := range e.LetStatements {
+			if cloneTable.IsCloned(let.AstID) {
+				clone.LetStatements[k] = cloneTable.Records[let.AstID].CloneInstance.(*LetStatement)
+			} else {
+				cloned := let.Clone(cloneTable)
+				clone.LetStatements[k] = cloned
+				cloneTable.MarkCloned(let.AstID, cloned.AstID, let, cloned)
+			}
+		}
+	}
+
 	return clone
 }
 
@@ -92,6 +113,13 @@ func (e *WhenScope) AcceptExpression(exp *Expression) error {
 	return errors.New("expression for when scope already assigned")
 }
 
+// AcceptLetStatement will accept a LetStatement binding a local variable of the when scope
+func (e *WhenScope) AcceptLetStatement(letStatement *LetStatement) error {
+	e.LetStatements = append(e.LetStatements, letStatement)
+
+	return nil
+}
+
 // GetAstID get the UUID asigned for this AST graph node
 func (e *WhenScope) GetAstID() string {
 
@@ -109,6 +137,10 @@ func (e *WhenScope) GetSnapshot() string {
 	var buff strings.Builder
 	buff.WriteString(WHENSCOPE)
 	buff.WriteString("(")
+	for _, let := range e.LetStatements {
+		buff.WriteString(let.GetSnapshot())
+		buff.WriteString(";")
+	}
 	if e.Expression != nil {
 		buff.WriteString(e.Expression.GetSnapshot())
 	}
@@ -18,7 +18,9 @@ import (
 	"fmt"
 	"github.com/DataWiseHQ/grule-rule-engine/ast/unique"
 	"github.com/DataWiseHQ/grule-rule-engine/logger"
+	"github.com/DataWiseHQ/grule-rule-engine/model"
 	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"reflect"
 	"strings"
 	"time"
 )
@@ -34,6 +36,7 @@ func NewWorkingMemory(name, version string) *WorkingMemory {
 		expressionAtomSnapshotMap: make(map[string]*ExpressionAtom),
 		expressionVariableMap:     make(map[*Variable][]*Expression),
 		expressionAtomVariableMap: make(map[*Variable][]*ExpressionAtom),
+		functions:                 make(map[string]*Function),
 		ID:                        unique.NewID(),
 	}
 }
@@ -47,6 +50,11 @@ type WorkingMemory struct {
 	variableSnapshotMap       map[string]*Variable
 	expressionVariableMap     map[*Variable][]*Expression
 	expressionAtomVariableMap map[*Variable][]*ExpressionAtom
+	resetExpressions          map[*Expression]bool
+	assignmentListeners       []AssignmentListener
+	thenExpressionListeners   []ThenExpressionListener
+	localValues               map[string]model.ValueNode
+	functions                 map[string]*Function
 	ID                        string
 }
 
@@ -66,6 +74,9 @@ func (workingMem *WorkingMemory) MakeCatalog(cat *Catalog) {
 	for key, value := range workingMem.variableSnapshotMap {
 		cat.MemoryVariableSnapshotMap[key] = value.AstID
 	}
+	for _, function := range workingMem.functions {
+		function.MakeCatalog(cat)
+	}
 	cat.MemoryExpressionVariableMap = make(map[string][]string)
 	for key, value := range workingMem.expressionVariableMap {
 		cat.MemoryExpressionVariableMap[key.AstID] = make([]string, len(value))
@@ -138,6 +149,10 @@ func (workingMem *WorkingMemory) Equals(that *WorkingMemory) bool {
 
 		return false
 	}
+	if len(workingMem.functions) != len(that.functions) {
+
+		return false
+	}
 
 	return true
 }
@@ -147,6 +162,17 @@ func (workingMem *WorkingMemory) Clone(cloneTable *pkg.CloneTable) (*WorkingMemo
 	AstLog.Debugf("Cloning working memory %s:%s", workingMem.Name, workingMem.Version)
 	clone := NewWorkingMemory(workingMem.Name, workingMem.Version)
 
+	// the nodes of the function bodies are in the snapshot maps, so they are cloned first.
+	for name, function := range workingMem.functions {
+		if cloneTable.IsCloned(function.AstID) {
+			clone.functions[name] = cloneTable.Records[function.AstID].CloneInstance.(*Function)
+		} else {
+			cloned := function.Clone(cloneTable)
+			clone.functions[name] = cloned
+			cloneTable.MarkCloned(function.AstID, cloned.AstID, function, cloned)
+		}
+	}
+
 	if workingMem.expressionSnapshotMap != nil {
 		AstLog.Debugf("Cloning %d expressionSnapshotMap entries", len(workingMem.expressionSnapshotMap))
 		for k, expr := range workingMem.expressionSnapshotMap {
@@ -247,6 +273,27 @@ func (workingMem *WorkingMemory) IndexVariables() {
 	workingMem.expressionVariableMap = make(map[*Variable][]*Expression)
 	workingMem.expressionAtomVariableMap = make(map[*Variable][]*ExpressionAtom)
 
+	// quantified maps the snapshot of a quantified or aggregated collection variable to the snapshots of the
+	// quantifiers and aggregates over it.
+	quantified := make(map[string][]string)
+	for exprAtmSnapshot, exprAtm := range workingMem.expressionAtomSnapshotMap {
+		var collection *ExpressionAtom
+		if exprAtm.Quantifier != nil {
+			collection = exprAtm.Quantifier.Collection
+		} else if exprAtm.Aggregate != nil {
+			collection = exprAtm.Aggregate.Collection
+		}
+		if collection != nil && collection.Variable != nil {
+			quantified[collection.Variable.GetSnapshot()] = append(quantified[collection.Variable.GetSnapshot()], exprAtmSnapshot)
+		}
+	}
+
+	calls := workingMem.functionCallSnapshots()
+	bodies := make(map[*Function]string, len(workingMem.functions))
+	for _, function := range workingMem.functions {
+		bodies[function] = function.GetSnapshot()
+	}
+
 	for varSnapshot, variable := range workingMem.variableSnapshotMap {
 		if _, ok := workingMem.expressionVariableMap[variable]; ok == false {
 			workingMem.expressionVariableMap[variable] = make([]*Expression, 0)
@@ -265,12 +312,106 @@ func (workingMem *WorkingMemory) IndexVariables() {
 				workingMem.expressionAtomVariableMap[variable] = append(workingMem.expressionAtomVariableMap[variable], exprAtm)
 			}
 		}
+
+		// a change of an element of a quantified collection changes the quantifiers and aggregates over it.
+		for parent := variable.Variable; parent != nil; parent = parent.Variable {
+			for _, quantifierSnapshot := range quantified[parent.GetSnapshot()] {
+				for exprSnapshot, expr := range workingMem.expressionSnapshotMap {
+					if strings.Contains(exprSnapshot, quantifierSnapshot) {
+						workingMem.expressionVariableMap[variable] = append(workingMem.expressionVariableMap[variable], expr)
+					}
+				}
+				for exprAtmSnapshot, exprAtm := range workingMem.expressionAtomSnapshotMap {
+					if strings.Contains(exprAtmSnapshot, quantifierSnapshot) {
+						workingMem.expressionAtomVariableMap[variable] = append(workingMem.expressionAtomVariableMap[variable], exprAtm)
+					}
+				}
+			}
+		}
+
+		// a call of a function declared in GRL reads the variables of the function body.
+		for function, body := range bodies {
+			if !strings.Contains(body, varSnapshot) {
+				continue
+			}
+			for _, callSnapshot := range calls[function] {
+				for exprSnapshot, expr := range workingMem.expressionSnapshotMap {
+					if strings.Contains(exprSnapshot, callSnapshot) {
+						workingMem.expressionVariableMap[variable] = append(workingMem.expressionVariableMap[variable], expr)
+					}
+				}
+				for exprAtmSnapshot, exprAtm := range workingMem.expressionAtomSnapshotMap {
+					if strings.Contains(exprAtmSnapshot, callSnapshot) {
+						workingMem.expressionAtomVariableMap[variable] = append(workingMem.expressionAtomVariableMap[variable], exprAtm)
+					}
+				}
+			}
+		}
 	}
 
 	workingMem.DebugContent()
 
 }
 
+// functionCallSnapshots maps each function declared in GRL to the snapshots of the expression atoms calling it,
+// and of the expression atoms calling the functions whose body calls it.
+func (workingMem *WorkingMemory) functionCallSnapshots() map[*Function][]string {
+	calls := make(map[*Function][]string)
+	if len(workingMem.functions) == 0 {
+
+		return calls
+	}
+	for exprAtmSnapshot, exprAtm := range workingMem.expressionAtomSnapshotMap {
+		if exprAtm.FunctionCall == nil {
+			continue
+		}
+		if function := exprAtm.FunctionCall.GetFunction(workingMem); function != nil {
+			calls[function] = append(calls[function], exprAtmSnapshot)
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, caller := range workingMem.functions {
+			body := caller.GetSnapshot()
+			for callee, snapshots := range calls {
+				if callee == caller || !containsAny(body, snapshots) {
+					continue
+				}
+				for _, callerSnapshot := range calls[caller] {
+					if !containsString(calls[callee], callerSnapshot) {
+						calls[callee] = append(calls[callee], callerSnapshot)
+						changed = true
+					}
+				}
+			}
+		}
+	}
+
+	return calls
+}
+
+func containsAny(snapshot string, snapshots []string) bool {
+	for _, s := range snapshots {
+		if strings.Contains(snapshot, s) {
+
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+
+			return true
+		}
+	}
+
+	return false
+}
+
 // AddExpression will add expression into its map if the expression signature is unique
 // if the expression is already in its map, it will return one from the map.
 func (workingMem *WorkingMemory) AddExpression(exp *Expression) *Expression {
@@ -328,7 +469,7 @@ func (workingMem *WorkingMemory) Reset(name string) bool {
 	}
 	for snap, expr := range workingMem.expressionSnapshotMap {
 		if strings.Contains(snap, name) || strings.Contains(expr.GrlText, name) {
-			expr.Evaluated = false
+			workingMem.resetExpression(expr)
 		}
 	}
 	for snap, expr := range workingMem.expressionAtomSnapshotMap {
@@ -351,7 +492,7 @@ func (workingMem *WorkingMemory) ResetVariable(variable *Variable) bool {
 	if arr, ok := workingMem.expressionVariableMap[variable]; ok {
 		for _, expr := range arr {
 			AstLog.Tracef("------ reset expr : %s", expr.GrlText)
-			expr.Evaluated = false
+			workingMem.resetExpression(expr)
 			reseted = true
 		}
 	} else {
@@ -370,9 +511,141 @@ func (workingMem *WorkingMemory) ResetVariable(variable *Variable) bool {
 	return reseted
 }
 
+// resetExpression sets the expression evaluated status to false and records it
+// so it can be reported by DrainResetExpressions.
+func (workingMem *WorkingMemory) resetExpression(expr *Expression) {
+	expr.Evaluated = false
+	if workingMem.resetExpressions == nil {
+		workingMem.resetExpressions = make(map[*Expression]bool)
+	}
+	workingMem.resetExpressions[expr] = true
+}
+
+// DrainResetExpressions returns the expressions whose evaluated status got reset by Reset or ResetVariable
+// since the last call, and forgets them. Expressions reset by ResetAll are not reported.
+func (workingMem *WorkingMemory) DrainResetExpressions() []*Expression {
+	drained := make([]*Expression, 0, len(workingMem.resetExpressions))
+	for expr := range workingMem.resetExpressions {
+		drained = append(drained, expr)
+	}
+	workingMem.resetExpressions = nil
+
+	return drained
+}
+
+// AddAssignmentListener will register a listener to be notified of the assignments executed using this working memory.
+func (workingMem *WorkingMemory) AddAssignmentListener(listener AssignmentListener) {
+	workingMem.assignmentListeners = append(workingMem.assignmentListeners, listener)
+}
+
+// RemoveAssignmentListener will unregister a listener previously added using AddAssignmentListener.
+func (workingMem *WorkingMemory) RemoveAssignmentListener(listener AssignmentListener) {
+	for i, l := range workingMem.assignmentListeners {
+		if l == listener {
+			workingMem.assignmentListeners = append(workingMem.assignmentListeners[:i], workingMem.assignmentListeners[i+1:]...)
+
+			return
+		}
+	}
+}
+
+func (workingMem *WorkingMemory) hasAssignmentListeners() bool {
+
+	return len(workingMem.assignmentListeners) > 0
+}
+
+func (workingMem *WorkingMemory) notifyAssignment(variable *Variable, oldValue, newValue reflect.Value) {
+	for _, l := range workingMem.assignmentListeners {
+		l.VariableAssigned(variable, oldValue, newValue)
+	}
+}
+
+// AddThenExpressionListener will register a listener to be notified before each then expression executed using this working memory.
+func (workingMem *WorkingMemory) AddThenExpressionListener(listener ThenExpressionListener) {
+	workingMem.thenExpressionListeners = append(workingMem.thenExpressionListeners, listener)
+}
+
+// RemoveThenExpressionListener will unregister a listener previously added using AddThenExpressionListener.
+func (workingMem *WorkingMemory) RemoveThenExpressionListener(listener ThenExpressionListener) {
+	for i, l := range workingMem.thenExpressionListeners {
+		if l == listener {
+			workingMem.thenExpressionListeners = append(workingMem.thenExpressionListeners[:i], workingMem.thenExpressionListeners[i+1:]...)
+
+			return
+		}
+	}
+}
+
+func (workingMem *WorkingMemory) notifyThenExpression(thenExpression *ThenExpression) error {
+	for _, l := range workingMem.thenExpressionListeners {
+		err := l.ExecuteThenExpression(thenExpression)
+		if err != nil {
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetEvaluatedExpressions returns the expressions whose value is currently memoized, by their GRL text.
+func (workingMem *WorkingMemory) GetEvaluatedExpressions() map[string]reflect.Value {
+	evaluated := make(map[string]reflect.Value)
+	for _, expr := range workingMem.expressionSnapshotMap {
+		if expr.Evaluated {
+			evaluated[expr.GrlText] = expr.Value
+		}
+	}
+	for _, expr := range workingMem.expressionAtomSnapshotMap {
+		if expr.Evaluated {
+			evaluated[expr.GrlText] = expr.Value
+		}
+	}
+
+	return evaluated
+}
+
+// AddFunction will add a function declared in GRL, it returns an error if a function with the same name exists.
+func (workingMem *WorkingMemory) AddFunction(function *Function) error {
+	if _, ok := workingMem.functions[function.Name]; ok {
+
+		return fmt.Errorf("function %s already exist", function.Name)
+	}
+	if workingMem.functions == nil {
+		workingMem.functions = make(map[string]*Function)
+	}
+	workingMem.functions[function.Name] = function
+
+	return nil
+}
+
+// GetFunction returns the function declared in GRL with the name, nil if there is none.
+func (workingMem *WorkingMemory) GetFunction(name string) *Function {
+
+	return workingMem.functions[name]
+}
+
+// setLocal stores the value of a local variable declared by a let statement or a quantifier, keyed by LocalVariableKey.
+// The local variables are kept apart from the facts of the data context.
+func (workingMem *WorkingMemory) setLocal(key string, value model.ValueNode) {
+	if workingMem.localValues == nil {
+		workingMem.localValues = make(map[string]model.ValueNode)
+	}
+	workingMem.localValues[key] = value
+}
+
+// getLocal returns the value of a local variable, and whether it was set.
+func (workingMem *WorkingMemory) getLocal(key string) (model.ValueNode, bool) {
+	value, ok := workingMem.localValues[key]
+
+	return value, ok
+}
+
 // ResetAll sets all expression evaluated status to false.
 // Returns true if any expression was reset, false if otherwise
 func (workingMem *WorkingMemory) ResetAll() bool {
+	workingMem.resetExpressions = nil
+	workingMem.localValues = nil
 	reseted := false
 	for _, expr := range workingMem.expressionSnapshotMap {
 		expr.Evaluated = false
@@ -150,8 +150,10 @@ func (builder *RuleBuilder) BuildRuleFromResource(name, version string, resource
 	is := antlr.NewInputStream(string(data))
 	lexer := parser.Newgrulev3Lexer(is)
 
+	// the error names the resource, such as the template and row a rule is expanded from.
 	errReporter := &pkg.GruleErrorReporter{
-		Errors: make([]error, 0),
+		Errors:   make([]error, 0),
+		Resource: resource.String(),
 	}
 
 	lexer.RemoveErrorListeners()
@@ -176,7 +178,7 @@ func (builder *RuleBuilder) BuildRuleFromResource(name, version string, resource
 	antlr.ParseTreeWalkerDefault.Walk(listener, psr.Grl())
 
 	grl := listener.Grl
-	for _, ruleEntry := range grl.RuleEntries {
+	for _, ruleEntry := range grl.DeclaredRuleEntries() {
 		err := knowledgeBase.AddRuleEntry(ruleEntry)
 		if err != nil && err.Error() != "rule entry TestNoDesc already exist" {
 			BuilderLog.Tracef("warning while adding rule entry : %s. got %s, possibly already added by antlr listener", ruleEntry.RuleName, err.Error())
@@ -189,7 +191,7 @@ func (builder *RuleBuilder) BuildRuleFromResource(name, version string, resource
 	dur := time.Now().Sub(startTime)
 
 	if errReporter.HasError() {
-		BuilderLog.Errorf("GRL syntax error. got %s", errReporter.Error())
+		BuilderLog.Errorf("GRL syntax error in %s. got %s", resource.String(), errReporter.Error())
 		for i, err := range errReporter.Errors {
 			BuilderLog.Errorf("%d : %s", i, err.Error())
 		}
@@ -201,3 +203,112 @@ func (builder *RuleBuilder) BuildRuleFromResource(name, version string, resource
 
 	return nil
 }
+
+// BuildRuleFlowFromResource will load rule flows in JSON format from a single resource into the knowledge base.
+// The transition conditions are compiled in the knowledge base, so they can use its functions and consts, but they
+// are never executed as rules.
+func (builder *RuleBuilder) BuildRuleFlowFromResource(name, version string, resource pkg.Resource) error {
+	data, err := resource.Load()
+	if err != nil {
+
+		return err
+	}
+	flowsJSON, err := pkg.ParseJSONRuleFlows(data)
+	if err != nil {
+
+		return err
+	}
+	knowledgeBase := builder.KnowledgeLibrary.GetKnowledgeBase(name, version)
+	if knowledgeBase == nil {
+
+		return fmt.Errorf("KnowledgeBase %s:%s is not in this library", name, version)
+	}
+	for _, flowJSON := range flowsJSON {
+		flow, err := buildRuleFlow(flowJSON, knowledgeBase)
+		if err != nil {
+
+			return err
+		}
+		err = knowledgeBase.AddRuleFlow(flow)
+		if err != nil {
+
+			return err
+		}
+	}
+	knowledgeBase.WorkingMemory.IndexVariables()
+	BuilderLog.Debugf("Loading rule flow resource : %s success", resource.String())
+
+	return nil
+}
+
+// buildRuleFlow creates the rule flow AST, compiling the conditional transitions in the knowledge base.
+func buildRuleFlow(flowJSON *pkg.RuleFlowJSON, knowledgeBase *ast.KnowledgeBase) (*ast.RuleFlow, error) {
+	flow := ast.NewRuleFlow(flowJSON.Name)
+	flow.Stages = make([]*ast.RuleFlowStage, len(flowJSON.Stages))
+	for i, stageJSON := range flowJSON.Stages {
+		stage := &ast.RuleFlowStage{
+			Name:        stageJSON.Name,
+			AgendaGroup: stageJSON.AgendaGroup,
+			Transitions: make([]*ast.RuleFlowTransition, len(stageJSON.Transitions)),
+		}
+		for j := range stageJSON.Transitions {
+			when, err := stageJSON.Transitions[j].Condition()
+			if err != nil {
+
+				return nil, err
+			}
+			transition := &ast.RuleFlowTransition{
+				When: when,
+				To:   stageJSON.Transitions[j].To,
+			}
+			if len(when) > 0 {
+				transition.Condition, err = buildRuleFlowCondition(when, knowledgeBase)
+				if err != nil {
+
+					return nil, fmt.Errorf("rule flow %s has an invalid transition condition \"%s\". got %w", flow.Name, when, err)
+				}
+			}
+			stage.Transitions[j] = transition
+		}
+		flow.Stages[i] = stage
+	}
+
+	return flow, nil
+}
+
+// buildRuleFlowCondition parses the condition of a transition as a GRL expression, whose nodes are added to the
+// working memory of the knowledge base.
+func buildRuleFlowCondition(when string, knowledgeBase *ast.KnowledgeBase) (*ast.Expression, error) {
+	errReporter := &pkg.GruleErrorReporter{
+		Errors: make([]error, 0),
+	}
+	lexer := parser.Newgrulev3Lexer(antlr.NewInputStream(when))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errReporter)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+
+	psr := parser.Newgrulev3Parser(stream)
+	psr.RemoveErrorListeners()
+	psr.AddErrorListener(errReporter)
+	psr.BuildParseTrees = true
+
+	// the expression is received by a when scope, as it would be in a rule entry.
+	listener := antlr2.NewGruleV3ParserListener(knowledgeBase, errReporter)
+	listener.Grl = ast.NewGrl()
+	whenScope := ast.NewWhenScope()
+	listener.Stack.Push(whenScope)
+	antlr.ParseTreeWalkerDefault.Walk(listener, psr.Expression())
+	if stream.LA(1) != antlr.TokenEOF {
+		errReporter.AddError(fmt.Errorf("unexpected %s after the condition", stream.LT(1).GetText()))
+	}
+	if errReporter.HasError() {
+
+		return nil, errReporter
+	}
+	if whenScope.Expression == nil {
+
+		return nil, fmt.Errorf("the condition is not an expression")
+	}
+
+	return whenScope.Expression, nil
+}
@@ -0,0 +1,121 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"time"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+)
+
+// agenda keeps the activations of a knowledge base between cycles.
+// The activations come out of the knowledge base's RETE network: only the rule entries whose terminal node
+// is reached by a change in the facts are updated, the other activations stay on the agenda.
+type agenda struct {
+	network     *ast.ReteNetwork
+	activations map[*ast.RuleEntry]*Activation
+	// fired is the rule entry executed in the last cycle, a no-loop rule entry is not activated by its own changes.
+	fired *ast.RuleEntry
+	// locked are the executed lock-on-active rule entries, they are not activated until their agenda group loses the focus.
+	locked map[*ast.RuleEntry]bool
+	// focus is the agenda group that had the focus in the last cycle.
+	focus string
+	// filters select the rule entries that can be activated.
+	filters []RuleFilter
+}
+
+// newAgenda creates an agenda fed by the RETE network of the knowledge base.
+// The network listens to the fact changes of the data context until the agenda is closed.
+// The rule entries not selected by the filters are never activated.
+func newAgenda(knowledge *ast.KnowledgeBase, dataCtx ast.IDataContext, filters []RuleFilter) *agenda {
+	network := knowledge.GetReteNetwork()
+	network.Attach(dataCtx)
+
+	return &agenda{
+		network:     network,
+		activations: make(map[*ast.RuleEntry]*Activation),
+		locked:      make(map[*ast.RuleEntry]bool),
+		focus:       ast.MainAgendaGroup,
+		filters:     filters,
+	}
+}
+
+// close stops listening to the fact changes of the data context.
+func (a *agenda) close() {
+	a.network.Detach()
+}
+
+// propagate flows the fact changes since the last call through the network and returns the terminal nodes
+// reached by them, so the caller can update their activations.
+func (a *agenda) propagate() []*ast.TerminalNode {
+
+	return a.network.Propagate()
+}
+
+// update records the outcome of a rule entry evaluation in the given cycle.
+func (a *agenda) update(ruleEntry *ast.RuleEntry, can bool, cycle uint64) {
+	if !can {
+		delete(a.activations, ruleEntry)
+
+		return
+	}
+	if a.locked[ruleEntry] || (ruleEntry == a.fired && ruleEntry.NoLoop) || !accepts(a.filters, ruleEntry) {
+
+		return
+	}
+	a.activations[ruleEntry] = &Activation{
+		RuleEntry: ruleEntry,
+		Cycle:     cycle,
+	}
+}
+
+// executed records the rule entry executed in the current cycle. The activation of a no-loop or
+// lock-on-active rule entry is consumed by its execution, the other ones stay until their when scope is not satisfied anymore.
+func (a *agenda) executed(ruleEntry *ast.RuleEntry) {
+	a.fired = ruleEntry
+	if ruleEntry.NoLoop || ruleEntry.LockOnActive {
+		delete(a.activations, ruleEntry)
+	}
+	if ruleEntry.LockOnActive {
+		a.locked[ruleEntry] = true
+	}
+}
+
+// focused releases the locked rule entries of the agenda groups that lost the focus.
+func (a *agenda) focused(agendaGroup string) {
+	if agendaGroup == a.focus {
+
+		return
+	}
+	a.focus = agendaGroup
+	for ruleEntry := range a.locked {
+		if ruleEntry.GetAgendaGroup() != agendaGroup {
+			delete(a.locked, ruleEntry)
+		}
+	}
+}
+
+// runnable returns the activations of the agenda group's rule entries that are neither retracted nor deleted,
+// and are active at the given instant.
+func (a *agenda) runnable(agendaGroup string, now time.Time) []*Activation {
+	runnable := make([]*Activation, 0, len(a.activations))
+	for ruleEntry, activation := range a.activations {
+		if !ruleEntry.Retracted && !ruleEntry.Deleted && ruleEntry.GetAgendaGroup() == agendaGroup && ruleEntry.IsActiveAt(now) {
+			runnable = append(runnable, activation)
+		}
+	}
+
+	return runnable
+}
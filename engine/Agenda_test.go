@@ -0,0 +1,255 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/builder"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type AgendaFact struct {
+	Counter int
+	Other   int
+	Done    bool
+}
+
+const agendaRules = `
+rule Count "increments the counter until it reaches 3" {
+	when
+		Fact.Counter < 3
+	then
+		Fact.Counter = Fact.Counter + 1;
+}
+
+rule Finish "runs once the counter reached 3" {
+	when
+		Fact.Counter == 3 && Fact.Done == false
+	then
+		Fact.Done = true;
+}
+
+rule Unrelated "never fires and does not depend on the counter" {
+	when
+		Fact.Other > 0
+	then
+		Fact.Other = 0;
+}
+`
+
+type evaluationCounter struct {
+	evaluations map[string]int
+}
+
+func (l *evaluationCounter) EvaluateRuleEntry(cycle uint64, entry *ast.RuleEntry, candidate bool) {
+	l.evaluations[entry.RuleName]++
+}
+
+func (l *evaluationCounter) ExecuteRuleEntry(cycle uint64, entry *ast.RuleEntry) {}
+
+func (l *evaluationCounter) BeginCycle(cycle uint64) {}
+
+func TestAgenda_OnlyDependentRulesAreReEvaluated(t *testing.T) {
+	fact := &AgendaFact{}
+	dctx := ast.NewDataContext()
+	err := dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err = rb.BuildRuleFromResource("AgendaTest", "0.1.1", pkg.NewBytesResource([]byte(agendaRules)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("AgendaTest", "0.1.1")
+	assert.NoError(t, err)
+
+	counter := &evaluationCounter{evaluations: make(map[string]int)}
+	engine := NewGruleEngine()
+	engine.Listeners = append(engine.Listeners, counter)
+	err = engine.Execute(dctx, kb)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, fact.Counter)
+	assert.True(t, fact.Done)
+	// once initially, then after each of the 3 counter increments.
+	assert.Equal(t, 4, counter.evaluations["Count"])
+	// once initially, when the counter reaches 3 and after Done is set.
+	// The increments that keep Fact.Counter == 3 false stop in the network.
+	assert.Equal(t, 3, counter.evaluations["Finish"])
+	// the counter and done flag are not part of its when scope.
+	assert.Equal(t, 1, counter.evaluations["Unrelated"])
+}
+
+type ReteOrder struct {
+	Amount int
+	Paid   bool
+}
+
+type ReteCustomer struct {
+	Limit int
+	Vip   bool
+}
+
+const reteRules = `
+rule SmallOrder "small unpaid order" {
+	when
+		Order.Paid == false && Order.Amount < 100
+	then
+		Retract("SmallOrder");
+}
+
+rule VipSmallOrder "small unpaid order of a vip customer" {
+	when
+		Order.Paid == false && Order.Amount < 100 && Customer.Vip
+	then
+		Retract("VipSmallOrder");
+}
+
+rule OverLimit "order above the customer limit" {
+	when
+		Order.Paid == false && Order.Amount > Customer.Limit
+	then
+		Retract("OverLimit");
+}
+`
+
+func TestReteNetwork_SharingAndFactChanges(t *testing.T) {
+	dctx := ast.NewDataContext()
+	err := dctx.Add("Order", &ReteOrder{Amount: 50})
+	assert.NoError(t, err)
+	err = dctx.Add("Customer", &ReteCustomer{Limit: 10, Vip: true})
+	assert.NoError(t, err)
+
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err = rb.BuildRuleFromResource("ReteTest", "0.1.1", pkg.NewBytesResource([]byte(reteRules)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("ReteTest", "0.1.1")
+	assert.NoError(t, err)
+	kb.WorkingMemory.ResetAll()
+
+	network := ast.NewReteNetwork(kb)
+	// Order.Paid == false, Order.Amount < 100 and Customer.Vip
+	assert.Equal(t, 3, network.AlphaNodeCount())
+	// the join of the first two conditions is shared, plus the join with Customer.Vip,
+	// the Order.Amount > Customer.Limit test and its join.
+	assert.Equal(t, 4, network.BetaNodeCount())
+	assert.Equal(t, 3, len(network.TerminalNodes()))
+
+	satisfied := func(terminals []*ast.TerminalNode) map[string]bool {
+		ret := make(map[string]bool)
+		for _, terminal := range terminals {
+			assert.NoError(t, terminal.Err())
+			ret[terminal.RuleEntry.RuleName] = terminal.Satisfied()
+		}
+
+		return ret
+	}
+
+	network.Attach(dctx)
+	defer network.Detach()
+	assert.Equal(t, map[string]bool{"SmallOrder": true, "VipSmallOrder": true, "OverLimit": true}, satisfied(network.Propagate()))
+	// nothing changed, nothing flows.
+	assert.Equal(t, 0, len(network.Propagate()))
+
+	// retracting the customer only reaches the rules joining it.
+	dctx.Retract("Customer")
+	assert.Equal(t, map[string]bool{"VipSmallOrder": false, "OverLimit": false}, satisfied(network.Propagate()))
+
+	// the customer comes back with a higher limit, OverLimit stays unsatisfied so nothing flows to it.
+	dctx.Reset()
+	err = dctx.Add("Customer", &ReteCustomer{Limit: 1000, Vip: true})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"VipSmallOrder": true}, satisfied(network.Propagate()))
+
+	// a paid order flows to the rules it satisfied.
+	err = dctx.Add("Order", &ReteOrder{Amount: 50, Paid: true})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"SmallOrder": false, "VipSmallOrder": false}, satisfied(network.Propagate()))
+}
+
+// unobservableDataContext hides the listener methods of the data context it wraps.
+type unobservableDataContext struct {
+	ast.IDataContext
+}
+
+func TestAgenda_UnobservableDataContext(t *testing.T) {
+	fact := &AgendaFact{}
+	dctx := &unobservableDataContext{IDataContext: ast.NewDataContext()}
+	err := dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+	_, observable := interface{}(dctx).(ast.ObservableDataContext)
+	assert.False(t, observable)
+
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err = rb.BuildRuleFromResource("AgendaTest", "0.1.1", pkg.NewBytesResource([]byte(agendaRules)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("AgendaTest", "0.1.1")
+	assert.NoError(t, err)
+
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, fact.Counter)
+	assert.True(t, fact.Done)
+}
+
+func TestReteNetwork_CachedPerKnowledgeBase(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("ReteTest", "0.1.1", pkg.NewBytesResource([]byte(reteRules)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("ReteTest", "0.1.1")
+	assert.NoError(t, err)
+
+	network := kb.GetReteNetwork()
+	for _, tc := range []struct {
+		order    *ReteOrder
+		executed []string
+	}{
+		{&ReteOrder{Amount: 50}, []string{"SmallOrder"}},
+		{&ReteOrder{Amount: 50, Paid: true}, nil},
+		{&ReteOrder{Amount: 500}, []string{"OverLimit"}},
+	} {
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Order", tc.order))
+		assert.NoError(t, dctx.Add("Customer", &ReteCustomer{Limit: 100}))
+		recorder := &executionRecorder{}
+		engine := NewGruleEngine()
+		engine.Listeners = append(engine.Listeners, recorder)
+		err = engine.Execute(dctx, kb)
+		assert.NoError(t, err)
+		// the network states left by the previous execution are forgotten.
+		assert.Equal(t, tc.executed, recorder.executed)
+		assert.Same(t, network, kb.GetReteNetwork())
+	}
+
+	// a when scope failing again in the next execution is reported again.
+	for i := 0; i < 2; i++ {
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Order", &ReteOrder{Amount: 50}))
+		engine := NewGruleEngine()
+		engine.ReturnErrOnFailedRuleEvaluation = true
+		err = engine.Execute(dctx, kb)
+		assert.Error(t, err)
+	}
+
+	// removing a rule entry compiles the network again.
+	kb.RemoveRuleEntry("OverLimit")
+	assert.NotSame(t, network, kb.GetReteNetwork())
+	assert.Equal(t, 2, len(kb.GetReteNetwork().TerminalNodes()))
+}
@@ -0,0 +1,205 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type CartItem struct {
+	Amount  float64
+	Qty     int
+	Taxable bool
+}
+
+type Cart struct {
+	Items    []*CartItem
+	Taxable  float64
+	Average  float64
+	Cheapest float64
+	MaxQty   int
+	Units    int
+	Big      bool
+	Done     bool
+}
+
+const aggregateRules = `
+rule Totals "computes the cart totals" {
+	when
+		!Cart.Done
+	then
+		Cart.Taxable = sum(i.Amount for i in Cart.Items if i.Taxable);
+		Cart.Average = avg(i.Amount for i in Cart.Items);
+		Cart.Cheapest = min(i.Amount for i in Cart.Items);
+		Cart.MaxQty = MAX(i.Qty for i in Cart.Items);
+		Cart.Units = sum(i.Qty for i in Cart.Items);
+		Cart.Done = true;
+}
+
+rule Big "the taxable amount is big" {
+	when
+		sum(i.Amount * i.Qty for i in Cart.Items if i.Taxable) > 100 && !Cart.Big
+	then
+		Cart.Big = true;
+}
+`
+
+func TestAggregate_GoValues(t *testing.T) {
+	kb, err := newKnowledgeBase(t, aggregateRules)
+	assert.NoError(t, err)
+
+	cart := &Cart{Items: []*CartItem{
+		{Amount: 10, Qty: 2, Taxable: true},
+		{Amount: 30.5, Qty: 1, Taxable: false},
+		{Amount: 45, Qty: 3, Taxable: true},
+	}}
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Cart", cart)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, 55.0, cart.Taxable)
+	assert.Equal(t, 28.5, cart.Average)
+	assert.Equal(t, 10.0, cart.Cheapest)
+	assert.Equal(t, 3, cart.MaxQty)
+	assert.Equal(t, 6, cart.Units)
+	assert.True(t, cart.Big)
+}
+
+func TestAggregate_ElementChange(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule Raise "raises the first item" {
+	when
+		Cart.Items[0].Amount < 100
+	then
+		Cart.Items[0].Amount = 100;
+}
+
+rule Big "the total is big" {
+	when
+		sum(i.Amount for i in Cart.Items) > 100 && !Cart.Big
+	then
+		Cart.Big = true;
+}`)
+	assert.NoError(t, err)
+	cart := &Cart{Items: []*CartItem{{Amount: 1}, {Amount: 20}}}
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Cart", cart)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	// the change of an element re-evaluated the aggregate.
+	assert.True(t, cart.Big)
+}
+
+func TestAggregate_Empty(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule Sum "sums nothing" {
+	when
+		!Cart.Done
+	then
+		Cart.Units = sum(i.Qty for i in Cart.Items if i.Taxable);
+		Cart.Done = true;
+}`)
+	assert.NoError(t, err)
+	cart := &Cart{Items: []*CartItem{{Qty: 4}}, Units: 7}
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Cart", cart)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, cart.Units)
+
+	kb, err = newKnowledgeBase(t, `
+rule Max "max of nothing" {
+	when
+		!Cart.Done
+	then
+		Cart.MaxQty = max(i.Qty for i in Cart.Items);
+		Cart.Done = true;
+}`)
+	assert.NoError(t, err)
+	dctx = ast.NewDataContext()
+	err = dctx.Add("Cart", &Cart{Items: []*CartItem{}})
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.Error(t, err)
+}
+
+func TestAggregate_Errors(t *testing.T) {
+	_, err := newKnowledgeBase(t, `
+rule Unknown "not an aggregate" {
+	when
+		median(i.Amount for i in Cart.Items) > 1
+	then
+		Retract("Unknown");
+}`)
+	assert.Error(t, err)
+
+	for _, expr := range []string{
+		`sum(i.Taxable for i in Cart.Items)`,
+		`sum(i.Amount for i in Cart.Items if i.Qty)`,
+	} {
+		kb, err := newKnowledgeBase(t, `
+rule NotNumber "invalid aggregate" {
+	when
+		!Cart.Done
+	then
+		Cart.Taxable = `+expr+`;
+		Cart.Done = true;
+}`)
+		assert.NoError(t, err)
+		dctx := ast.NewDataContext()
+		err = dctx.Add("Cart", &Cart{Items: []*CartItem{{Amount: 1, Qty: 1}}})
+		assert.NoError(t, err)
+		err = NewGruleEngine().Execute(dctx, kb)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestAggregate_SerializationAndClone(t *testing.T) {
+	kb, err := newKnowledgeBase(t, aggregateRules)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+
+	for _, knowledge := range []*ast.KnowledgeBase{loadedKb, clone} {
+		cart := &Cart{Items: []*CartItem{{Amount: 60, Qty: 2, Taxable: true}}}
+		dctx := ast.NewDataContext()
+		err = dctx.Add("Cart", cart)
+		assert.NoError(t, err)
+		err = NewGruleEngine().Execute(dctx, knowledge)
+		assert.NoError(t, err)
+		assert.Equal(t, 60.0, cart.Taxable)
+		assert.Equal(t, 2, cart.Units)
+		assert.True(t, cart.Big)
+	}
+}
@@ -0,0 +1,153 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type Ledger struct {
+	A         int
+	B         int
+	Rate      float64
+	Negated   int
+	Balance   float64
+	Square    int
+	Tower     int
+	Signed    int
+	Root      float64
+	Inverse   float64
+	Quotient  int
+	Truncated int
+	Pages     int
+	Done      bool
+}
+
+const arithmeticOperatorRules = `
+rule Compute "uses the unary minus, exponent and integer division operators" {
+	when
+		!Ledger.Done
+	then
+		Ledger.Negated = -(Ledger.A + Ledger.B);
+		Ledger.Balance = -Ledger.Rate * 2;
+		Ledger.Square = Ledger.A ** 2;
+		Ledger.Tower = 2 ** 3 ** 2;
+		Ledger.Signed = -2 ** 2;
+		Ledger.Root = Ledger.B ** 0.5;
+		Ledger.Inverse = 2 ** -1;
+		Ledger.Quotient = Ledger.A ~/ Ledger.B;
+		Ledger.Truncated = -Ledger.A ~/ 2;
+		Ledger.Pages = (Ledger.A + Ledger.B - 1) ~/ Ledger.B + Ledger.A % Ledger.B * 0;
+		Ledger.Done = true;
+}
+`
+
+func TestArithmeticOperator_Evaluation(t *testing.T) {
+	kb, err := newKnowledgeBase(t, arithmeticOperatorRules)
+	assert.NoError(t, err)
+
+	ledger := &Ledger{A: 7, B: 4, Rate: 1.5}
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Ledger", ledger))
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, -11, ledger.Negated)
+	assert.Equal(t, -3.0, ledger.Balance)
+	assert.Equal(t, 49, ledger.Square)
+	assert.Equal(t, 512, ledger.Tower)
+	assert.Equal(t, -4, ledger.Signed)
+	assert.Equal(t, 2.0, ledger.Root)
+	assert.Equal(t, 0.5, ledger.Inverse)
+	assert.Equal(t, 1, ledger.Quotient)
+	assert.Equal(t, -3, ledger.Truncated)
+	assert.Equal(t, 2, ledger.Pages)
+}
+
+func TestArithmeticOperator_WhenScope(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule Negative "matches a negative sum" {
+	when
+		-(Ledger.A + Ledger.B) < -10 && Ledger.A ** 2 > 40 && Ledger.A ~/ Ledger.B == 1 && !Ledger.Done
+	then
+		Ledger.Done = true;
+}`)
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		ledger *Ledger
+		done   bool
+	}{
+		{&Ledger{A: 7, B: 4}, true},
+		{&Ledger{A: 6, B: 4}, false},
+	} {
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Ledger", tc.ledger))
+		engine := NewGruleEngine()
+		engine.ReturnErrOnFailedRuleEvaluation = true
+		err = engine.Execute(dctx, kb)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.done, tc.ledger.Done)
+	}
+}
+
+func TestArithmeticOperator_Errors(t *testing.T) {
+	for _, grl := range []string{
+		`rule Zero "divides by zero" { when !Ledger.Done then Ledger.Quotient = Ledger.A ~/ 0; Ledger.Done = true; }`,
+		`rule String "negates a string" { when !Ledger.Done then Ledger.Negated = -"one"; Ledger.Done = true; }`,
+		`rule String "raises a string" { when !Ledger.Done then Ledger.Square = "one" ** 2; Ledger.Done = true; }`,
+	} {
+		kb, err := newKnowledgeBase(t, grl)
+		assert.NoError(t, err)
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Ledger", &Ledger{A: 7, B: 4}))
+		err = NewGruleEngine().Execute(dctx, kb)
+		assert.Error(t, err, grl)
+	}
+}
+
+func TestArithmeticOperator_SerializationAndClone(t *testing.T) {
+	kb, err := newKnowledgeBase(t, arithmeticOperatorRules)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	assert.True(t, kb.IsIdentical(loadedKb))
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+
+	for _, knowledge := range []*ast.KnowledgeBase{loadedKb, clone} {
+		ledger := &Ledger{A: 7, B: 4, Rate: 1.5}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Ledger", ledger))
+		err = NewGruleEngine().Execute(dctx, knowledge)
+		assert.NoError(t, err)
+		assert.Equal(t, -11, ledger.Negated)
+		assert.Equal(t, -4, ledger.Signed)
+		assert.Equal(t, -3, ledger.Truncated)
+	}
+}
@@ -0,0 +1,137 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type LiteralFact struct {
+	Names   []string
+	Codes   []int
+	Ratios  []float64
+	Limits  map[string]int
+	Mixed   []interface{}
+	Matched bool
+	Count   int
+	Done    bool
+}
+
+func (f *LiteralFact) Has(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+
+			return true
+		}
+	}
+
+	return false
+}
+
+const collectionLiteralRules = `
+rule Literals "assigns collection literals" {
+	when
+		!Fact.Done
+	then
+		let codes = [1, 2, 3];
+		Fact.Names = ["a", "b"];
+		Fact.Codes = codes;
+		Fact.Ratios = [1, 2.5];
+		Fact.Limits = {"low": 1, "high": 10};
+		Fact.Mixed = [1, "two", nil, [3]];
+		Fact.Matched = Fact.Has(["x", "y"], "y");
+		Fact.Count = codes.Len() + codes[2] + {"a": 1}["a"];
+		Fact.Done = true;
+}
+`
+
+func TestCollectionLiteral_Values(t *testing.T) {
+	kb, err := newKnowledgeBase(t, collectionLiteralRules)
+	assert.NoError(t, err)
+	fact := &LiteralFact{}
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, fact.Names)
+	assert.Equal(t, []int{1, 2, 3}, fact.Codes)
+	assert.Equal(t, []float64{1, 2.5}, fact.Ratios)
+	assert.Equal(t, map[string]int{"low": 1, "high": 10}, fact.Limits)
+	assert.Equal(t, []interface{}{int64(1), "two", nil, []int64{3}}, fact.Mixed)
+	assert.True(t, fact.Matched)
+	assert.Equal(t, 7, fact.Count)
+}
+
+func TestCollectionLiteral_Errors(t *testing.T) {
+	_, err := newKnowledgeBase(t, `
+rule ListKey "a list is not a map key" {
+	when
+		true
+	then
+		Fact.Limits = {[1]: 1};
+}`)
+	assert.Error(t, err)
+
+	kb, err := newKnowledgeBase(t, `
+rule Mismatch "strings are not integers" {
+	when
+		!Fact.Done
+	then
+		Fact.Codes = ["a"];
+		Fact.Done = true;
+}`)
+	assert.NoError(t, err)
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", &LiteralFact{})
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.Error(t, err)
+}
+
+func TestCollectionLiteral_Serialization(t *testing.T) {
+	kb, err := newKnowledgeBase(t, collectionLiteralRules)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+
+	for _, knowledge := range []*ast.KnowledgeBase{loadedKb, clone} {
+		fact := &LiteralFact{}
+		dctx := ast.NewDataContext()
+		err = dctx.Add("Fact", fact)
+		assert.NoError(t, err)
+		err = NewGruleEngine().Execute(dctx, knowledge)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, fact.Codes)
+		assert.Equal(t, map[string]int{"low": 1, "high": 10}, fact.Limits)
+		assert.Equal(t, []interface{}{int64(1), "two", nil, []int64{3}}, fact.Mixed)
+	}
+}
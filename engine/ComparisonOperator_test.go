@@ -0,0 +1,192 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type Applicant struct {
+	Tier      string
+	Country   string
+	Age       int
+	Score     float64
+	Code      string
+	Pattern   string
+	Born      time.Time
+	Blocked   []string
+	Eligible  bool
+	Adult     bool
+	ValidCode bool
+	Custom    bool
+	Done      bool
+}
+
+const comparisonOperatorRules = `
+rule Eligible "tier and country are allowed" {
+	when
+		Applicant.Tier in ["gold", "silver"] && Applicant.Country not in Applicant.Blocked &&
+		Applicant.Score between 0.5 and 1 + 0 && !Applicant.Eligible
+	then
+		Applicant.Eligible = true;
+}
+
+rule Adult "born between two dates" {
+	when
+		Applicant.Age BETWEEN 18 AND 130 &&
+		Applicant.Born between MakeTime(1900, 1, 1, 0, 0, 0) and Now() && !Applicant.Adult
+	then
+		Applicant.Adult = true;
+}
+
+rule Codes "validates the code" {
+	when
+		!Applicant.Done
+	then
+		Applicant.ValidCode = Applicant.Code matches "^[A-Z]{2}-\\d+$";
+		Applicant.Custom = Applicant.Code matches Applicant.Pattern;
+		Applicant.Done = true;
+}
+`
+
+func TestComparisonOperator_Evaluation(t *testing.T) {
+	kb, err := newKnowledgeBase(t, comparisonOperatorRules)
+	assert.NoError(t, err)
+
+	applicant := &Applicant{
+		Tier: "gold", Country: "NL", Age: 30, Score: 0.7, Code: "AB-12", Pattern: "^AB",
+		Born: time.Date(1990, 5, 1, 0, 0, 0, 0, time.Local), Blocked: []string{"XX", "YY"},
+	}
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Applicant", applicant)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.True(t, applicant.Eligible)
+	assert.True(t, applicant.Adult)
+	assert.True(t, applicant.ValidCode)
+	assert.True(t, applicant.Custom)
+
+	rejected := &Applicant{
+		Tier: "bronze", Country: "NL", Age: 12, Score: 0.7, Code: "ab-12", Pattern: "^X",
+		Born: time.Now(), Blocked: []string{"NL"},
+	}
+	dctx = ast.NewDataContext()
+	err = dctx.Add("Applicant", rejected)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.False(t, rejected.Eligible)
+	assert.False(t, rejected.Adult)
+	assert.False(t, rejected.ValidCode)
+	assert.False(t, rejected.Custom)
+}
+
+func TestComparisonOperator_JSON(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule Allowed "the tier is allowed" {
+	when
+		Order.Tier in Config.Tiers && Order.Amount between Config.Min and Config.Max && !Order.Allowed
+	then
+		Order.Allowed = true;
+}`)
+	assert.NoError(t, err)
+	dctx := ast.NewDataContext()
+	err = dctx.AddJSON("Config", []byte(`{"Tiers": ["gold", "silver"], "Min": 10, "Max": 100}`))
+	assert.NoError(t, err)
+	order := &struct {
+		Tier    string
+		Amount  int
+		Allowed bool
+	}{Tier: "silver", Amount: 50}
+	err = dctx.Add("Order", order)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.True(t, order.Allowed)
+}
+
+func TestComparisonOperator_Errors(t *testing.T) {
+	_, err := newKnowledgeBase(t, `
+rule BadPattern "the pattern does not compile" {
+	when
+		Applicant.Code matches "[a-"
+	then
+		Retract("BadPattern");
+}`)
+	assert.Error(t, err)
+
+	for _, expr := range []string{
+		`Applicant.Tier in "gold"`,
+		`Applicant.Tier between "a" and "z"`,
+		`Applicant.Age matches "1"`,
+		`Applicant.Code matches Applicant.Age`,
+	} {
+		kb, err := newKnowledgeBase(t, `
+rule Invalid "invalid operands" {
+	when
+		!Applicant.Done
+	then
+		Applicant.Eligible = `+expr+`;
+		Applicant.Done = true;
+}`)
+		assert.NoError(t, err)
+		dctx := ast.NewDataContext()
+		err = dctx.Add("Applicant", &Applicant{Tier: "gold", Code: "AB"})
+		assert.NoError(t, err)
+		err = NewGruleEngine().Execute(dctx, kb)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestComparisonOperator_SerializationAndClone(t *testing.T) {
+	kb, err := newKnowledgeBase(t, comparisonOperatorRules)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+
+	for _, knowledge := range []*ast.KnowledgeBase{loadedKb, clone} {
+		applicant := &Applicant{
+			Tier: "silver", Country: "NL", Age: 40, Score: 1, Code: "XY-1", Pattern: "1$",
+			Born: time.Date(1980, 1, 1, 0, 0, 0, 0, time.Local),
+		}
+		dctx := ast.NewDataContext()
+		err = dctx.Add("Applicant", applicant)
+		assert.NoError(t, err)
+		err = NewGruleEngine().Execute(dctx, knowledge)
+		assert.NoError(t, err)
+		assert.True(t, applicant.Eligible)
+		assert.True(t, applicant.Adult)
+		assert.True(t, applicant.ValidCode)
+		assert.True(t, applicant.Custom)
+	}
+}
@@ -0,0 +1,131 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type ShippingAddress struct {
+	City string
+}
+
+type Shipment struct {
+	Address  *ShippingAddress
+	Weight   int
+	Express  bool
+	City     string
+	Cost     float64
+	Category string
+	Heavy    bool
+	Done     bool
+}
+
+const conditionalRules = `
+rule Ship "computes the shipment" {
+	when
+		!Shipment.Done
+	then
+		Shipment.City = Shipment.Address != nil ? Shipment.Address.City : "unknown";
+		Shipment.Cost = Shipment.Express ? Shipment.Weight * 2.5 : Shipment.Weight * 1.5 + 1;
+		Shipment.Category = Shipment.Weight > 100 ? "freight" : Shipment.Weight > 10 ? "parcel" : "letter";
+		Shipment.Done = true;
+}
+
+rule Heavy "conditional in the when scope" {
+	when
+		(Shipment.Express ? Shipment.Weight > 5 : Shipment.Weight > 50) && !Shipment.Heavy
+	then
+		Shipment.Heavy = true;
+}
+`
+
+func TestConditionalExpression_Evaluation(t *testing.T) {
+	kb, err := newKnowledgeBase(t, conditionalRules)
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		shipment *Shipment
+		city     string
+		cost     float64
+		category string
+		heavy    bool
+	}{
+		{&Shipment{Address: &ShippingAddress{City: "Delft"}, Weight: 8, Express: true}, "Delft", 20, "letter", true},
+		{&Shipment{Weight: 20}, "unknown", 31, "parcel", false},
+		{&Shipment{Weight: 200}, "unknown", 301, "freight", true},
+	} {
+		dctx := ast.NewDataContext()
+		err = dctx.Add("Shipment", tc.shipment)
+		assert.NoError(t, err)
+		err = NewGruleEngine().Execute(dctx, kb)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.city, tc.shipment.City)
+		assert.Equal(t, tc.cost, tc.shipment.Cost)
+		assert.Equal(t, tc.category, tc.shipment.Category)
+		assert.Equal(t, tc.heavy, tc.shipment.Heavy)
+	}
+}
+
+func TestConditionalExpression_NotBool(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule NotBool "the condition is not a boolean" {
+	when
+		!Shipment.Done
+	then
+		Shipment.City = Shipment.Weight ? "a" : "b";
+		Shipment.Done = true;
+}`)
+	assert.NoError(t, err)
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Shipment", &Shipment{Weight: 1})
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.Error(t, err)
+}
+
+func TestConditionalExpression_SerializationAndClone(t *testing.T) {
+	kb, err := newKnowledgeBase(t, conditionalRules)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+
+	for _, knowledge := range []*ast.KnowledgeBase{loadedKb, clone} {
+		shipment := &Shipment{Weight: 20}
+		dctx := ast.NewDataContext()
+		err = dctx.Add("Shipment", shipment)
+		assert.NoError(t, err)
+		err = NewGruleEngine().Execute(dctx, knowledge)
+		assert.NoError(t, err)
+		assert.Equal(t, "unknown", shipment.City)
+		assert.Equal(t, "parcel", shipment.Category)
+	}
+}
@@ -0,0 +1,223 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+)
+
+// Activation is a rule entry whose when scope is satisfied in the current cycle.
+type Activation struct {
+	RuleEntry *ast.RuleEntry
+	// Cycle is the most recent cycle in which this rule entry became a candidate
+	// or got its when scope re-evaluated due to a change in the facts it depends on.
+	Cycle uint64
+}
+
+// ConflictResolver selects which activation the engine should execute when more than one rule
+// entry is runnable in a cycle.
+type ConflictResolver interface {
+	// Resolve returns the activation to execute. The activations slice is never empty.
+	Resolve(activations []*Activation) *Activation
+}
+
+// ConflictStrategy compares two activations. It returns a negative number if a should fire before b,
+// a positive number if b should fire before a, and zero if the strategy has no preference.
+type ConflictStrategy func(a, b *Activation) int
+
+// SalienceStrategy prefers the activation with the highest salience.
+func SalienceStrategy(a, b *Activation) int {
+
+	return compareInt(b.RuleEntry.Salience, a.RuleEntry.Salience)
+}
+
+// FIFOStrategy prefers the rule entry declared first.
+func FIFOStrategy(a, b *Activation) int {
+
+	return compareInt(a.RuleEntry.Sequence, b.RuleEntry.Sequence)
+}
+
+// LIFOStrategy prefers the rule entry declared last.
+func LIFOStrategy(a, b *Activation) int {
+
+	return compareInt(b.RuleEntry.Sequence, a.RuleEntry.Sequence)
+}
+
+// RecencyStrategy prefers the activation whose facts changed most recently.
+func RecencyStrategy(a, b *Activation) int {
+	switch {
+	case a.Cycle > b.Cycle:
+
+		return -1
+	case a.Cycle < b.Cycle:
+
+		return 1
+	}
+
+	return 0
+}
+
+// SpecificityStrategy prefers the rule entry with more conditions in its when scope.
+func SpecificityStrategy(a, b *Activation) int {
+
+	return compareInt(conditionCount(b.RuleEntry), conditionCount(a.RuleEntry))
+}
+
+// compareInt returns -1 if a is lesser than b, 1 if it is greater, and 0 if they are equal.
+// Unlike a subtraction, it does not overflow for extreme values such as a salience of math.MinInt.
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+
+		return -1
+	case a > b:
+
+		return 1
+	}
+
+	return 0
+}
+
+// conditionCount counts the conditions joined by logical operators in the rule entry's when scope.
+func conditionCount(entry *ast.RuleEntry) int {
+	if entry.WhenScope == nil {
+
+		return 0
+	}
+
+	return expressionConditionCount(entry.WhenScope.Expression)
+}
+
+func expressionConditionCount(expr *ast.Expression) int {
+	switch {
+	case expr == nil:
+
+		return 0
+	case expr.SingleExpression != nil:
+
+		return expressionConditionCount(expr.SingleExpression)
+	case expr.LeftExpression != nil && expr.RightExpression != nil && (expr.Operator == ast.OpAnd || expr.Operator == ast.OpOr):
+
+		return expressionConditionCount(expr.LeftExpression) + expressionConditionCount(expr.RightExpression)
+	}
+
+	return 1
+}
+
+// compareDeclaration is the final tie breaker, so that the resolution never depends on
+// the iteration order of the knowledge base's rule entry map.
+func compareDeclaration(a, b *Activation) int {
+	if c := FIFOStrategy(a, b); c != 0 {
+
+		return c
+	}
+
+	return strings.Compare(a.RuleEntry.RuleName, b.RuleEntry.RuleName)
+}
+
+// NewConflictResolver creates a ConflictResolver that applies the strategies in order,
+// consulting the next strategy only when the previous ones consider two activations equal.
+// Remaining ties are broken by declaration order.
+func NewConflictResolver(strategies ...ConflictStrategy) ConflictResolver {
+
+	return &strategyResolver{
+		strategies: strategies,
+	}
+}
+
+// NewRandomConflictResolver creates a ConflictResolver that applies the strategies in order
+// and picks randomly among the activations they consider equally preferable.
+// Runs using the same seed over the same facts are reproducible. The resolver may be shared by engines
+// executing concurrently, their draws are then interleaved.
+func NewRandomConflictResolver(seed int64, strategies ...ConflictStrategy) ConflictResolver {
+
+	return &randomResolver{
+		strategyResolver: strategyResolver{
+			strategies: strategies,
+		},
+		random: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// DefaultConflictResolver fires the rule entry with the highest salience, and the one declared first among equals.
+var DefaultConflictResolver = NewConflictResolver(SalienceStrategy, FIFOStrategy)
+
+type strategyResolver struct {
+	strategies []ConflictStrategy
+}
+
+func (resolver *strategyResolver) compare(a, b *Activation) int {
+	for _, strategy := range resolver.strategies {
+		if c := strategy(a, b); c != 0 {
+
+			return c
+		}
+	}
+
+	return 0
+}
+
+// Resolve returns the most preferable activation.
+func (resolver *strategyResolver) Resolve(activations []*Activation) *Activation {
+	selected := activations[0]
+	for _, activation := range activations[1:] {
+		c := resolver.compare(activation, selected)
+		if c < 0 || (c == 0 && compareDeclaration(activation, selected) < 0) {
+			selected = activation
+		}
+	}
+
+	return selected
+}
+
+type randomResolver struct {
+	strategyResolver
+	// lock guards random, a rand.Rand is not safe for concurrent use.
+	lock   sync.Mutex
+	random *rand.Rand
+}
+
+// Resolve returns a random activation among the most preferable ones.
+func (resolver *randomResolver) Resolve(activations []*Activation) *Activation {
+	top := make([]*Activation, 0, len(activations))
+	for _, activation := range activations {
+		if len(top) == 0 {
+			top = append(top, activation)
+
+			continue
+		}
+		c := resolver.compare(activation, top[0])
+		if c < 0 {
+			top = top[:0]
+		}
+		if c <= 0 {
+			top = append(top, activation)
+		}
+	}
+	sort.Slice(top, func(i, j int) bool {
+
+		return compareDeclaration(top[i], top[j]) < 0
+	})
+
+	resolver.lock.Lock()
+	defer resolver.lock.Unlock()
+
+	return top[resolver.random.Intn(len(top))]
+}
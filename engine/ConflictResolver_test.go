@@ -0,0 +1,159 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/builder"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type ConflictFact struct {
+	X     int
+	Y     int
+	Fired string
+}
+
+const conflictRules = `
+rule RuleA "first declared, enables RuleB" {
+	when
+		Fact.X == 0
+	then
+		Fact.X = 1;
+		Fact.Fired = Fact.Fired + "A";
+		Retract("RuleA");
+}
+
+rule RuleC "second declared, has the most conditions" {
+	when
+		Fact.Y == 0 && Fact.Y < 10 && Fact.Y > -10
+	then
+		Fact.Fired = Fact.Fired + "C";
+		Retract("RuleC");
+}
+
+rule RuleB "third declared, activated by RuleA" {
+	when
+		Fact.X == 1
+	then
+		Fact.Fired = Fact.Fired + "B";
+		Retract("RuleB");
+}
+
+rule RuleD "fourth declared, lower salience" salience -1 {
+	when
+		Fact.Y == 0
+	then
+		Fact.Fired = Fact.Fired + "D";
+		Retract("RuleD");
+}
+`
+
+func executeWithResolver(t *testing.T, resolver ConflictResolver) string {
+	t.Helper()
+	fact := &ConflictFact{}
+	dctx := ast.NewDataContext()
+	err := dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err = rb.BuildRuleFromResource("ConflictTest", "0.1.1", pkg.NewBytesResource([]byte(conflictRules)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("ConflictTest", "0.1.1")
+	assert.NoError(t, err)
+
+	engine := NewGruleEngine()
+	engine.ConflictResolver = resolver
+	err = engine.Execute(dctx, kb)
+	assert.NoError(t, err)
+
+	return fact.Fired
+}
+
+func TestConflictResolver_Strategies(t *testing.T) {
+	assert.Equal(t, "ACBD", executeWithResolver(t, nil))
+	assert.Equal(t, "ACBD", executeWithResolver(t, NewConflictResolver(SalienceStrategy, FIFOStrategy)))
+	assert.Equal(t, "CABD", executeWithResolver(t, NewConflictResolver(SalienceStrategy, LIFOStrategy)))
+	assert.Equal(t, "ABCD", executeWithResolver(t, NewConflictResolver(SalienceStrategy, RecencyStrategy)))
+	assert.Equal(t, "CABD", executeWithResolver(t, NewConflictResolver(SalienceStrategy, SpecificityStrategy)))
+	assert.Equal(t, "ACBD", executeWithResolver(t, NewConflictResolver(FIFOStrategy)))
+	assert.Equal(t, "DCAB", executeWithResolver(t, NewConflictResolver(LIFOStrategy)))
+}
+
+func TestConflictResolver_ExtremeValues(t *testing.T) {
+	low := &Activation{RuleEntry: &ast.RuleEntry{RuleName: "Low", Salience: math.MinInt, Sequence: math.MinInt}}
+	high := &Activation{RuleEntry: &ast.RuleEntry{RuleName: "High", Salience: math.MaxInt, Sequence: math.MaxInt}}
+	assert.Equal(t, -1, SalienceStrategy(high, low))
+	assert.Equal(t, 1, SalienceStrategy(low, high))
+	assert.Equal(t, -1, FIFOStrategy(low, high))
+	assert.Equal(t, 1, FIFOStrategy(high, low))
+	assert.Equal(t, -1, LIFOStrategy(high, low))
+	assert.Equal(t, 0, LIFOStrategy(low, low))
+	assert.Same(t, high, DefaultConflictResolver.Resolve([]*Activation{low, high}))
+}
+
+func TestConflictResolver_RandomIsConcurrent(t *testing.T) {
+	resolver := NewRandomConflictResolver(42)
+	activations := []*Activation{
+		{RuleEntry: &ast.RuleEntry{RuleName: "RuleA", Sequence: 1}},
+		{RuleEntry: &ast.RuleEntry{RuleName: "RuleB", Sequence: 2}},
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				assert.NotNil(t, resolver.Resolve(activations))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestConflictResolver_RandomIsReproducible(t *testing.T) {
+	first := executeWithResolver(t, NewRandomConflictResolver(42, SalienceStrategy))
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, first, executeWithResolver(t, NewRandomConflictResolver(42, SalienceStrategy)))
+	}
+	// salience is still honoured, so the low salience rule is always the last one.
+	assert.Equal(t, "D", first[3:])
+}
+
+func TestConflictResolver_FetchMatchingRulesOrder(t *testing.T) {
+	dctx := ast.NewDataContext()
+	err := dctx.Add("Fact", &ConflictFact{})
+	assert.NoError(t, err)
+
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err = rb.BuildRuleFromResource("ConflictTest", "0.1.1", pkg.NewBytesResource([]byte(conflictRules)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("ConflictTest", "0.1.1")
+	assert.NoError(t, err)
+
+	matching, err := NewGruleEngine().FetchMatchingRules(dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(matching))
+	assert.Equal(t, "RuleA", matching[0].RuleName)
+	assert.Equal(t, "RuleC", matching[1].RuleName)
+	assert.Equal(t, "RuleD", matching[2].RuleName)
+}
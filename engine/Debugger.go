@@ -0,0 +1,237 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+)
+
+// ErrDebugAborted is returned by the engine when the Debugger aborts the execution.
+var ErrDebugAborted = errors.New("execution aborted by the debugger")
+
+// DebugAction tells the engine how to go on after a pause.
+type DebugAction int
+
+const (
+	// DebugContinue runs until the next breakpoint.
+	DebugContinue DebugAction = iota
+	// DebugStep pauses again at the next pause point, whatever the breakpoints.
+	DebugStep
+	// DebugAbort stops the execution, the engine returns ErrDebugAborted.
+	DebugAbort
+)
+
+// DebugPoint tells where the engine paused.
+type DebugPoint int
+
+const (
+	// BeginCyclePoint is before a cycle, before the fact changes are propagated.
+	BeginCyclePoint DebugPoint = iota
+	// ExecuteRuleEntryPoint is before the then scope of the selected rule entry is executed.
+	ExecuteRuleEntryPoint
+	// ThenExpressionPoint is before a then expression is executed.
+	ThenExpressionPoint
+)
+
+// DebugEvent describes a pause of the engine. It is only valid until the pause ends.
+type DebugEvent struct {
+	Point          DebugPoint
+	Cycle          uint64
+	RuleEntry      *ast.RuleEntry      // the executed rule entry, nil at BeginCyclePoint
+	ThenExpression *ast.ThenExpression // the next then expression, only at ThenExpressionPoint
+	DataContext    ast.IDataContext
+	WorkingMemory  *ast.WorkingMemory
+}
+
+// Value returns the current value of a fact, or of one of its fields, e.g. "Order.Customer.Name".
+func (event *DebugEvent) Value(path string) (interface{}, error) {
+	names := strings.Split(path, ".")
+	node := event.DataContext.Get(names[0])
+	if node == nil {
+
+		return nil, fmt.Errorf("fact %s is not in the data context", names[0])
+	}
+	for _, name := range names[1:] {
+		child, err := node.GetChildNodeByField(name)
+		if err != nil {
+
+			return nil, err
+		}
+		node = child
+	}
+	val, err := node.GetValue()
+	if err != nil {
+
+		return nil, err
+	}
+	if !val.IsValid() || !val.CanInterface() {
+
+		return nil, nil
+	}
+
+	return val.Interface(), nil
+}
+
+// EvaluatedExpressions returns the values memoized by the working memory, by their GRL text.
+func (event *DebugEvent) EvaluatedExpressions() map[string]interface{} {
+	evaluated := make(map[string]interface{})
+	for grlText, val := range event.WorkingMemory.GetEvaluatedExpressions() {
+		evaluated[grlText] = traceValue(val)
+	}
+
+	return evaluated
+}
+
+// NewDebugger creates a Debugger calling onPause every time the engine pauses.
+func NewDebugger(onPause func(event *DebugEvent) DebugAction) *Debugger {
+
+	return &Debugger{
+		OnPause:         onPause,
+		ruleBreakpoints: make(map[string]bool),
+		lineBreakpoints: make(map[lineBreakpoint]bool),
+	}
+}
+
+// Debugger pauses the engine before each cycle, or on the breakpoints set by rule name or by then expression line.
+// While paused, the engine waits for OnPause to return the action to take. The breakpoints can be changed
+// at any time, but a Debugger must not be shared by concurrent executions.
+type Debugger struct {
+	// PauseOnCycle pauses before each cycle, not only on the breakpoints.
+	PauseOnCycle bool
+	// OnPause is called every time the engine pauses.
+	OnPause func(event *DebugEvent) DebugAction
+
+	lock            sync.Mutex
+	ruleBreakpoints map[string]bool
+	lineBreakpoints map[lineBreakpoint]bool
+	stepping        bool
+
+	// the state of the current execution.
+	cycle     uint64
+	ruleEntry *ast.RuleEntry
+	dataCtx   ast.IDataContext
+	memory    *ast.WorkingMemory
+}
+
+// lineBreakpoint is a GRL line within a rule entry, as a line number alone may be found in every resource.
+type lineBreakpoint struct {
+	ruleName string
+	line     int
+}
+
+// AddRuleBreakpoint pauses the engine before the rule entry's then scope is executed.
+func (d *Debugger) AddRuleBreakpoint(ruleName string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.ruleBreakpoints[ruleName] = true
+}
+
+// RemoveRuleBreakpoint removes a breakpoint added using AddRuleBreakpoint.
+func (d *Debugger) RemoveRuleBreakpoint(ruleName string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	delete(d.ruleBreakpoints, ruleName)
+}
+
+// AddLineBreakpoint pauses the engine before the then expressions of the rule entry starting at the GRL line
+// are executed.
+func (d *Debugger) AddLineBreakpoint(ruleName string, line int) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.lineBreakpoints[lineBreakpoint{ruleName: ruleName, line: line}] = true
+}
+
+// RemoveLineBreakpoint removes a breakpoint added using AddLineBreakpoint.
+func (d *Debugger) RemoveLineBreakpoint(ruleName string, line int) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	delete(d.lineBreakpoints, lineBreakpoint{ruleName: ruleName, line: line})
+}
+
+// ClearBreakpoints removes all the breakpoints.
+func (d *Debugger) ClearBreakpoints() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.ruleBreakpoints = make(map[string]bool)
+	d.lineBreakpoints = make(map[lineBreakpoint]bool)
+}
+
+// attach starts debugging an execution, the then expressions are intercepted through the working memory.
+func (d *Debugger) attach(dataCtx ast.IDataContext, memory *ast.WorkingMemory) {
+	d.dataCtx, d.memory = dataCtx, memory
+	d.cycle, d.ruleEntry, d.stepping = 0, nil, false
+	memory.AddThenExpressionListener(d)
+}
+
+// detach stops debugging the execution.
+func (d *Debugger) detach() {
+	d.memory.RemoveThenExpressionListener(d)
+	d.dataCtx, d.memory, d.ruleEntry = nil, nil, nil
+}
+
+func (d *Debugger) beginCycle(cycle uint64) error {
+	d.cycle, d.ruleEntry = cycle, nil
+
+	return d.pauseIf(d.PauseOnCycle, BeginCyclePoint, nil)
+}
+
+func (d *Debugger) executeRuleEntry(cycle uint64, entry *ast.RuleEntry) error {
+	d.cycle, d.ruleEntry = cycle, entry
+	d.lock.Lock()
+	breakpoint := d.ruleBreakpoints[entry.RuleName]
+	d.lock.Unlock()
+
+	return d.pauseIf(breakpoint, ExecuteRuleEntryPoint, nil)
+}
+
+// ExecuteThenExpression implements ast.ThenExpressionListener
+func (d *Debugger) ExecuteThenExpression(thenExpression *ast.ThenExpression) error {
+	breakpoint := false
+	if d.ruleEntry != nil {
+		d.lock.Lock()
+		breakpoint = d.lineBreakpoints[lineBreakpoint{ruleName: d.ruleEntry.RuleName, line: thenExpression.Line}]
+		d.lock.Unlock()
+	}
+
+	return d.pauseIf(breakpoint, ThenExpressionPoint, thenExpression)
+}
+
+// pauseIf pauses the engine on a breakpoint or while stepping, and returns ErrDebugAborted if the execution is aborted.
+func (d *Debugger) pauseIf(breakpoint bool, point DebugPoint, thenExpression *ast.ThenExpression) error {
+	if !breakpoint && !d.stepping || d.OnPause == nil {
+
+		return nil
+	}
+	action := d.OnPause(&DebugEvent{
+		Point:          point,
+		Cycle:          d.cycle,
+		RuleEntry:      d.ruleEntry,
+		ThenExpression: thenExpression,
+		DataContext:    d.dataCtx,
+		WorkingMemory:  d.memory,
+	})
+	d.stepping = action == DebugStep
+	if action == DebugAbort {
+
+		return ErrDebugAborted
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+type DebugFact struct {
+	Count int
+	Name  string
+}
+
+const debugRules = `rule Count "counts to two" {
+	when
+		DebugFact.Count < 2
+	then
+		DebugFact.Count = DebugFact.Count + 1;
+		DebugFact.Name = "counted";
+}
+
+rule Other "never runs" {
+	when
+		DebugFact.Count > 10
+	then
+		DebugFact.Name = "other";
+}
+`
+
+type debugPause struct {
+	point DebugPoint
+	cycle uint64
+	line  int
+	count interface{}
+}
+
+func TestDebugger_Breakpoints(t *testing.T) {
+	fact := &DebugFact{}
+	dctx := ast.NewDataContext()
+	err := dctx.Add("DebugFact", fact)
+	assert.NoError(t, err)
+
+	pauses := make([]debugPause, 0)
+	var evaluated map[string]interface{}
+	debugger := NewDebugger(func(event *DebugEvent) DebugAction {
+		count, err := event.Value("DebugFact.Count")
+		assert.NoError(t, err)
+		pause := debugPause{point: event.Point, cycle: event.Cycle, count: count}
+		if event.ThenExpression != nil {
+			pause.line = event.ThenExpression.Line
+		}
+		pauses = append(pauses, pause)
+		if event.Point == ExecuteRuleEntryPoint {
+			evaluated = event.EvaluatedExpressions()
+			// step into the then scope.
+			return DebugStep
+		}
+
+		return DebugContinue
+	})
+	debugger.AddRuleBreakpoint("Count")
+	debugger.AddLineBreakpoint("Count", 6)
+
+	eng := NewGruleEngine()
+	eng.Debugger = debugger
+	err = eng.Execute(dctx, mustNewKnowledgeBase(t, debugRules))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fact.Count)
+	assert.Equal(t, []debugPause{
+		{ExecuteRuleEntryPoint, 1, 0, 0},
+		{ThenExpressionPoint, 1, 5, 0},
+		{ThenExpressionPoint, 1, 6, 1},
+		{ExecuteRuleEntryPoint, 2, 0, 1},
+		{ThenExpressionPoint, 2, 5, 1},
+		{ThenExpressionPoint, 2, 6, 2},
+	}, pauses)
+	assert.Equal(t, true, evaluated["DebugFact.Count<2"])
+
+	_, err = (&DebugEvent{DataContext: dctx}).Value("Unknown.Count")
+	assert.Error(t, err)
+}
+
+func TestDebugger_LineBreakpointOfRule(t *testing.T) {
+	fact := &DebugFact{}
+	dctx := ast.NewDataContext()
+	err := dctx.Add("DebugFact", fact)
+	assert.NoError(t, err)
+
+	// the then expression of Tally is at line 5 of its resource, as the first one of Count.
+	kb := mustNewKnowledgeBase(t, debugRules, `rule Tally "renames once counted" {
+	when
+		DebugFact.Count == 2 && DebugFact.Name == "counted"
+	then
+		DebugFact.Name = "tallied";
+}`)
+	paused := make([]string, 0)
+	debugger := NewDebugger(func(event *DebugEvent) DebugAction {
+		paused = append(paused, fmt.Sprintf("%s:%d", event.RuleEntry.RuleName, event.ThenExpression.Line))
+
+		return DebugContinue
+	})
+	debugger.AddLineBreakpoint("Count", 5)
+
+	eng := NewGruleEngine()
+	eng.Debugger = debugger
+	err = eng.Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, "tallied", fact.Name)
+	assert.Equal(t, []string{"Count:5", "Count:5"}, paused)
+
+	debugger.RemoveLineBreakpoint("Count", 5)
+	debugger.AddLineBreakpoint("Tally", 5)
+	fact.Count, fact.Name = 0, ""
+	paused = paused[:0]
+	err = eng.Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Tally:5"}, paused)
+}
+
+func TestDebugger_StepAndAbort(t *testing.T) {
+	fact := &DebugFact{}
+	dctx := ast.NewDataContext()
+	err := dctx.Add("DebugFact", fact)
+	assert.NoError(t, err)
+
+	points := make([]DebugPoint, 0)
+	debugger := NewDebugger(func(event *DebugEvent) DebugAction {
+		points = append(points, event.Point)
+		if len(points) == 4 {
+
+			return DebugAbort
+		}
+
+		return DebugStep
+	})
+	debugger.PauseOnCycle = true
+
+	eng := NewGruleEngine()
+	eng.Debugger = debugger
+	err = eng.Execute(dctx, mustNewKnowledgeBase(t, debugRules))
+	assert.True(t, errors.Is(err, ErrDebugAborted))
+	assert.Equal(t, []DebugPoint{BeginCyclePoint, ExecuteRuleEntryPoint, ThenExpressionPoint, ThenExpressionPoint}, points)
+	// the first then expression got executed before the abort.
+	assert.Equal(t, 1, fact.Count)
+	assert.Equal(t, "", fact.Name)
+}
@@ -0,0 +1,178 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type Invoice struct {
+	Country string
+	Amount  float64
+	Tax     float64
+	Exempt  bool
+	Done    bool
+}
+
+type TaxConfig struct {
+	Active bool
+}
+
+const declarationConsts = `
+const TAX_RATE = 0.11;
+const EXEMPT_COUNTRIES = ["NL", "BE"];
+`
+
+const declarationRules = `
+global Invoice Invoice;
+global *engine.TaxConfig Config;
+
+rule Tax "applies the tax rate" {
+	when
+		Config.Active && !Invoice.Done && Invoice.Country not in EXEMPT_COUNTRIES
+	then
+		Invoice.Tax = Invoice.Amount * TAX_RATE;
+		Invoice.Done = true;
+}
+
+rule Exempt "marks the exempt invoices" {
+	when
+		!Invoice.Done && Invoice.Country in EXEMPT_COUNTRIES
+	then
+		Invoice.Exempt = true;
+		Invoice.Done = true;
+}
+`
+
+func TestDeclaration_Const(t *testing.T) {
+	kb, err := newKnowledgeBase(t, declarationConsts, declarationRules)
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		invoice *Invoice
+		tax     float64
+		exempt  bool
+	}{
+		{&Invoice{Country: "DE", Amount: 100}, 11, false},
+		{&Invoice{Country: "NL", Amount: 100}, 0, true},
+	} {
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Invoice", tc.invoice))
+		assert.NoError(t, dctx.Add("Config", &TaxConfig{Active: true}))
+		err = NewGruleEngine().Execute(dctx, kb)
+		assert.NoError(t, err)
+		assert.InDelta(t, tc.tax, tc.invoice.Tax, 0.0001)
+		assert.Equal(t, tc.exempt, tc.invoice.Exempt)
+	}
+}
+
+func TestDeclaration_Global(t *testing.T) {
+	kb, err := newKnowledgeBase(t, declarationConsts, declarationRules)
+	assert.NoError(t, err)
+
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Invoice", &Invoice{}))
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.Error(t, err)
+
+	dctx = ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Invoice", &Invoice{}))
+	assert.NoError(t, dctx.Add("Config", TaxConfig{Active: true}))
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.Error(t, err)
+
+	dctx = ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Invoice", &Membership{}))
+	assert.NoError(t, dctx.Add("Config", &TaxConfig{Active: true}))
+	_, err = NewGruleEngine().FetchMatchingRules(dctx, kb)
+	assert.Error(t, err)
+}
+
+func TestDeclaration_Errors(t *testing.T) {
+	for _, grls := range [][]string{
+		{`const TAX_RATE = 0.11; const TAX_RATE = 0.21;`},
+		{`const TAX_RATE = 0.11; global Invoice TAX_RATE;`},
+		{declarationConsts, declarationConsts},
+		{declarationConsts, `
+rule Assign "assigns to a constant" {
+	when
+		true
+	then
+		TAX_RATE = 0.21;
+}`},
+		{`
+rule Early "uses a constant declared later" {
+	when
+		Invoice.Amount * TAX_RATE > 0
+	then
+		Retract("Early");
+}
+
+const TAX_RATE = 0.11;`},
+		{`
+function Tax(amount) {
+	return amount * TAX_RATE;
+}
+
+const TAX_RATE = 0.11;`},
+		{declarationConsts, `
+rule Member "reads a member of a constant" {
+	when
+		TAX_RATE.Value > 0
+	then
+		Retract("Member");
+}`},
+	} {
+		_, err := newKnowledgeBase(t, grls...)
+		assert.Error(t, err, grls)
+	}
+}
+
+func TestDeclaration_SerializationAndClone(t *testing.T) {
+	kb, err := newKnowledgeBase(t, declarationConsts, declarationRules)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	assert.True(t, kb.IsIdentical(loadedKb))
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+	assert.True(t, kb.IsIdentical(clone))
+
+	for _, knowledge := range []*ast.KnowledgeBase{loadedKb, clone} {
+		invoice := &Invoice{Country: "DE", Amount: 200}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Invoice", invoice))
+		err = NewGruleEngine().Execute(dctx, knowledge)
+		assert.Error(t, err)
+		assert.NoError(t, dctx.Add("Config", &TaxConfig{Active: true}))
+		err = NewGruleEngine().Execute(dctx, knowledge)
+		assert.NoError(t, err)
+		assert.InDelta(t, 22, invoice.Tax, 0.0001)
+	}
+}
@@ -0,0 +1,182 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+)
+
+// ExecutionTrace records what the engine did during an execution, cycle by cycle.
+// It explains why each rule was, or was not, a candidate and what the executed rules changed.
+type ExecutionTrace struct {
+	KnowledgeBase string        `json:"knowledgeBase"`
+	Version       string        `json:"version"`
+	Cycles        []*CycleTrace `json:"cycles"`
+	Error         string        `json:"error,omitempty"`
+}
+
+// CycleTrace records a single cycle of an execution.
+type CycleTrace struct {
+	Cycle uint64 `json:"cycle"`
+	// Evaluations are the rules whose when scope got evaluated in this cycle.
+	Evaluations []*RuleEvaluationTrace `json:"evaluations,omitempty"`
+	// Candidates are the rules in the conflict set, including the ones evaluated in a previous cycle.
+	Candidates []string `json:"candidates"`
+	// Selected is the rule executed in this cycle, empty if there was no candidate.
+	Selected    string             `json:"selected,omitempty"`
+	Assignments []*AssignmentTrace `json:"assignments,omitempty"`
+}
+
+// RuleEvaluationTrace records the evaluation of a rule's when scope.
+type RuleEvaluationTrace struct {
+	Rule      string           `json:"rule"`
+	Candidate bool             `json:"candidate"`
+	Error     string           `json:"error,omitempty"`
+	When      *ExpressionTrace `json:"when,omitempty"`
+}
+
+// ExpressionTrace records the value of an expression of a when scope, and of its sub-expressions.
+// Evaluated is false for the sub-expressions skipped by the short circuit of && and ||.
+type ExpressionTrace struct {
+	Expression string             `json:"expression"`
+	Evaluated  bool               `json:"evaluated"`
+	Value      interface{}        `json:"value,omitempty"`
+	Children   []*ExpressionTrace `json:"children,omitempty"`
+}
+
+// AssignmentTrace records an assignment executed in a then scope.
+type AssignmentTrace struct {
+	Variable string      `json:"variable"`
+	OldValue interface{} `json:"oldValue"`
+	NewValue interface{} `json:"newValue"`
+}
+
+// JSON will serialize the trace into JSON.
+func (trace *ExecutionTrace) JSON() ([]byte, error) {
+
+	return json.MarshalIndent(trace, "", "  ")
+}
+
+// currentCycle returns the last cycle of the trace.
+func (trace *ExecutionTrace) currentCycle() *CycleTrace {
+
+	return trace.Cycles[len(trace.Cycles)-1]
+}
+
+func (trace *ExecutionTrace) beginCycle(cycle uint64) {
+	trace.Cycles = append(trace.Cycles, &CycleTrace{
+		Cycle:      cycle,
+		Candidates: make([]string, 0),
+	})
+}
+
+func (trace *ExecutionTrace) evaluated(entry *ast.RuleEntry, candidate bool, err error) {
+	evaluation := &RuleEvaluationTrace{
+		Rule:      entry.RuleName,
+		Candidate: candidate,
+	}
+	if err != nil {
+		evaluation.Error = err.Error()
+	}
+	if entry.WhenScope != nil {
+		evaluation.When = traceExpression(entry.WhenScope.Expression)
+	}
+	cycle := trace.currentCycle()
+	cycle.Evaluations = append(cycle.Evaluations, evaluation)
+}
+
+func (trace *ExecutionTrace) selected(runnable []*Activation, runner *ast.RuleEntry) {
+	cycle := trace.currentCycle()
+	for _, activation := range runnable {
+		cycle.Candidates = append(cycle.Candidates, activation.RuleEntry.RuleName)
+	}
+	cycle.Selected = runner.RuleName
+}
+
+// VariableAssigned implements ast.AssignmentListener
+func (trace *ExecutionTrace) VariableAssigned(variable *ast.Variable, oldValue, newValue reflect.Value) {
+	cycle := trace.currentCycle()
+	cycle.Assignments = append(cycle.Assignments, &AssignmentTrace{
+		Variable: variable.GrlText,
+		OldValue: traceValue(oldValue),
+		NewValue: traceValue(newValue),
+	})
+}
+
+// traceExpression records the memoized values of the expression tree. It must be called right after
+// the expression got evaluated, before any then scope resets them.
+func traceExpression(expr *ast.Expression) *ExpressionTrace {
+	if expr == nil {
+
+		return nil
+	}
+	// parentheses do not deserve their own level in the trace.
+	if expr.SingleExpression != nil && !expr.Negated && !expr.Negative {
+
+		return traceExpression(expr.SingleExpression)
+	}
+	ret := &ExpressionTrace{
+		Expression: expr.GrlText,
+		Evaluated:  expr.Evaluated,
+	}
+	if expr.Evaluated {
+		ret.Value = traceValue(expr.Value)
+	}
+	for _, child := range []*ast.Expression{expr.SingleExpression, expr.LeftExpression, expr.RightExpression, expr.UpperExpression, expr.ElseExpression} {
+		if child != nil {
+			ret.Children = append(ret.Children, traceExpression(child))
+		}
+	}
+	// the && of a when scope are joined by the RETE network, without evaluating the expression itself.
+	if !expr.Evaluated && expr.Operator == ast.OpAnd && len(ret.Children) == 2 {
+		left, right := ret.Children[0], ret.Children[1]
+		switch {
+		case left.Evaluated && left.Value == false, right.Evaluated && right.Value == false:
+			ret.Evaluated, ret.Value = true, false
+		case left.Evaluated && left.Value == true && right.Evaluated && right.Value == true:
+			ret.Evaluated, ret.Value = true, true
+		}
+	}
+
+	return ret
+}
+
+// traceValue converts a value to be serialized in the trace. Basic values are kept as they are,
+// the others are formatted, so a trace never holds a reference to a fact.
+func traceValue(val reflect.Value) interface{} {
+	if !val.IsValid() || !val.CanInterface() {
+
+		return nil
+	}
+	switch val.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+
+		return val.Interface()
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		if val.IsNil() {
+
+			return nil
+		}
+	}
+
+	return fmt.Sprintf("%v", val.Interface())
+}
@@ -0,0 +1,116 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/builder"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type TraceLoan struct {
+	Amount   int
+	Income   int
+	Status   string
+	Reviewed bool
+}
+
+const traceRules = `
+rule Reject "loan above half of the income" salience 10 {
+	when
+		Loan.Status == "" && Loan.Amount > Loan.Income / 2
+	then
+		Loan.Status = "REJECTED";
+}
+
+rule Approve "loan within half of the income" {
+	when
+		Loan.Status == "" && Loan.Amount <= Loan.Income / 2
+	then
+		Loan.Status = "APPROVED";
+}
+
+rule Review "every decision is reviewed" {
+	when
+		Loan.Status != "" && Loan.Reviewed == false
+	then
+		Loan.Reviewed = true;
+}
+`
+
+func TestGruleEngine_ExecuteWithTrace(t *testing.T) {
+	loan := &TraceLoan{Amount: 800, Income: 1000}
+	dctx := ast.NewDataContext()
+	err := dctx.Add("Loan", loan)
+	assert.NoError(t, err)
+
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err = rb.BuildRuleFromResource("TraceTest", "0.1.1", pkg.NewBytesResource([]byte(traceRules)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("TraceTest", "0.1.1")
+	assert.NoError(t, err)
+
+	trace, err := NewGruleEngine().ExecuteWithTrace(context.Background(), dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, "REJECTED", loan.Status)
+
+	// two executed cycles, and the last one without candidate.
+	assert.Equal(t, 3, len(trace.Cycles))
+	first := trace.Cycles[0]
+	assert.Equal(t, []string{"Reject"}, first.Candidates)
+	assert.Equal(t, "Reject", first.Selected)
+	assert.Equal(t, 3, len(first.Evaluations))
+	assert.Equal(t, []*AssignmentTrace{{Variable: "Loan.Status", OldValue: "", NewValue: "REJECTED"}}, first.Assignments)
+
+	// why Reject fired: the amount is above half of the income.
+	reject := first.Evaluations[0]
+	assert.Equal(t, "Reject", reject.Rule)
+	assert.True(t, reject.Candidate)
+	assert.Equal(t, true, reject.When.Value)
+	assert.Equal(t, 2, len(reject.When.Children))
+	amount := reject.When.Children[1]
+	assert.Equal(t, "Loan.Amount>Loan.Income/2", amount.Expression)
+	assert.Equal(t, true, amount.Value)
+	assert.Equal(t, float64(500), amount.Children[1].Value)
+
+	// why Approve did not fire.
+	approve := first.Evaluations[1]
+	assert.Equal(t, "Approve", approve.Rule)
+	assert.False(t, approve.Candidate)
+	assert.Equal(t, false, approve.When.Value)
+	assert.Equal(t, false, approve.When.Children[1].Value)
+
+	second := trace.Cycles[1]
+	assert.Equal(t, "Review", second.Selected)
+	assert.Equal(t, []*AssignmentTrace{{Variable: "Loan.Reviewed", OldValue: false, NewValue: true}}, second.Assignments)
+
+	assert.Equal(t, 0, len(trace.Cycles[2].Candidates))
+	assert.Equal(t, "", trace.Cycles[2].Selected)
+
+	data, err := trace.JSON()
+	assert.NoError(t, err)
+	decoded := &ExecutionTrace{}
+	err = json.Unmarshal(data, decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, "TraceTest", decoded.KnowledgeBase)
+	assert.Equal(t, 3, len(decoded.Cycles))
+	assert.Equal(t, "REJECTED", decoded.Cycles[0].Assignments[0].NewValue)
+}
@@ -0,0 +1,204 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type Membership struct {
+	Tier     string
+	Years    int
+	Total    float64
+	Discount float64
+	Loyal    bool
+	Rank     int
+	Done     bool
+}
+
+const functionRules = `
+rule Discount "applies the discount of the tier" {
+	when
+		!Membership.Done
+	then
+		Membership.Discount = discountFor(Membership.Tier, Membership.Years) * Membership.Total;
+		Membership.Rank = factorial(3);
+		Membership.Done = true;
+}
+
+rule Loyal "uses a function in the when scope" {
+	when
+		isLoyal(Membership.Years) && !Membership.Loyal
+	then
+		Membership.Loyal = true;
+}
+
+function discountFor(tier, years) {
+	let base = tier == "gold" ? 0.2 : tier == "silver" ? 0.1 : 0.0;
+	return isLoyal(years) ? base + 0.05 : base;
+}
+
+function factorial(n) {
+	return n <= 1 ? 1 : n * factorial(n - 1);
+}
+`
+
+const loyaltyFunction = `
+function isLoyal(years) {
+	return years >= 5;
+}
+`
+
+func TestFunction_Call(t *testing.T) {
+	kb, err := newKnowledgeBase(t, functionRules, loyaltyFunction)
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		membership *Membership
+		discount   float64
+		loyal      bool
+	}{
+		{&Membership{Tier: "gold", Years: 6, Total: 100}, 25, true},
+		{&Membership{Tier: "silver", Years: 1, Total: 100}, 10, false},
+		{&Membership{Tier: "basic", Years: 2, Total: 100}, 0, false},
+	} {
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Membership", tc.membership))
+		err = NewGruleEngine().Execute(dctx, kb)
+		assert.NoError(t, err)
+		assert.InDelta(t, tc.discount, tc.membership.Discount, 0.0001)
+		assert.Equal(t, tc.loyal, tc.membership.Loyal)
+		assert.Equal(t, 6, tc.membership.Rank)
+	}
+}
+
+func TestFunction_Errors(t *testing.T) {
+	for _, grl := range []string{
+		`function Now() { return 1; }`,
+		`function twice(n) { return n; } function twice(n) { return n * 2; }`,
+		`function add(a, a) { return a + a; }`,
+	} {
+		_, err := newKnowledgeBase(t, grl)
+		assert.Error(t, err, grl)
+	}
+
+	_, err := newKnowledgeBase(t, `function twice(n) { return n * 2; }`, `function twice(n) { return n + n; }`)
+	assert.Error(t, err)
+
+	for _, grl := range []string{
+		`function twice(n) { return n * 2; }
+rule Arity "calls the function with the wrong number of arguments" {
+	when
+		!Membership.Done
+	then
+		Membership.Rank = twice(1, 2);
+		Membership.Done = true;
+}`,
+		`function loop(n) { return loop(n + 1); }
+rule Recursion "calls a function that never returns" {
+	when
+		!Membership.Done
+	then
+		Membership.Rank = loop(0);
+		Membership.Done = true;
+}`,
+	} {
+		kb, err := newKnowledgeBase(t, grl)
+		assert.NoError(t, err)
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Membership", &Membership{}))
+		err = NewGruleEngine().Execute(dctx, kb)
+		assert.Error(t, err)
+	}
+}
+
+type Counter struct {
+	A       int
+	B       int
+	Checked bool
+}
+
+const bodyFactRules = `
+function withB(x) {
+	return x + Counter.B;
+}
+
+function twice(x) {
+	return withB(x) * 2;
+}
+
+rule Inc "raises the fact read in the function body" salience 10 {
+	when
+		Counter.B < 5
+	then
+		Counter.B = Counter.B + 1;
+}
+
+rule Check "calls a function reading a fact in its body" {
+	when
+		!Counter.Checked && withB(1) > 3 && twice(Counter.A) > 9
+	then
+		Counter.Checked = true;
+}
+`
+
+func TestFunction_FactReadInBody(t *testing.T) {
+	kb := mustNewKnowledgeBase(t, bodyFactRules)
+
+	counter := &Counter{}
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Counter", counter))
+	err := NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, counter.B)
+	// withB(1) is 6 and twice(0) is 10 once Counter.B reached 5, only the function bodies read it.
+	assert.True(t, counter.Checked)
+}
+
+func TestFunction_SerializationAndClone(t *testing.T) {
+	kb, err := newKnowledgeBase(t, functionRules, loyaltyFunction)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	assert.True(t, kb.IsIdentical(loadedKb))
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+	assert.NotSame(t, kb.WorkingMemory.GetFunction("factorial"), clone.WorkingMemory.GetFunction("factorial"))
+
+	for _, knowledge := range []*ast.KnowledgeBase{loadedKb, clone} {
+		membership := &Membership{Tier: "gold", Years: 6, Total: 100}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Membership", membership))
+		err = NewGruleEngine().Execute(dctx, knowledge)
+		assert.NoError(t, err)
+		assert.InDelta(t, 25, membership.Discount, 0.0001)
+		assert.True(t, membership.Loyal)
+		assert.Equal(t, 6, membership.Rank)
+	}
+}
@@ -79,7 +79,8 @@ func SetLogger(externalLog interface{}) {
 func NewGruleEngine() *GruleEngine {
 
 	return &GruleEngine{
-		MaxCycle: DefaultCycleCount,
+		MaxCycle:         DefaultCycleCount,
+		ConflictResolver: DefaultConflictResolver,
 	}
 }
 
@@ -88,12 +89,40 @@ type GruleEngine struct {
 	MaxCycle                        uint64
 	ReturnErrOnFailedRuleEvaluation bool
 	Listeners                       []GruleEngineListener
+	// ConflictResolver selects the rule entry to execute when more than one is runnable in a cycle.
+	// If nil, DefaultConflictResolver is used.
+	ConflictResolver ConflictResolver
+	// Clock tells the current time, the rule entries outside of their effective dates are not executed.
+	// If nil, time.Now is used.
+	Clock func() time.Time
+	// Debugger pauses the execution before each cycle or on breakpoints. If nil, the execution never pauses.
+	Debugger *Debugger
+}
+
+// now returns the current time of the configured Clock.
+func (g *GruleEngine) now() time.Time {
+	if g.Clock == nil {
+
+		return time.Now()
+	}
+
+	return g.Clock()
+}
+
+// conflictResolver returns the configured ConflictResolver or the default one.
+func (g *GruleEngine) conflictResolver() ConflictResolver {
+	if g.ConflictResolver == nil {
+
+		return DefaultConflictResolver
+	}
+
+	return g.ConflictResolver
 }
 
 // Execute function is the same as ExecuteWithContext(context.Background())
-func (g *GruleEngine) Execute(dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase) error {
+func (g *GruleEngine) Execute(dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase, filters ...RuleFilter) error {
 
-	return g.ExecuteWithContext(context.Background(), dataCtx, knowledge)
+	return g.ExecuteWithContext(context.Background(), dataCtx, knowledge, filters...)
 }
 
 // notifyEvaluateRuleEntry will notify all registered listener that a rule is being evaluated.
@@ -106,32 +135,125 @@ func (g *GruleEngine) notifyEvaluateRuleEntry(cycle uint64, entry *ast.RuleEntry
 }
 
 // notifyEvaluateRuleEntry will notify all registered listener that a rule is being executed.
-func (g *GruleEngine) notifyExecuteRuleEntry(cycle uint64, entry *ast.RuleEntry) {
+// It returns an error if the debugger aborted the execution.
+func (g *GruleEngine) notifyExecuteRuleEntry(cycle uint64, entry *ast.RuleEntry) error {
 	if g.Listeners != nil && len(g.Listeners) > 0 {
 		for _, gl := range g.Listeners {
 			gl.ExecuteRuleEntry(cycle, entry)
 		}
 	}
+	if g.Debugger != nil {
+
+		return g.Debugger.executeRuleEntry(cycle, entry)
+	}
+
+	return nil
 }
 
 // notifyEvaluateRuleEntry will notify all registered listener that a rule is being executed.
-func (g *GruleEngine) notifyBeginCycle(cycle uint64) {
+// It returns an error if the debugger aborted the execution.
+func (g *GruleEngine) notifyBeginCycle(cycle uint64) error {
 	if g.Listeners != nil && len(g.Listeners) > 0 {
 		for _, gl := range g.Listeners {
 			gl.BeginCycle(cycle)
 		}
 	}
+	if g.Debugger != nil {
+
+		return g.Debugger.beginCycle(cycle)
+	}
+
+	return nil
 }
 
 // ExecuteWithContext function will execute a knowledge evaluation and action against data context.
 // The engine will evaluate context cancelation status in each cycle.
 // The engine also do conflict resolution of which rule to execute.
-func (g *GruleEngine) ExecuteWithContext(ctx context.Context, dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase) error {
+// If filters are given, only the rule entries selected by all of them are executed.
+func (g *GruleEngine) ExecuteWithContext(ctx context.Context, dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase, filters ...RuleFilter) error {
+
+	return g.execute(ctx, dataCtx, knowledge, "", nil, filters)
+}
+
+// ExecuteWithTrace function is the same as ExecuteWithContext, but it also records an ExecutionTrace
+// explaining the candidates, the evaluated conditions and the assignments of every cycle.
+// The trace is returned even if the execution failed, up to the point of failure.
+func (g *GruleEngine) ExecuteWithTrace(ctx context.Context, dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase, filters ...RuleFilter) (*ExecutionTrace, error) {
+	if knowledge == nil || dataCtx == nil {
+
+		return nil, fmt.Errorf("nil KnowledgeBase or DataContext is not allowed")
+	}
+	trace := &ExecutionTrace{
+		KnowledgeBase: knowledge.Name,
+		Version:       knowledge.Version,
+		Cycles:        make([]*CycleTrace, 0),
+	}
+	knowledge.WorkingMemory.AddAssignmentListener(trace)
+	defer knowledge.WorkingMemory.RemoveAssignmentListener(trace)
+	err := g.execute(ctx, dataCtx, knowledge, "", trace, filters)
+	if err != nil {
+		trace.Error = err.Error()
+	}
+
+	return trace, err
+}
+
+// ExecuteRuleFlow function will execute the stages of the knowledge base's rule flow, one after the other.
+// Each stage executes the rule entries of its agenda group until none of them can be executed anymore,
+// then the transitions of the stage select the next one. The execution ends with the last stage, or with a transition to no stage.
+// If filters are given, only the rule entries selected by all of them are executed.
+func (g *GruleEngine) ExecuteRuleFlow(ctx context.Context, dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase, ruleFlow string, filters ...RuleFilter) error {
+	if knowledge == nil || dataCtx == nil {
+
+		return fmt.Errorf("nil KnowledgeBase or DataContext is not allowed")
+	}
+	flow, ok := knowledge.RuleFlows[ruleFlow]
+	if !ok {
+
+		return fmt.Errorf("rule flow %s not found in knowledge base %s version %s", ruleFlow, knowledge.Name, knowledge.Version)
+	}
+	var stages uint64
+	for stage := flow.Stages[0]; stage != nil; {
+		// the transitions may loop between stages, the same safety mechanism as for the cycles is needed.
+		stages++
+		if stages > g.MaxCycle {
+
+			return fmt.Errorf("the rule flow %s executed %d stages, its transitions possibly loop between stages. You can adjust the maximum using GruleEngine.MaxCycle variable", flow.Name, g.MaxCycle)
+		}
+		log.Debugf("Rule flow %s, stage %s", flow.Name, stage.Name)
+		err := g.execute(ctx, dataCtx, knowledge, stage.GetAgendaGroup(), nil, filters)
+		if err != nil {
+
+			return fmt.Errorf("rule flow %s stage %s failed. got %w", flow.Name, stage.Name, err)
+		}
+		if dataCtx.IsComplete() {
+			break
+		}
+		stage, err = flow.NextStage(ctx, stage, dataCtx, knowledge.WorkingMemory)
+		if err != nil {
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// execute runs the rule entries selected by the filters, recording the trace if it is not nil.
+// If the stage agenda group is not empty, only its rule entries, and the ones of the agenda groups it focuses, are executed.
+func (g *GruleEngine) execute(ctx context.Context, dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase, stage string, trace *ExecutionTrace, filters []RuleFilter) error {
 	if knowledge == nil || dataCtx == nil {
 
 		return fmt.Errorf("nil KnowledgeBase or DataContext is not allowed")
 	}
 
+	// the facts declared as globals must be in the data context before anything is evaluated.
+	err := knowledge.ValidateGlobals(dataCtx)
+	if err != nil {
+
+		return err
+	}
+
 	log.Debugf("Starting rule execution using knowledge '%s' version %s. Contains %d rule entries", knowledge.Name, knowledge.Version, len(knowledge.RuleEntries))
 
 	// Prepare the timer, we need to measure the processing time in debug mode.
@@ -143,7 +265,7 @@ func (g *GruleEngine) ExecuteWithContext(ctx context.Context, dataCtx ast.IDataC
 		WorkingMemory: knowledge.WorkingMemory,
 		DataContext:   dataCtx,
 	}
-	err := dataCtx.Add("DEFUNC", defunc)
+	err = dataCtx.Add("DEFUNC", defunc)
 	if err != nil {
 		log.Error("DEFUNC add err")
 
@@ -161,6 +283,21 @@ func (g *GruleEngine) ExecuteWithContext(ctx context.Context, dataCtx ast.IDataC
 
 	var cycle uint64
 
+	// agenda keeps the activations between cycles, they are updated by the fact changes flowing through the RETE network.
+	agenda := newAgenda(knowledge, dataCtx, filters)
+	defer agenda.close()
+	if g.Debugger != nil {
+		g.Debugger.attach(dataCtx, knowledge.WorkingMemory)
+		defer g.Debugger.detach()
+	}
+	// the focus set before the execution is used, but it must not leak into the next one.
+	defer knowledge.ClearFocus()
+	// a stage of a rule flow starts with the focus on its agenda group, and the focus never goes back below it.
+	if len(stage) > 0 {
+		knowledge.ClearFocus()
+		knowledge.SetFocus(stage)
+	}
+
 	/*
 		Un-limited loop as long as there are rule to execute.
 		We need to add safety mechanism to detect unlimited loop as there are possibility executed rule are not changing
@@ -173,41 +310,54 @@ func (g *GruleEngine) ExecuteWithContext(ctx context.Context, dataCtx ast.IDataC
 			return ctx.Err()
 		}
 
-		g.notifyBeginCycle(cycle + 1)
+		if err := g.notifyBeginCycle(cycle + 1); err != nil {
+
+			return err
+		}
+		if trace != nil {
+			trace.beginCycle(cycle + 1)
+		}
 
-		// Select all rule entry that can be executed.
-		log.Tracef("Select all rule entry that can be executed.")
-		runnable := make([]*ast.RuleEntry, 0)
-		for _, ruleEntry := range knowledge.RuleEntries {
+		// Update the activations of the rule entries reached by the fact changes since the last cycle.
+		log.Tracef("Propagate fact changes through the RETE network.")
+		for _, terminal := range agenda.propagate() {
 			if ctx.Err() != nil {
 				log.Error("Context canceled")
 
 				return ctx.Err()
 			}
-			if !ruleEntry.Retracted && !ruleEntry.Deleted {
-				// test if this rule entry v can execute.
-				can, err := ruleEntry.Evaluate(ctx, dataCtx, knowledge.WorkingMemory)
-				if err != nil {
-					log.Errorf("Failed testing condition for rule : %s. Got error %v", ruleEntry.RuleName, err)
-					if g.ReturnErrOnFailedRuleEvaluation {
-
-						return err
-					}
-				}
-				// if can, add into runnable array
-				if can {
-					runnable = append(runnable, ruleEntry)
+			ruleEntry := terminal.RuleEntry
+			if trace != nil && !ruleEntry.Retracted && !ruleEntry.Deleted {
+				trace.evaluated(ruleEntry, terminal.Satisfied(), terminal.Err())
+			}
+			if err := terminal.Err(); err != nil {
+				log.Errorf("Failed testing condition for rule : %s. Got error %v", ruleEntry.RuleName, err)
+				if g.ReturnErrOnFailedRuleEvaluation && !ruleEntry.Retracted {
+
+					return fmt.Errorf("evaluating expression in rule '%s' the when raised an error. got %w", ruleEntry.RuleName, err)
 				}
+			}
+			agenda.update(ruleEntry, terminal.Satisfied(), cycle+1)
+			if !ruleEntry.Retracted && !ruleEntry.Deleted {
 				// notify all listeners that a rule's when scope is been evaluated.
-				g.notifyEvaluateRuleEntry(cycle+1, ruleEntry, can)
+				g.notifyEvaluateRuleEntry(cycle+1, ruleEntry, terminal.Satisfied())
 			}
 		}
+		// the lock-on-active rule entries are released once their agenda group lost the focus,
+		// the changes made before the focus moved do not activate them.
+		agenda.focused(knowledge.GetFocus())
+		// only the focused agenda group can execute, the focus goes back to the previous group once it has nothing left.
+		now := g.now()
+		runnable := agenda.runnable(knowledge.GetFocus(), now)
+		for len(runnable) == 0 && knowledge.GetFocus() != stage && knowledge.PopFocus() {
+			runnable = agenda.runnable(knowledge.GetFocus(), now)
+		}
 
 		// disabled to test the rete's variable change detection.
 		// knowledge.RuleContextReset()
 		log.Tracef("Selected rules %d.", len(runnable))
 
-		// If there are rules to execute, sort them by their Salience
+		// If there are rules to execute, let the conflict resolver pick one of them
 		if len(runnable) > 0 {
 			// add the cycle counter
 			cycle++
@@ -220,20 +370,20 @@ func (g *GruleEngine) ExecuteWithContext(ctx context.Context, dataCtx ast.IDataC
 				return fmt.Errorf("the GruleEngine successfully selected rule candidate for execution after %d cycles, this could possibly caused by rule entry(s) that keep added into execution pool but when executed it does not change any data in context. Please evaluate your rule entries \"When\" and \"Then\" scope. You can adjust the maximum cycle using GruleEngine.MaxCycle variable", g.MaxCycle)
 			}
 
-			runner := runnable[0]
-
-			// scan all runnables and pick the highest salience
+			runner := runnable[0].RuleEntry
 			if len(runnable) > 1 {
-				for idx, pr := range runnable {
-					if idx > 0 && runner.Salience < pr.Salience {
-						runner = pr
-					}
-				}
+				runner = g.conflictResolver().Resolve(runnable).RuleEntry
+			}
+			if trace != nil {
+				trace.selected(runnable, runner)
 			}
 			// set the current rule entry to run. This is for trace ability purpose
 			dataCtx.SetRuleEntry(runner)
 			// notify listeners that we are about to execute a rule entry then scope
-			g.notifyExecuteRuleEntry(cycle, runner)
+			if err := g.notifyExecuteRuleEntry(cycle, runner); err != nil {
+
+				return err
+			}
 			// execute the top most prioritized rule
 			err := runner.Execute(ctx, dataCtx, knowledge.WorkingMemory)
 			if err != nil {
@@ -241,6 +391,9 @@ func (g *GruleEngine) ExecuteWithContext(ctx context.Context, dataCtx ast.IDataC
 
 				return fmt.Errorf("error while executing rule %s. got %w", runner.RuleName, err)
 			}
+			agenda.executed(runner)
+			// the first rule of an activation group to execute cancels the others.
+			knowledge.RetractActivationGroup(runner.ActivationGroup, runner.RuleName)
 
 			if dataCtx.IsComplete() {
 				break
@@ -258,13 +411,20 @@ func (g *GruleEngine) ExecuteWithContext(ctx context.Context, dataCtx ast.IDataC
 }
 
 // FetchMatchingRules function is responsible to fetch all the rules that matches to a fact against all rule entries
-// Returns []*ast.RuleEntry order by salience
-func (g *GruleEngine) FetchMatchingRules(dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase) ([]*ast.RuleEntry, error) {
+// Returns []*ast.RuleEntry order by salience, rules with equal salience are ordered by declaration.
+// If filters are given, only the rule entries selected by all of them are considered.
+func (g *GruleEngine) FetchMatchingRules(dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase, filters ...RuleFilter) ([]*ast.RuleEntry, error) {
 	if knowledge == nil || dataCtx == nil {
 
 		return nil, fmt.Errorf("nil KnowledgeBase or DataContext is not allowed")
 	}
 
+	err := knowledge.ValidateGlobals(dataCtx)
+	if err != nil {
+
+		return nil, err
+	}
+
 	log.Debugf("Starting rule matching using knowledge '%s' version %s. Contains %d rule entries", knowledge.Name, knowledge.Version, len(knowledge.RuleEntries))
 	// Prepare the build-in function and add to datacontext.
 	defunc := &ast.BuiltInFunctions{
@@ -272,7 +432,7 @@ func (g *GruleEngine) FetchMatchingRules(dataCtx ast.IDataContext, knowledge *as
 		WorkingMemory: knowledge.WorkingMemory,
 		DataContext:   dataCtx,
 	}
-	err := dataCtx.Add("DEFUNC", defunc)
+	err = dataCtx.Add("DEFUNC", defunc)
 	if err != nil {
 		log.Error("DEFUNC add err")
 
@@ -290,8 +450,9 @@ func (g *GruleEngine) FetchMatchingRules(dataCtx ast.IDataContext, knowledge *as
 	// Select all rule entry that can be executed.
 	log.Tracef("Select all rule entry that can be executed.")
 	runnable := make([]*ast.RuleEntry, 0)
+	now := g.now()
 	for _, entries := range knowledge.RuleEntries {
-		if !entries.Deleted {
+		if !entries.Deleted && entries.IsActiveAt(now) && accepts(filters, entries) {
 			// test if this rule entry v can execute.
 			can, err := entries.Evaluate(context.Background(), dataCtx, knowledge.WorkingMemory)
 			if err != nil {
@@ -309,8 +470,12 @@ func (g *GruleEngine) FetchMatchingRules(dataCtx ast.IDataContext, knowledge *as
 	log.Debugf("Matching rules length %d.", len(runnable))
 	if len(runnable) > 1 {
 		sort.SliceStable(runnable, func(i, j int) bool {
+			if runnable[i].Salience != runnable[j].Salience {
+
+				return runnable[i].Salience > runnable[j].Salience
+			}
 
-			return runnable[i].Salience > runnable[j].Salience
+			return runnable[i].Sequence < runnable[j].Sequence
 		})
 	}
 
@@ -0,0 +1,70 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/builder"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+// the name and version of the knowledge bases built by the tests.
+const (
+	testKnowledgeBaseName    = "EngineTest"
+	testKnowledgeBaseVersion = "0.1.1"
+)
+
+// buildKnowledgeBase calls build with the rule builder of a new knowledge library, and returns a new instance of the
+// knowledge base it built. The error returned by build is returned as is.
+func buildKnowledgeBase(t *testing.T, build func(rb *builder.RuleBuilder) error) (*ast.KnowledgeBase, error) {
+	t.Helper()
+	lib := ast.NewKnowledgeLibrary()
+	err := build(builder.NewRuleBuilder(lib))
+	if err != nil {
+
+		return nil, err
+	}
+
+	return lib.NewKnowledgeBaseInstance(testKnowledgeBaseName, testKnowledgeBaseVersion)
+}
+
+// newKnowledgeBase builds the GRL resources in their order, and returns a new instance of the knowledge base.
+func newKnowledgeBase(t *testing.T, grls ...string) (*ast.KnowledgeBase, error) {
+	t.Helper()
+
+	return buildKnowledgeBase(t, func(rb *builder.RuleBuilder) error {
+		for _, grl := range grls {
+			err := rb.BuildRuleFromResource(testKnowledgeBaseName, testKnowledgeBaseVersion, pkg.NewBytesResource([]byte(grl)))
+			if err != nil {
+
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// mustNewKnowledgeBase is the same as newKnowledgeBase, but the test fails if the knowledge base can not be built.
+func mustNewKnowledgeBase(t *testing.T, grls ...string) *ast.KnowledgeBase {
+	t.Helper()
+	kb, err := newKnowledgeBase(t, grls...)
+	assert.NoError(t, err)
+
+	return kb
+}
@@ -0,0 +1,146 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type IfFact struct {
+	Score    int
+	Grade    string
+	Stage    int
+	Reviewed bool
+	Bonus    int
+}
+
+const ifRules = `
+rule Grade "grades the score once" {
+	when
+		Fact.Stage == 0
+	then
+		if (Fact.Score >= 90) {
+			Fact.Grade = "A";
+		} else if (Fact.Score >= 70) {
+			Fact.Grade = "B";
+			if (Fact.Score >= 80) {
+				Fact.Bonus = 10;
+			}
+		} else {
+			Fact.Grade = "C";
+		}
+		Fact.Stage = 1;
+}
+
+rule Review "triggered by an assignment made inside a branch" {
+	when
+		Fact.Stage == 1 && Fact.Reviewed == false
+	then
+		if (Fact.Grade == "C") {
+			Fact.Stage = 2;
+		}
+		Fact.Reviewed = true;
+}
+`
+
+func executeIfRules(t *testing.T, kb *ast.KnowledgeBase, fact *IfFact) {
+	t.Helper()
+	dctx := ast.NewDataContext()
+	err := dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+}
+
+func TestIfStatement_Execution(t *testing.T) {
+	kb := mustNewKnowledgeBase(t, ifRules)
+
+	fact := &IfFact{Score: 95}
+	executeIfRules(t, kb, fact)
+	assert.Equal(t, "A", fact.Grade)
+	assert.Equal(t, 0, fact.Bonus)
+	assert.Equal(t, 1, fact.Stage)
+	assert.True(t, fact.Reviewed)
+
+	fact = &IfFact{Score: 85}
+	executeIfRules(t, kb, fact)
+	assert.Equal(t, "B", fact.Grade)
+	assert.Equal(t, 10, fact.Bonus)
+
+	fact = &IfFact{Score: 75}
+	executeIfRules(t, kb, fact)
+	assert.Equal(t, "B", fact.Grade)
+	assert.Equal(t, 0, fact.Bonus)
+
+	fact = &IfFact{Score: 10}
+	executeIfRules(t, kb, fact)
+	assert.Equal(t, "C", fact.Grade)
+	assert.Equal(t, 2, fact.Stage)
+	assert.True(t, fact.Reviewed)
+}
+
+func TestIfStatement_NonBooleanCondition(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule Bad "condition is not a boolean" {
+	when
+		Fact.Stage == 0
+	then
+		if (Fact.Score) {
+			Fact.Stage = 1;
+		}
+}`)
+	assert.NoError(t, err)
+
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", &IfFact{Score: 1})
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.Error(t, err)
+}
+
+func TestIfStatement_SerializationAndClone(t *testing.T) {
+	kb := mustNewKnowledgeBase(t, ifRules)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err := cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	assert.Equal(t, kb.RuleEntries["Grade"].GetSnapshot(), loadedKb.RuleEntries["Grade"].GetSnapshot())
+
+	fact := &IfFact{Score: 85}
+	executeIfRules(t, loadedKb, fact)
+	assert.Equal(t, "B", fact.Grade)
+	assert.Equal(t, 10, fact.Bonus)
+
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+	assert.Equal(t, kb.RuleEntries["Grade"].GetSnapshot(), clone.RuleEntries["Grade"].GetSnapshot())
+
+	fact = &IfFact{Score: 10}
+	executeIfRules(t, clone, fact)
+	assert.Equal(t, "C", fact.Grade)
+	assert.Equal(t, 2, fact.Stage)
+}
@@ -0,0 +1,193 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type LetOrder struct {
+	Sum      float64
+	Total    float64
+	Items    int
+	Discount float64
+	Level    string
+}
+
+const letRules = `
+rule Total "binds the total in the when scope" salience 10 {
+	when
+		let total = Order.Sum * 2;
+		Order.Total == 0 && total > 100
+	then
+		Order.Total = total;
+		Retract("Total");
+}
+
+rule Discount "uses then locals, which are reset when assigned again" {
+	when
+		Order.Items > 0
+	then
+		let discount = Order.Items * 0.5;
+		if (discount > 2) {
+			let level = "gold";
+			Order.Level = level;
+		} else {
+			let level = "silver";
+			Order.Level = level;
+		}
+		Order.Discount = Order.Discount + discount;
+		Order.Items = Order.Items - 2;
+}
+
+rule Other "a local with the same name in another rule" {
+	when
+		let total = Order.Sum;
+		total < 0
+	then
+		Order.Total = total;
+		Retract("Other");
+}
+`
+
+func executeLetRules(t *testing.T, kb *ast.KnowledgeBase, order *LetOrder) {
+	t.Helper()
+	dctx := ast.NewDataContext()
+	err := dctx.Add("Order", order)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	// the local variables never leak into the data context.
+	assert.Nil(t, dctx.Get("total"))
+	assert.Nil(t, dctx.Get("discount"))
+}
+
+func TestLetStatement_Execution(t *testing.T) {
+	kb, err := newKnowledgeBase(t, letRules)
+	assert.NoError(t, err)
+
+	order := &LetOrder{Sum: 60, Items: 6}
+	executeLetRules(t, kb, order)
+	assert.Equal(t, 120.0, order.Total)
+	// 6 items give 3, then 4 items give 2, then 2 items give 1.
+	assert.Equal(t, 6.0, order.Discount)
+	assert.Equal(t, "silver", order.Level)
+	assert.Equal(t, 0, order.Items)
+
+	order = &LetOrder{Sum: 40}
+	executeLetRules(t, kb, order)
+	assert.Equal(t, 0.0, order.Total)
+}
+
+func TestLetStatement_BindingFollowsFacts(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule Raise "raises the sum until the binding is satisfied" {
+	when
+		let double = Order.Sum * 2;
+		double < 100
+	then
+		Order.Sum = Order.Sum + 10;
+}
+
+rule Done "fires once the binding of the other rule is satisfied" {
+	when
+		let double = Order.Sum * 2;
+		double >= 100 && Order.Level == ""
+	then
+		Order.Level = "done";
+		Order.Total = double;
+}`)
+	assert.NoError(t, err)
+	order := &LetOrder{Sum: 10}
+	executeLetRules(t, kb, order)
+	assert.Equal(t, 50.0, order.Sum)
+	assert.Equal(t, 100.0, order.Total)
+	assert.Equal(t, "done", order.Level)
+}
+
+func TestLetStatement_Errors(t *testing.T) {
+	_, err := newKnowledgeBase(t, `
+rule Twice "declares the same local twice" {
+	when
+		let a = 1;
+		a > 0
+	then
+		let a = 2;
+		Retract("Twice");
+}`)
+	assert.Error(t, err)
+
+	_, err = newKnowledgeBase(t, `
+rule Shadow "declares a local already declared by the enclosing block" {
+	when
+		true
+	then
+		let a = 1;
+		if (a > 0) {
+			let a = 2;
+		}
+		Retract("Shadow");
+}`)
+	assert.Error(t, err)
+
+	kb, err := newKnowledgeBase(t, `
+rule Bound "assigns a local bound in the when scope" {
+	when
+		let a = Order.Sum;
+		a == 0
+	then
+		a = 3;
+}`)
+	assert.NoError(t, err)
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Order", &LetOrder{})
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.Error(t, err)
+}
+
+func TestLetStatement_SerializationAndClone(t *testing.T) {
+	kb, err := newKnowledgeBase(t, letRules)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	assert.Equal(t, kb.RuleEntries["Total"].GetSnapshot(), loadedKb.RuleEntries["Total"].GetSnapshot())
+
+	order := &LetOrder{Sum: 60, Items: 6}
+	executeLetRules(t, loadedKb, order)
+	assert.Equal(t, 120.0, order.Total)
+	assert.Equal(t, 6.0, order.Discount)
+
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+	order = &LetOrder{Sum: 60, Items: 6}
+	executeLetRules(t, clone, order)
+	assert.Equal(t, 120.0, order.Total)
+	assert.Equal(t, 6.0, order.Discount)
+}
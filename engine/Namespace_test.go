@@ -0,0 +1,206 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/builder"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type Quote struct {
+	Total    float64
+	Vip      bool
+	Discount float64
+	Net      float64
+	Fee      float64
+	Shipped  bool
+}
+
+const commonNamespace = `
+package com.acme.common;
+
+const VIP_RATE = 0.2;
+
+function Half(x) {
+	return x / 2;
+}
+`
+
+const pricingNamespace = `
+package com.acme.pricing;
+
+import com.acme.common.VIP_RATE;
+import com.acme.common.*;
+
+rule Discount "applies the vip discount" salience 10 {
+	when
+		Quote.Vip
+	then
+		Quote.Discount = Quote.Total * VIP_RATE;
+		Quote.Net = Half(Quote.Total);
+		Retract("Discount");
+}
+`
+
+const shippingNamespace = `
+package com.acme.shipping;
+
+rule Discount "ships the vip quotes" {
+	when
+		Quote.Vip && !Quote.Shipped
+	then
+		Quote.Fee = Half(Quote.Total);
+		Quote.Shipped = true;
+}
+
+function Half(x) {
+	return x / 4;
+}
+`
+
+type executionRecorder struct {
+	executed []string
+}
+
+func (l *executionRecorder) EvaluateRuleEntry(cycle uint64, entry *ast.RuleEntry, candidate bool) {}
+
+func (l *executionRecorder) ExecuteRuleEntry(cycle uint64, entry *ast.RuleEntry) {
+	l.executed = append(l.executed, entry.RuleName)
+}
+
+func (l *executionRecorder) BeginCycle(cycle uint64) {}
+
+func TestNamespace_QualifiedRuleNames(t *testing.T) {
+	kb, err := newKnowledgeBase(t, commonNamespace, pricingNamespace, shippingNamespace)
+	assert.NoError(t, err)
+	assert.Len(t, kb.RuleEntries, 2)
+	assert.Equal(t, "com.acme.pricing", kb.RuleEntries["com.acme.pricing.Discount"].Package)
+	assert.Equal(t, "com.acme.shipping", kb.RuleEntries["com.acme.shipping.Discount"].Package)
+
+	quote := &Quote{Total: 200, Vip: true}
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Quote", quote))
+	recorder := &executionRecorder{}
+	engine := NewGruleEngine()
+	engine.Listeners = append(engine.Listeners, recorder)
+	err = engine.Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"com.acme.pricing.Discount", "com.acme.shipping.Discount"}, recorder.executed)
+	assert.True(t, kb.IsRuleRetracted("com.acme.pricing.Discount"))
+	assert.False(t, kb.IsRuleRetracted("com.acme.shipping.Discount"))
+	assert.InDelta(t, 40, quote.Discount, 0.0001)
+	assert.InDelta(t, 100, quote.Net, 0.0001)
+	assert.InDelta(t, 50, quote.Fee, 0.0001)
+}
+
+func TestNamespace_Errors(t *testing.T) {
+	for _, grls := range [][]string{
+		{commonNamespace, `package com.acme.pricing; import com.acme.common.Missing;`},
+		{`package com.acme.pricing; import com.acme.common.*;`},
+		{commonNamespace, `package com.acme.other; const VIP_RATE = 0.3;`, `
+package com.acme.pricing;
+import com.acme.common.VIP_RATE;
+import com.acme.other.VIP_RATE;`},
+		{pricingNamespace},
+		{commonNamespace, pricingNamespace, pricingNamespace},
+		{commonNamespace, `package com.acme.common; function Max(x) { return x; }`},
+	} {
+		_, err := newKnowledgeBase(t, grls...)
+		assert.Error(t, err, grls)
+	}
+}
+
+const pricingTemplate = `
+package com.acme.pricing;
+
+import com.acme.common.*;
+
+rule Discount_@{Region} "applies the vip discount in @{Region}" {
+	when
+		Quote.Vip && Quote.Discount == 0
+	then
+		Quote.Discount = Half(Quote.Total) * VIP_RATE;
+}
+`
+
+func TestNamespace_Templates(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("NamespaceTest", "0.1.1", pkg.NewBytesResource([]byte(commonNamespace)))
+	assert.NoError(t, err)
+	// the rules expanded from a template may import functions and consts.
+	err = rb.BuildRulesFromBundle("NamespaceTest", "0.1.1", pkg.NewCSVTemplateResourceBundle(
+		pkg.NewBytesResource([]byte(pricingTemplate)), pkg.NewBytesResource([]byte("Region\nEU\n"))))
+	assert.NoError(t, err)
+	err = rb.BuildRuleFromResource("NamespaceTest", "0.1.1", pkg.NewBytesResource([]byte(shippingNamespace)))
+	assert.NoError(t, err)
+
+	kb, err := lib.NewKnowledgeBaseInstance("NamespaceTest", "0.1.1")
+	assert.NoError(t, err)
+	quote := &Quote{Total: 200, Vip: true}
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Quote", quote))
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.InDelta(t, 20, quote.Discount, 0.0001)
+
+	// neither a template nor a rule can be imported.
+	err = rb.BuildRuleFromResource("NamespaceTest", "0.1.1", pkg.NewBytesResource([]byte(`
+package com.acme.other;
+import com.acme.pricing.Discount_EU;`)))
+	assert.IsType(t, &pkg.GruleErrorReporter{}, err)
+	assert.ErrorContains(t, err.(*pkg.GruleErrorReporter).Errors[0], "templates can not be imported")
+	err = rb.BuildRuleFromResource("NamespaceTest", "0.1.1", pkg.NewBytesResource([]byte(`
+package com.acme.other;
+import com.acme.shipping.Discount;`)))
+	assert.IsType(t, &pkg.GruleErrorReporter{}, err)
+	assert.ErrorContains(t, err.(*pkg.GruleErrorReporter).Errors[0], "is a rule, only functions and consts can be imported")
+}
+
+func TestNamespace_SerializationAndClone(t *testing.T) {
+	kb, err := newKnowledgeBase(t, commonNamespace, pricingNamespace, shippingNamespace)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	assert.True(t, kb.IsIdentical(loadedKb))
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+	assert.True(t, kb.IsIdentical(clone))
+
+	for _, knowledge := range []*ast.KnowledgeBase{loadedKb, clone} {
+		quote := &Quote{Total: 200, Vip: true}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Quote", quote))
+		err = NewGruleEngine().Execute(dctx, knowledge)
+		assert.NoError(t, err)
+		assert.Equal(t, "com.acme.pricing", knowledge.RuleEntries["com.acme.pricing.Discount"].Package)
+		assert.InDelta(t, 100, quote.Net, 0.0001)
+		assert.InDelta(t, 50, quote.Fee, 0.0001)
+	}
+}
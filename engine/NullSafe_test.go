@@ -0,0 +1,229 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type PostalAddress struct {
+	City string
+	Zip  string
+}
+
+func (a *PostalAddress) Label() string {
+
+	return a.City + " " + a.Zip
+}
+
+type Patron struct {
+	Address *PostalAddress
+}
+
+type Delivery struct {
+	City  string
+	Label string
+	Zip   string
+	Done  bool
+}
+
+const nullSafeRules = `
+rule Deliver "reads the optional address of the patron" {
+	when
+		!Delivery.Done
+	then
+		Delivery.City = Patron?.Address?.City ?? "unknown";
+		Delivery.Label = Patron.Address?.Label() ?? "no label";
+		Delivery.Zip = Order?.Customer?.Address?.Zip ?? Order?.Zip ?? "00000";
+		Delivery.Done = true;
+}
+`
+
+func TestNullSafe_Navigation(t *testing.T) {
+	kb, err := newKnowledgeBase(t, nullSafeRules)
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		patron *Patron
+		order  string
+		city   string
+		label  string
+		zip    string
+	}{
+		{&Patron{Address: &PostalAddress{City: "Delft", Zip: "2611"}}, `{"Customer":{"Address":{"Zip":"1011"}}}`, "Delft", "Delft 2611", "1011"},
+		{&Patron{}, `{"Customer":{"Address":null},"Zip":"3011"}`, "unknown", "no label", "3011"},
+		{&Patron{}, `{"Customer":{}}`, "unknown", "no label", "00000"},
+		{&Patron{}, `{}`, "unknown", "no label", "00000"},
+	} {
+		delivery := &Delivery{}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Patron", tc.patron))
+		assert.NoError(t, dctx.Add("Delivery", delivery))
+		assert.NoError(t, dctx.AddJSON("Order", []byte(tc.order)))
+		err = NewGruleEngine().Execute(dctx, kb)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.city, delivery.City)
+		assert.Equal(t, tc.label, delivery.Label)
+		assert.Equal(t, tc.zip, delivery.Zip)
+	}
+}
+
+func TestNullSafe_WhenScope(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule Local "matches deliveries to Delft" {
+	when
+		Patron?.Address?.City == "Delft" && !Delivery.Done
+	then
+		Delivery.Done = true;
+}`)
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		patron *Patron
+		done   bool
+	}{
+		{&Patron{Address: &PostalAddress{City: "Delft"}}, true},
+		{&Patron{}, false},
+	} {
+		delivery := &Delivery{}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Patron", tc.patron))
+		assert.NoError(t, dctx.Add("Delivery", delivery))
+		engine := NewGruleEngine()
+		engine.ReturnErrOnFailedRuleEvaluation = true
+		err = engine.Execute(dctx, kb)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.done, delivery.Done)
+	}
+}
+
+type Recipient struct {
+	Address *PostalAddress
+	Cities  []string
+	Zips    map[string]string
+	Visits  int
+	Done    bool
+}
+
+func TestNullSafe_AssignNil(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule Clear "assigns the nil of null-safe chains" {
+	when
+		!Recipient.Done
+	then
+		Recipient.Cities[0] = Patron?.Address?.City;
+		Recipient.Zips["home"] = Patron?.Address?.Zip;
+		Order.Zip = Patron?.Address?.Zip;
+		Recipient.Address = Patron?.Address;
+		Patron.Address?.City = "Delft";
+		Recipient.Done = true;
+}`)
+	assert.NoError(t, err)
+
+	recipient := &Recipient{
+		Address: &PostalAddress{City: "Leiden"},
+		Cities:  []string{"Leiden"},
+		Zips:    map[string]string{"home": "2311"},
+	}
+	patron := &Patron{}
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Patron", patron))
+	assert.NoError(t, dctx.Add("Recipient", recipient))
+	assert.NoError(t, dctx.AddJSON("Order", []byte(`{"Zip":"3011"}`)))
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.True(t, recipient.Done)
+	assert.Equal(t, "", recipient.Cities[0])
+	zip, ok := recipient.Zips["home"]
+	assert.True(t, ok)
+	assert.Equal(t, "", zip)
+	assert.Nil(t, recipient.Address)
+	// the null-safe assignment target is left unassigned, the patron has no address.
+	assert.Nil(t, patron.Address)
+	assert.Nil(t, dctx.Get("Order").Value().MapIndex(reflect.ValueOf("Zip")).Interface())
+}
+
+func TestNullSafe_AssignPlainNil(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule Clear "assigns a nil that is not from a null-safe chain" {
+	when
+		!Recipient.Done
+	then
+		Recipient.Visits = nil;
+		Recipient.Done = true;
+}`)
+	assert.NoError(t, err)
+	recipient := &Recipient{Visits: 3}
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Recipient", recipient))
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.Error(t, err)
+	assert.Equal(t, 3, recipient.Visits)
+	assert.False(t, recipient.Done)
+}
+
+func TestNullSafe_UndefinedJSONField(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule Strict "reads an undefined field without null-safe navigation" {
+	when
+		!Delivery.Done
+	then
+		Delivery.Zip = Order.Zip ?? "00000";
+		Delivery.Done = true;
+}`)
+	assert.NoError(t, err)
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Delivery", &Delivery{}))
+	assert.NoError(t, dctx.AddJSON("Order", []byte(`{}`)))
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.Error(t, err)
+}
+
+func TestNullSafe_SerializationAndClone(t *testing.T) {
+	kb, err := newKnowledgeBase(t, nullSafeRules)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+
+	for _, knowledge := range []*ast.KnowledgeBase{loadedKb, clone} {
+		delivery := &Delivery{}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Patron", &Patron{}))
+		assert.NoError(t, dctx.Add("Delivery", delivery))
+		assert.NoError(t, dctx.AddJSON("Order", []byte(`{"Customer":null}`)))
+		err = NewGruleEngine().Execute(dctx, knowledge)
+		assert.NoError(t, err)
+		assert.Equal(t, "unknown", delivery.City)
+		assert.Equal(t, "no label", delivery.Label)
+		assert.Equal(t, "00000", delivery.Zip)
+	}
+}
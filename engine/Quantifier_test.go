@@ -0,0 +1,244 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type BasketItem struct {
+	Name    string
+	Price   int
+	Shipped bool
+}
+
+type Basket struct {
+	Items     []*BasketItem
+	Stock     map[string]int
+	Expensive bool
+	Shipped   bool
+	Cheap     int64
+	Empty     bool
+	Restock   bool
+}
+
+const quantifierRules = `
+rule Expensive "any item above 100" {
+	when
+		exists(x in Basket.Items : x.Price > 100) && !Basket.Expensive
+	then
+		Basket.Expensive = true;
+}
+
+rule Shipped "all items shipped" {
+	when
+		forall(item in Basket.Items : item.Shipped) && !Basket.Shipped
+	then
+		Basket.Shipped = true;
+}
+
+rule Cheap "counts the cheap items" {
+	when
+		Basket.Cheap == 0
+	then
+		Basket.Cheap = count(x in Basket.Items : x.Price < 50 && x.Name != "");
+}
+
+rule Restock "some stock is empty" {
+	when
+		ANY(q in Basket.Stock : q == 0) && !Basket.Restock
+	then
+		Basket.Restock = true;
+}
+`
+
+func TestQuantifier_GoValues(t *testing.T) {
+	kb, err := newKnowledgeBase(t, quantifierRules)
+	assert.NoError(t, err)
+
+	basket := &Basket{
+		Items: []*BasketItem{
+			{Name: "pen", Price: 10, Shipped: true},
+			{Name: "book", Price: 40, Shipped: false},
+			{Name: "lamp", Price: 120, Shipped: true},
+		},
+		Stock: map[string]int{"pen": 3, "book": 0},
+	}
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Basket", basket)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.True(t, basket.Expensive)
+	assert.False(t, basket.Shipped)
+	assert.Equal(t, int64(2), basket.Cheap)
+	assert.True(t, basket.Restock)
+
+	empty := &Basket{Items: []*BasketItem{}, Stock: map[string]int{}, Cheap: 5}
+	dctx = ast.NewDataContext()
+	err = dctx.Add("Basket", empty)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.False(t, empty.Expensive)
+	// forall holds for an empty collection.
+	assert.True(t, empty.Shipped)
+	assert.False(t, empty.Restock)
+}
+
+func TestQuantifier_ElementChange(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule Ship "ships the first item" {
+	when
+		!Basket.Items[0].Shipped
+	then
+		Basket.Items[0].Shipped = true;
+}
+
+rule Shipped "all items shipped" {
+	when
+		forall(item in Basket.Items : item.Shipped) && !Basket.Shipped
+	then
+		Basket.Shipped = true;
+}`)
+	assert.NoError(t, err)
+	basket := &Basket{Items: []*BasketItem{{Name: "pen"}, {Name: "book", Shipped: true}}}
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Basket", basket)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.True(t, basket.Items[0].Shipped)
+	// the change of an element re-evaluated the quantifier.
+	assert.True(t, basket.Shipped)
+}
+
+func TestQuantifier_JSON(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule Expensive "any item above 100" {
+	when
+		exists(x in Basket.Items : x.Price > 100) && Result.Count == 0
+	then
+		Result.Count = count(x in Basket.Items : x.Price > 100);
+		Result.AllNamed = forall(x in Basket.Items : x.Name != "");
+}`)
+	assert.NoError(t, err)
+	dctx := ast.NewDataContext()
+	err = dctx.AddJSON("Basket", []byte(`{"Items": [{"Name": "pen", "Price": 10}, {"Name": "lamp", "Price": 120}, {"Name": "desk", "Price": 300}]}`))
+	assert.NoError(t, err)
+	result := &struct {
+		Count    int64
+		AllNamed bool
+	}{}
+	err = dctx.Add("Result", result)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), result.Count)
+	assert.True(t, result.AllNamed)
+}
+
+func TestQuantifier_MapValues(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule Stock "quantifies the values of the stock map" {
+	when
+		Result.Count == 0
+	then
+		Result.Count = count(q in Basket.Stock : q > 1);
+		Result.Keyed = "pen" in Basket.Stock && !exists(q in Basket.Stock : q > 3);
+		Result.Total = sum(q for q in Basket.Stock);
+		Result.Prices = count(p in Prices : p > 50);
+}`)
+	assert.NoError(t, err)
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Basket", &Basket{Stock: map[string]int{"pen": 3, "book": 0, "lamp": 2}})
+	assert.NoError(t, err)
+	err = dctx.AddJSON("Prices", []byte(`{"pen": 10, "lamp": 120, "desk": 300}`))
+	assert.NoError(t, err)
+	result := &struct {
+		Count  int64
+		Keyed  bool
+		Total  int64
+		Prices int64
+	}{}
+	err = dctx.Add("Result", result)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	// the values are bound, the keys are tested with in.
+	assert.Equal(t, int64(2), result.Count)
+	assert.True(t, result.Keyed)
+	assert.Equal(t, int64(5), result.Total)
+	assert.Equal(t, int64(2), result.Prices)
+}
+
+func TestQuantifier_Errors(t *testing.T) {
+	_, err := newKnowledgeBase(t, `
+rule Unknown "not a quantifier" {
+	when
+		some(x in Basket.Items : x.Shipped)
+	then
+		Retract("Unknown");
+}`)
+	assert.Error(t, err)
+
+	kb, err := newKnowledgeBase(t, `
+rule NotBool "the predicate is not a boolean" {
+	when
+		!Basket.Expensive
+	then
+		Basket.Expensive = exists(x in Basket.Items : x.Price);
+}`)
+	assert.NoError(t, err)
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Basket", &Basket{Items: []*BasketItem{{Price: 1}}})
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.Error(t, err)
+}
+
+func TestQuantifier_SerializationAndClone(t *testing.T) {
+	kb, err := newKnowledgeBase(t, quantifierRules)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+
+	for _, knowledge := range []*ast.KnowledgeBase{loadedKb, clone} {
+		basket := &Basket{Items: []*BasketItem{{Name: "lamp", Price: 120, Shipped: true}}, Cheap: 1}
+		dctx := ast.NewDataContext()
+		err = dctx.Add("Basket", basket)
+		assert.NoError(t, err)
+		err = NewGruleEngine().Execute(dctx, knowledge)
+		assert.NoError(t, err)
+		assert.True(t, basket.Expensive)
+		assert.True(t, basket.Shipped)
+	}
+}
@@ -0,0 +1,143 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type CampaignFact struct {
+	Applied string
+}
+
+const campaignRules = `
+rule Winter "winter campaign" date-effective "2024-01-01" date-expires "2024-03-01" no-loop {
+	when
+		true
+	then
+		Fact.Applied = Fact.Applied + "W";
+}
+
+rule Spring "spring campaign" date-effective "2024-03-01 00:00" no-loop {
+	when
+		true
+	then
+		Fact.Applied = Fact.Applied + "S";
+}
+
+rule Off "switched off" enabled false no-loop {
+	when
+		true
+	then
+		Fact.Applied = Fact.Applied + "X";
+}
+
+rule Always "explicitly enabled" enabled true no-loop {
+	when
+		true
+	then
+		Fact.Applied = Fact.Applied + "A";
+}
+`
+
+func activeRuleNames(kb *ast.KnowledgeBase, instant time.Time) []string {
+	names := make([]string, 0)
+	for _, entry := range kb.GetActiveRuleEntries(instant) {
+		names = append(names, entry.RuleName)
+	}
+
+	return names
+}
+
+func TestRuleDates_ActiveRuleEntries(t *testing.T) {
+	kb := mustNewKnowledgeBase(t, campaignRules)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), kb.RuleEntries["Winter"].DateEffective)
+	assert.True(t, kb.RuleEntries["Off"].Disabled)
+
+	assert.Equal(t, []string{"Always"}, activeRuleNames(kb, time.Date(2023, 12, 31, 23, 59, 59, 0, time.Local)))
+	assert.Equal(t, []string{"Winter", "Always"}, activeRuleNames(kb, time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)))
+	// the expiry date is exclusive.
+	assert.Equal(t, []string{"Spring", "Always"}, activeRuleNames(kb, time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)))
+}
+
+func TestRuleDates_ExecuteWithClock(t *testing.T) {
+	for _, test := range []struct {
+		now      time.Time
+		expected string
+	}{
+		{time.Date(2023, 6, 1, 0, 0, 0, 0, time.Local), "A"},
+		{time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local), "WA"},
+		{time.Date(2024, 6, 1, 0, 0, 0, 0, time.Local), "SA"},
+	} {
+		fact := &CampaignFact{}
+		dctx := ast.NewDataContext()
+		err := dctx.Add("Fact", fact)
+		assert.NoError(t, err)
+
+		now := test.now
+		eng := NewGruleEngine()
+		eng.Clock = func() time.Time {
+
+			return now
+		}
+		err = eng.Execute(dctx, mustNewKnowledgeBase(t, campaignRules))
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, fact.Applied)
+
+		matching, err := eng.FetchMatchingRules(dctx, mustNewKnowledgeBase(t, campaignRules))
+		assert.NoError(t, err)
+		assert.Equal(t, len(test.expected), len(matching))
+	}
+}
+
+func TestRuleDates_Serialization(t *testing.T) {
+	kb := mustNewKnowledgeBase(t, campaignRules)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err := cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	assert.True(t, loadedKb.RuleEntries["Winter"].DateExpires.Equal(kb.RuleEntries["Winter"].DateExpires))
+	assert.True(t, loadedKb.RuleEntries["Spring"].DateExpires.IsZero())
+	assert.True(t, loadedKb.RuleEntries["Off"].Disabled)
+
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+	assert.Equal(t, kb.RuleEntries["Winter"].DateEffective, clone.RuleEntries["Winter"].DateEffective)
+	assert.True(t, clone.RuleEntries["Off"].Disabled)
+}
+
+func TestRuleDates_InvalidDate(t *testing.T) {
+	_, err := newKnowledgeBase(t, `
+rule Invalid "not a date" date-effective "next monday" {
+	when
+		true
+	then
+		Complete();
+}`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,131 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type Claim struct {
+	Amount   float64
+	Verified bool
+	Urgent   bool
+	Reviewed bool
+	Approved bool
+	Escalate bool
+	Paid     bool
+}
+
+const claimBaseRules = `
+rule Eligible "matches the verified claims not yet reviewed" {
+	when
+		Claim.Verified && !Claim.Reviewed
+	then
+		Claim.Reviewed = true;
+}
+`
+
+const claimExtendedRules = `
+rule Escalated extends Approved "escalates the large urgent claims" salience 20 {
+	when
+		Claim.Urgent
+	then
+		Claim.Escalate = true;
+		Retract("Escalated");
+}
+
+rule Approved extends Eligible "approves the small claims" salience 10 {
+	when
+		Claim.Amount < 1000
+	then
+		Claim.Approved = true;
+		Retract("Approved");
+}
+`
+
+func TestRuleExtends_Condition(t *testing.T) {
+	kb, err := newKnowledgeBase(t, claimBaseRules, claimExtendedRules)
+	assert.NoError(t, err)
+	assert.Equal(t, "Eligible", kb.RuleEntries["Approved"].Extends)
+
+	for _, tc := range []struct {
+		claim    *Claim
+		approved bool
+		escalate bool
+	}{
+		{&Claim{Amount: 500, Verified: true}, true, false},
+		{&Claim{Amount: 500, Verified: true, Urgent: true}, true, true},
+		{&Claim{Amount: 5000, Verified: true, Urgent: true}, false, false},
+		{&Claim{Amount: 500, Urgent: true}, false, false},
+	} {
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Claim", tc.claim))
+		err = NewGruleEngine().Execute(dctx, kb)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.approved, tc.claim.Approved)
+		assert.Equal(t, tc.escalate, tc.claim.Escalate)
+	}
+}
+
+func TestRuleExtends_Errors(t *testing.T) {
+	for _, grls := range [][]string{
+		{`rule Paid extends Missing "extends a missing rule" { when true then Claim.Paid = true; }`},
+		{claimExtendedRules, claimBaseRules},
+		{`rule Paid extends Paid "extends itself" { when true then Claim.Paid = true; }`},
+		{`
+rule First extends Second "extends the second rule" { when true then Claim.Paid = true; }
+rule Second extends Third "extends the third rule" { when true then Claim.Paid = true; }
+rule Third extends First "extends the first rule" { when true then Claim.Paid = true; }`},
+	} {
+		_, err := newKnowledgeBase(t, grls...)
+		assert.Error(t, err, grls)
+	}
+}
+
+func TestRuleExtends_SerializationAndClone(t *testing.T) {
+	kb, err := newKnowledgeBase(t, claimBaseRules, claimExtendedRules)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	assert.True(t, kb.IsIdentical(loadedKb))
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+	assert.True(t, kb.IsIdentical(clone))
+
+	for _, knowledge := range []*ast.KnowledgeBase{loadedKb, clone} {
+		assert.Equal(t, "Approved", knowledge.RuleEntries["Escalated"].Extends)
+		claim := &Claim{Amount: 500, Verified: true, Urgent: true}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Claim", claim))
+		err = NewGruleEngine().Execute(dctx, knowledge)
+		assert.NoError(t, err)
+		assert.True(t, claim.Approved)
+		assert.True(t, claim.Escalate)
+	}
+}
@@ -0,0 +1,79 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+)
+
+// RuleFilter selects the rule entries considered by an execution. It returns false for the rule entries to ignore.
+type RuleFilter func(ruleEntry *ast.RuleEntry) bool
+
+// WithAnyTag selects the rule entries having at least one of the tags.
+func WithAnyTag(tags ...string) RuleFilter {
+
+	return func(ruleEntry *ast.RuleEntry) bool {
+		for _, tag := range tags {
+			if ruleEntry.HasTag(tag) {
+
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// WithAllTags selects the rule entries having all the tags.
+func WithAllTags(tags ...string) RuleFilter {
+
+	return func(ruleEntry *ast.RuleEntry) bool {
+		for _, tag := range tags {
+			if !ruleEntry.HasTag(tag) {
+
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// WithMetadata selects the rule entries having a metadata annotation with the given name and value.
+func WithMetadata(name, value string) RuleFilter {
+
+	return func(ruleEntry *ast.RuleEntry) bool {
+		for _, v := range ruleEntry.GetMetadata(name) {
+			if v == value {
+
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// accepts returns true if the rule entry is selected by all the filters.
+func accepts(filters []RuleFilter, ruleEntry *ast.RuleEntry) bool {
+	for _, filter := range filters {
+		if filter != nil && !filter(ruleEntry) {
+
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,111 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type TaggedFact struct {
+	Applied string
+}
+
+const taggedRules = `
+rule Price "pricing rule" @tag("pricing") @owner("team-x") no-loop {
+	when
+		true
+	then
+		Fact.Applied = Fact.Applied + "P";
+}
+
+rule VipPrice "vip pricing rule" @tag("pricing", "vip") @owner("team-y") no-loop {
+	when
+		true
+	then
+		Fact.Applied = Fact.Applied + "V";
+}
+
+rule Shipping "shipping rule" @tag("shipping") @reviewed no-loop {
+	when
+		true
+	then
+		Fact.Applied = Fact.Applied + "S";
+}
+`
+
+func TestRuleMetadata_Parsing(t *testing.T) {
+	kb := mustNewKnowledgeBase(t, taggedRules)
+	vip := kb.RuleEntries["VipPrice"]
+	assert.Equal(t, []string{"pricing", "vip"}, vip.GetTags())
+	assert.Equal(t, []string{"team-y"}, vip.GetMetadata("owner"))
+	assert.True(t, vip.HasTag("vip"))
+	assert.False(t, kb.RuleEntries["Price"].HasTag("vip"))
+	assert.True(t, kb.RuleEntries["Shipping"].HasMetadata("reviewed"))
+	assert.Contains(t, vip.GetSnapshot(), `@owner["team-y"] @tag["pricing" "vip"]`)
+}
+
+func TestRuleMetadata_FilteredExecution(t *testing.T) {
+	for _, test := range []struct {
+		filters  []RuleFilter
+		expected string
+	}{
+		{nil, "PVS"},
+		{[]RuleFilter{WithAnyTag("pricing")}, "PV"},
+		{[]RuleFilter{WithAnyTag("vip", "shipping")}, "VS"},
+		{[]RuleFilter{WithAllTags("pricing", "vip")}, "V"},
+		{[]RuleFilter{WithAnyTag("pricing"), WithMetadata("owner", "team-x")}, "P"},
+		{[]RuleFilter{WithAnyTag("unknown")}, ""},
+	} {
+		fact := &TaggedFact{}
+		dctx := ast.NewDataContext()
+		err := dctx.Add("Fact", fact)
+		assert.NoError(t, err)
+
+		err = NewGruleEngine().ExecuteWithContext(context.Background(), dctx, mustNewKnowledgeBase(t, taggedRules), test.filters...)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, fact.Applied)
+
+		matching, err := NewGruleEngine().FetchMatchingRules(dctx, mustNewKnowledgeBase(t, taggedRules), test.filters...)
+		assert.NoError(t, err)
+		assert.Equal(t, len(test.expected), len(matching))
+	}
+}
+
+func TestRuleMetadata_Serialization(t *testing.T) {
+	kb := mustNewKnowledgeBase(t, taggedRules)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err := cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	assert.Equal(t, kb.RuleEntries["VipPrice"].Metadata, loadedKb.RuleEntries["VipPrice"].Metadata)
+	assert.Equal(t, kb.RuleEntries["Shipping"].Metadata, loadedKb.RuleEntries["Shipping"].Metadata)
+
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+	assert.Equal(t, kb.RuleEntries["VipPrice"].Metadata, clone.RuleEntries["VipPrice"].Metadata)
+}
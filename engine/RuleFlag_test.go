@@ -0,0 +1,153 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type FlagFact struct {
+	Price    int
+	Bonus    int
+	Counter  int
+	Done     bool
+	Resolved int
+}
+
+const flagRules = `
+rule Discount "modifies the fact it matches on" no-loop {
+	when
+		Fact.Price > 100
+	then
+		Fact.Price = Fact.Price - 10;
+}
+
+rule Bonus "modified by another rule, but locked" lock-on-active true {
+	when
+		Fact.Counter >= 0
+	then
+		Fact.Bonus = Fact.Bonus + 1;
+}
+
+rule Count "keeps changing the counter" {
+	when
+		Fact.Counter < 3
+	then
+		Fact.Counter = Fact.Counter + 1;
+}
+
+rule Loop "not a no-loop rule" no-loop false {
+	when
+		Fact.Done == false && Fact.Counter == 3
+	then
+		Fact.Resolved = Fact.Resolved + 1;
+		Fact.Done = Fact.Resolved == 2;
+}
+`
+
+func TestRuleFlags_Execution(t *testing.T) {
+	fact := &FlagFact{Price: 200}
+	dctx := ast.NewDataContext()
+	err := dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+
+	kb, err := newKnowledgeBase(t, flagRules)
+	assert.NoError(t, err)
+	assert.True(t, kb.RuleEntries["Discount"].NoLoop)
+	assert.True(t, kb.RuleEntries["Bonus"].LockOnActive)
+	assert.False(t, kb.RuleEntries["Loop"].NoLoop)
+
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	// the discount did not activate itself again.
+	assert.Equal(t, 190, fact.Price)
+	// the counter changes did not activate the bonus again.
+	assert.Equal(t, 1, fact.Bonus)
+	assert.Equal(t, 3, fact.Counter)
+	// without no-loop, a rule is activated again by its own changes.
+	assert.Equal(t, 2, fact.Resolved)
+}
+
+func TestRuleFlags_LockReleasedByFocus(t *testing.T) {
+	fact := &FlagFact{}
+	dctx := ast.NewDataContext()
+	err := dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+
+	kb, err := newKnowledgeBase(t, `
+rule Bonus "locked until the main group loses the focus" lock-on-active {
+	when
+		Fact.Counter < 3
+	then
+		Fact.Bonus = Fact.Bonus + 1;
+		Fact.Counter = Fact.Counter + 1;
+		SetFocus("other");
+}
+
+rule Other "changes the counter once the main group lost the focus" agenda-group "other" {
+	when
+		Fact.Counter == Fact.Bonus
+	then
+		Fact.Price = Fact.Price + 1;
+		Fact.Counter = Fact.Counter + 0;
+		Retract("Other");
+}`)
+	assert.NoError(t, err)
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	// Bonus was released when the focus went to the other group, then activated again by the change of Other.
+	assert.Equal(t, 2, fact.Bonus)
+	assert.Equal(t, 1, fact.Price)
+}
+
+func TestRuleFlags_Serialization(t *testing.T) {
+	kb, err := newKnowledgeBase(t, flagRules)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	assert.True(t, loadedKb.RuleEntries["Discount"].NoLoop)
+	assert.True(t, loadedKb.RuleEntries["Bonus"].LockOnActive)
+	assert.False(t, loadedKb.RuleEntries["Count"].NoLoop)
+
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+	assert.True(t, clone.RuleEntries["Discount"].NoLoop)
+	assert.True(t, clone.RuleEntries["Bonus"].LockOnActive)
+}
+
+func TestRuleFlags_DuplicateAttribute(t *testing.T) {
+	_, err := newKnowledgeBase(t, `
+rule Twice "two no-loop" no-loop no-loop {
+	when
+		true
+	then
+		Complete();
+}`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,236 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/builder"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type FlowOrder struct {
+	Quantity int
+	Valid    bool
+	Weight   int
+	Price    int
+	Stages   string
+}
+
+const flowRules = `
+rule Validate "the quantity must be positive" agenda-group "validation" no-loop {
+	when
+		Order.Quantity > 0
+	then
+		Order.Valid = true;
+		Order.Stages = Order.Stages + "V";
+}
+
+rule Enrich "computes the weight" agenda-group "enrichment" {
+	when
+		Order.Weight == 0
+	then
+		Order.Weight = Order.Quantity * 2;
+		Order.Stages = Order.Stages + "E";
+}
+
+rule Price "computes the price, once the weight is known" agenda-group "pricing" {
+	when
+		Order.Price == 0 && Order.Weight > 0
+	then
+		Order.Price = Order.Weight * 10;
+		Order.Stages = Order.Stages + "P";
+}
+
+rule Finalise "always runs last" agenda-group "finalisation" no-loop {
+	when
+		true
+	then
+		Order.Stages = Order.Stages + "F";
+}
+
+rule Main "main group, never part of the flow" {
+	when
+		Order.Stages == ""
+	then
+		Order.Stages = "M";
+}
+
+const MIN_QUANTITY = 1;
+
+function Rejected(quantity) {
+	return quantity < MIN_QUANTITY;
+}
+`
+
+const orderFlow = `{
+	"name": "order",
+	"stages": [
+		{
+			"name": "validation",
+			"transitions": [
+				{"when": "Order.Valid == false", "to": "finalisation"},
+				{"to": "enrichment"}
+			]
+		},
+		{"name": "enrichment"},
+		{"name": "pricing", "agendaGroup": "pricing"},
+		{"name": "finalisation"}
+	]
+}`
+
+const checkedOrderFlow = `{
+	"name": "checked",
+	"stages": [
+		{
+			"name": "validation",
+			"transitions": [
+				{"when": "Rejected(Order.Quantity)", "to": "finalisation"},
+				{"when": "Order.Quantity >= MIN_QUANTITY", "to": "enrichment"}
+			]
+		},
+		{"name": "enrichment"},
+		{"name": "pricing", "agendaGroup": "pricing"},
+		{"name": "finalisation"}
+	]
+}`
+
+func newFlowKnowledgeBase(t *testing.T, flow string) (*ast.KnowledgeBase, error) {
+	t.Helper()
+
+	return buildKnowledgeBase(t, func(rb *builder.RuleBuilder) error {
+		err := rb.BuildRuleFromResource(testKnowledgeBaseName, testKnowledgeBaseVersion, pkg.NewBytesResource([]byte(flowRules)))
+		assert.NoError(t, err)
+
+		return rb.BuildRuleFlowFromResource(testKnowledgeBaseName, testKnowledgeBaseVersion, pkg.NewBytesResource([]byte(flow)))
+	})
+}
+
+func TestRuleFlow_Execution(t *testing.T) {
+	for _, test := range []struct {
+		quantity int
+		stages   string
+		price    int
+	}{
+		{3, "VEPF", 60},
+		// the invalid order goes straight to the finalisation.
+		{0, "F", 0},
+	} {
+		order := &FlowOrder{Quantity: test.quantity}
+		dctx := ast.NewDataContext()
+		err := dctx.Add("Order", order)
+		assert.NoError(t, err)
+
+		kb, err := newFlowKnowledgeBase(t, orderFlow)
+		assert.NoError(t, err)
+		err = NewGruleEngine().ExecuteRuleFlow(context.Background(), dctx, kb, "order")
+		assert.NoError(t, err)
+		assert.Equal(t, test.stages, order.Stages)
+		assert.Equal(t, test.price, order.Price)
+	}
+}
+
+func TestRuleFlow_Instances(t *testing.T) {
+	kb, err := newFlowKnowledgeBase(t, orderFlow)
+	assert.NoError(t, err)
+	flow := kb.RuleFlows["order"]
+	assert.Equal(t, 4, len(flow.Stages))
+	assert.Equal(t, "enrichment", flow.Stages[1].GetAgendaGroup())
+	transition := flow.Stages[0].Transitions[0]
+	assert.Equal(t, "Order.Valid == false", transition.When)
+	// every instance gets its own conditions.
+	other, err := newFlowKnowledgeBase(t, orderFlow)
+	assert.NoError(t, err)
+	assert.NotNil(t, transition.Condition)
+	assert.NotSame(t, transition.Condition, other.RuleFlows["order"].Stages[0].Transitions[0].Condition)
+	assert.Nil(t, flow.Stages[0].Transitions[1].Condition)
+	// the conditions are not rule entries of the knowledge base.
+	assert.Len(t, kb.RuleEntries, 5)
+}
+
+func TestRuleFlow_KnowledgeBaseFunctionsAndConsts(t *testing.T) {
+	for _, test := range []struct {
+		quantity int
+		stages   string
+	}{
+		{3, "VEPF"},
+		{0, "F"},
+	} {
+		order := &FlowOrder{Quantity: test.quantity}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Order", order))
+
+		kb, err := newFlowKnowledgeBase(t, checkedOrderFlow)
+		assert.NoError(t, err)
+		err = NewGruleEngine().ExecuteRuleFlow(context.Background(), dctx, kb, "checked")
+		assert.NoError(t, err)
+		assert.Equal(t, test.stages, order.Stages)
+	}
+}
+
+func TestRuleFlow_SerializationAndClone(t *testing.T) {
+	kb, err := newFlowKnowledgeBase(t, checkedOrderFlow)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	assert.True(t, kb.IsIdentical(loadedKb))
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+	assert.True(t, kb.IsIdentical(clone))
+
+	for _, knowledge := range []*ast.KnowledgeBase{loadedKb, clone} {
+		assert.Equal(t, "Rejected(Order.Quantity)", knowledge.RuleFlows["checked"].Stages[0].Transitions[0].When)
+		order := &FlowOrder{Quantity: 0}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Order", order))
+		err = NewGruleEngine().ExecuteRuleFlow(context.Background(), dctx, knowledge, "checked")
+		assert.NoError(t, err)
+		assert.Equal(t, "F", order.Stages)
+	}
+}
+
+func TestRuleFlow_Invalid(t *testing.T) {
+	_, err := newFlowKnowledgeBase(t, `{"name": "order", "stages": [{"name": "a", "transitions": [{"to": "b"}]}]}`)
+	assert.Error(t, err)
+	_, err = newFlowKnowledgeBase(t, `{"name": "order", "stages": [{"name": "a", "transitions": [{"when": "Order.Valid ==", "to": "a"}]}]}`)
+	assert.Error(t, err)
+	_, err = newFlowKnowledgeBase(t, `{"name": "order", "stages": [{"name": "a", "transitions": [{"when": "Order.Valid Order.Quantity", "to": "a"}]}]}`)
+	assert.Error(t, err)
+
+	kb, err := newFlowKnowledgeBase(t, `{"name": "loop", "stages": [{"name": "a", "transitions": [{"to": "a"}]}]}`)
+	assert.NoError(t, err)
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Order", &FlowOrder{})
+	assert.NoError(t, err)
+	eng := NewGruleEngine()
+	eng.MaxCycle = 10
+	err = eng.ExecuteRuleFlow(context.Background(), dctx, kb, "loop")
+	assert.Error(t, err)
+	err = eng.ExecuteRuleFlow(context.Background(), dctx, kb, "unknown")
+	assert.Error(t, err)
+}
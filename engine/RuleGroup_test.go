@@ -0,0 +1,131 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+type GroupFact struct {
+	Fired    string
+	Discount int
+}
+
+const groupRules = `
+rule Start "main group, gives the focus to the pricing group" salience 10 {
+	when
+		Fact.Fired == ""
+	then
+		Fact.Fired = Fact.Fired + "S";
+		SetFocus("pricing");
+}
+
+rule Finish "main group, runs once the pricing group is done" {
+	when
+		Fact.Fired != "" && Fact.Discount > 0
+	then
+		Fact.Fired = Fact.Fired + "F";
+		Retract("Finish");
+}
+
+rule GoldDiscount "only one discount applies" agenda-group "pricing" activation-group "discount" salience 10 {
+	when
+		Fact.Discount == 0
+	then
+		Fact.Discount = 20;
+		Fact.Fired = Fact.Fired + "G";
+}
+
+rule SilverDiscount "never fires, the gold discount won" activation-group "discount" agenda-group "pricing" {
+	when
+		Fact.Discount == 0
+	then
+		Fact.Discount = 10;
+		Fact.Fired = Fact.Fired + "X";
+}
+
+rule Audit "audit group, only runs when focused" agenda-group 'audit' {
+	when
+		Fact.Fired != ""
+	then
+		Fact.Fired = Fact.Fired + "A";
+		Retract("Audit");
+}
+`
+
+func TestRuleGroups_FocusFromGRL(t *testing.T) {
+	fact := &GroupFact{}
+	dctx := ast.NewDataContext()
+	err := dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+
+	kb := mustNewKnowledgeBase(t, groupRules)
+	assert.Equal(t, "pricing", kb.RuleEntries["GoldDiscount"].AgendaGroup)
+	assert.Equal(t, "discount", kb.RuleEntries["SilverDiscount"].ActivationGroup)
+	assert.Equal(t, ast.MainAgendaGroup, kb.RuleEntries["Start"].GetAgendaGroup())
+
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	// the audit group never got the focus.
+	assert.Equal(t, "SGF", fact.Fired)
+	assert.Equal(t, 20, fact.Discount)
+	assert.Equal(t, ast.MainAgendaGroup, kb.GetFocus())
+}
+
+func TestRuleGroups_FocusFromGo(t *testing.T) {
+	fact := &GroupFact{Fired: "-", Discount: 5}
+	dctx := ast.NewDataContext()
+	err := dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+
+	kb := mustNewKnowledgeBase(t, groupRules)
+	kb.SetFocus("audit")
+	err = NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+	// the audit group runs first, then the main group.
+	assert.Equal(t, "-AF", fact.Fired)
+}
+
+func TestRuleGroups_Serialization(t *testing.T) {
+	kb := mustNewKnowledgeBase(t, groupRules)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err := cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	assert.Equal(t, "pricing", loadedKb.RuleEntries["SilverDiscount"].AgendaGroup)
+	assert.Equal(t, "discount", loadedKb.RuleEntries["SilverDiscount"].ActivationGroup)
+}
+
+func TestRuleGroups_DuplicateAttribute(t *testing.T) {
+	_, err := newKnowledgeBase(t, `
+rule Twice "two agenda groups" agenda-group "a" agenda-group "b" {
+	when
+		true
+	then
+		Complete();
+}`)
+	assert.Error(t, err)
+}
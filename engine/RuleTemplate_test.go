@@ -0,0 +1,106 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/builder"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type RegionOrder struct {
+	Region   string
+	Total    float64
+	Discount float64
+}
+
+const regionDiscountTemplate = `
+rule Discount_@{Region}_@{row} "tiered discount in @{Region}" salience @{Minimum} {
+	when
+		Order.Region == "@{Region}" && Order.Total >= @{Minimum} && Order.Discount < @{Rate}
+	then
+		Order.Discount = @{Rate};
+}
+`
+
+const regionDiscountTable = `Region,Minimum,Rate
+EU,100,0.05
+EU,500,0.1
+US,200,0.07
+`
+
+func newTemplateKnowledgeBase(t *testing.T, bundle pkg.ResourceBundle) (*ast.KnowledgeBase, error) {
+	t.Helper()
+
+	return buildKnowledgeBase(t, func(rb *builder.RuleBuilder) error {
+
+		return rb.BuildRulesFromBundle(testKnowledgeBaseName, testKnowledgeBaseVersion, bundle)
+	})
+}
+
+func TestRuleTemplate_Expansion(t *testing.T) {
+	kb, err := newTemplateKnowledgeBase(t, pkg.NewCSVTemplateResourceBundle(
+		pkg.NewBytesResource([]byte(regionDiscountTemplate)), pkg.NewBytesResource([]byte(regionDiscountTable))))
+	assert.NoError(t, err)
+	assert.Len(t, kb.RuleEntries, 3)
+
+	for _, tc := range []struct {
+		order    *RegionOrder
+		discount float64
+	}{
+		{&RegionOrder{Region: "EU", Total: 150}, 0.05},
+		{&RegionOrder{Region: "EU", Total: 600}, 0.1},
+		{&RegionOrder{Region: "US", Total: 600}, 0.07},
+		{&RegionOrder{Region: "US", Total: 150}, 0},
+	} {
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Order", tc.order))
+		err = NewGruleEngine().Execute(dctx, kb)
+		assert.NoError(t, err)
+		assert.InDelta(t, tc.discount, tc.order.Discount, 0.0001)
+	}
+}
+
+func TestRuleTemplate_Traceability(t *testing.T) {
+	kb, err := newTemplateKnowledgeBase(t, pkg.NewJSONTemplateResourceBundle(
+		pkg.NewBytesResource([]byte(regionDiscountTemplate)),
+		pkg.NewBytesResource([]byte(`[{"Region": "EU", "Minimum": 100, "Rate": 0.05}, {"Region": "US", "Minimum": 200, "Rate": 0.07}]`))))
+	assert.NoError(t, err)
+
+	entry := kb.RuleEntries["Discount_US_2"]
+	assert.NotNil(t, entry)
+	assert.Equal(t, "tiered discount in US", entry.RuleDescription)
+	metadata := entry.GetMetadata(pkg.TemplateMetadata)
+	assert.Len(t, metadata, 3)
+	assert.Equal(t, "2", metadata[2])
+	assert.True(t, strings.Contains(entry.GrlText, `@template(`), entry.GrlText)
+
+	_, err = newTemplateKnowledgeBase(t, pkg.NewCSVTemplateResourceBundle(
+		pkg.NewBytesResource([]byte(regionDiscountTemplate)), pkg.NewBytesResource([]byte("Region,Minimum,Rate\nEU,100,0.05\nEU,100,0.05\n"))))
+	assert.NoError(t, err)
+
+	template := pkg.NewBytesResource([]byte(regionDiscountTemplate))
+	table := pkg.NewBytesResource([]byte("Region,Minimum,Rate\nEU,100,0.05\nEU,,0.1\n"))
+	_, err = newTemplateKnowledgeBase(t, pkg.NewCSVTemplateResourceBundle(template, table))
+	assert.Error(t, err)
+	// the error names the template and the row expanded to invalid GRL.
+	assert.IsType(t, &pkg.GruleErrorReporter{}, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("Template %s expanded with row 2 of %s: ", template.String(), table.String()))
+}
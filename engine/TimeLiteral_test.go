@@ -0,0 +1,160 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/DataWiseHQ/grule-rule-engine/ast"
+	"github.com/DataWiseHQ/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type Subscription struct {
+	Birth   time.Time
+	Start   time.Time
+	Expires time.Time
+	Renewal time.Time
+	Grace   time.Duration
+	Age     time.Duration
+	Adult   bool
+	Early   bool
+	Done    bool
+}
+
+const timeLiteralRules = `
+const TRIAL = 30d;
+
+rule Subscribe "computes the dates of the subscription" {
+	when
+		!Subscription.Done
+	then
+		Subscription.Expires = Subscription.Start + TRIAL;
+		Subscription.Renewal = Subscription.Start + P1M;
+		Subscription.Grace = 1h30m + PT30M - 15m;
+		Subscription.Age = Subscription.Start - Subscription.Birth;
+		Subscription.Adult = Subscription.Birth <= Subscription.Start - P18Y;
+		Subscription.Early = Subscription.Start < @2024-01-01;
+		Subscription.Done = true;
+}
+`
+
+func TestTimeLiteral_Arithmetic(t *testing.T) {
+	kb, err := newKnowledgeBase(t, timeLiteralRules)
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		birth time.Time
+		start time.Time
+		adult bool
+		early bool
+	}{
+		{time.Date(2000, 1, 31, 0, 0, 0, 0, time.Local), time.Date(2023, 1, 31, 0, 0, 0, 0, time.Local), true, true},
+		{time.Date(2006, 3, 2, 0, 0, 0, 0, time.Local), time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local), false, false},
+	} {
+		subscription := &Subscription{Birth: tc.birth, Start: tc.start}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Subscription", subscription))
+		err = NewGruleEngine().Execute(dctx, kb)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.start.Add(30*24*time.Hour), subscription.Expires)
+		assert.Equal(t, tc.start.AddDate(0, 1, 0), subscription.Renewal)
+		assert.Equal(t, 105*time.Minute, subscription.Grace)
+		assert.Equal(t, tc.start.Sub(tc.birth), subscription.Age)
+		assert.Equal(t, tc.adult, subscription.Adult)
+		assert.Equal(t, tc.early, subscription.Early)
+	}
+}
+
+func TestTimeLiteral_WhenScope(t *testing.T) {
+	kb, err := newKnowledgeBase(t, `
+rule Expired "expires the subscriptions started more than 30 days ago" {
+	when
+		!Subscription.Done && Now() - Subscription.Start > 30d && Subscription.Start >= @2024-01-01T00:00:00Z
+	then
+		Subscription.Done = true;
+}`)
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		start time.Time
+		done  bool
+	}{
+		{time.Now().Add(-31 * 24 * time.Hour), true},
+		{time.Now().Add(-29 * 24 * time.Hour), false},
+		{time.Date(2023, 12, 31, 23, 0, 0, 0, time.UTC), false},
+	} {
+		subscription := &Subscription{Start: tc.start}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Subscription", subscription))
+		engine := NewGruleEngine()
+		engine.ReturnErrOnFailedRuleEvaluation = true
+		err = engine.Execute(dctx, kb)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.done, subscription.Done)
+	}
+}
+
+func TestTimeLiteral_Errors(t *testing.T) {
+	for _, grl := range []string{
+		`rule Date "adds two dates" { when !Subscription.Done then Subscription.Start = @2024-01-01 + @2024-01-02; Subscription.Done = true; }`,
+		`rule Date "subtracts a date from a duration" { when !Subscription.Done then Subscription.Start = 30d - @2024-01-01; Subscription.Done = true; }`,
+	} {
+		kb, err := newKnowledgeBase(t, grl)
+		assert.NoError(t, err)
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Subscription", &Subscription{}))
+		err = NewGruleEngine().Execute(dctx, kb)
+		assert.Error(t, err)
+	}
+
+	_, err := newKnowledgeBase(t, `rule Date "uses an invalid date" { when @2024-13-45 > Now() then Retract("Date"); }`)
+	assert.Error(t, err)
+}
+
+func TestTimeLiteral_SerializationAndClone(t *testing.T) {
+	kb, err := newKnowledgeBase(t, timeLiteralRules)
+	assert.NoError(t, err)
+	cat := kb.MakeCatalog()
+	buffer := &bytes.Buffer{}
+	err = cat.WriteCatalogToWriter(buffer)
+	assert.NoError(t, err)
+
+	loaded := &ast.Catalog{}
+	err = loaded.ReadCatalogFromReader(buffer)
+	assert.NoError(t, err)
+	assert.True(t, cat.Equals(loaded))
+	loadedKb, err := loaded.BuildKnowledgeBase()
+	assert.NoError(t, err)
+	assert.True(t, kb.IsIdentical(loadedKb))
+	clone, err := kb.Clone(pkg.NewCloneTable())
+	assert.NoError(t, err)
+
+	for _, knowledge := range []*ast.KnowledgeBase{loadedKb, clone} {
+		start := time.Date(2023, 1, 31, 0, 0, 0, 0, time.Local)
+		subscription := &Subscription{Birth: time.Date(2000, 1, 31, 0, 0, 0, 0, time.Local), Start: start}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Subscription", subscription))
+		err = NewGruleEngine().Execute(dctx, knowledge)
+		assert.NoError(t, err)
+		assert.Equal(t, start.Add(30*24*time.Hour), subscription.Expires)
+		assert.Equal(t, start.AddDate(0, 1, 0), subscription.Renewal)
+		assert.Equal(t, 105*time.Minute, subscription.Grace)
+		assert.True(t, subscription.Adult)
+		assert.True(t, subscription.Early)
+	}
+}
@@ -364,6 +364,13 @@ func (node *GoValueNode) SetObjectValueByField(field string, newValue reflect.Va
 
 			return SetNumberValue(fieldVal, newValue)
 		}
+		if newValue.Kind() == reflect.Slice || newValue.Kind() == reflect.Map {
+			newValue, err = pkg.ConvertValue(newValue, fieldVal.Type())
+			if err != nil {
+
+				return err
+			}
+		}
 		fieldVal.Set(newValue)
 
 		return nil
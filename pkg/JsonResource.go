@@ -25,11 +25,19 @@ import (
 
 // GruleJSON represents a rule in JSON format
 type GruleJSON struct {
-	Name        string        `json:"name"`
-	Description string        `json:"desc"`
-	Salience    int           `json:"salience"`
-	When        interface{}   `json:"when"`
-	Then        []interface{} `json:"then"`
+	Name            string        `json:"name"`
+	Extends         string        `json:"extends"`
+	Description     string        `json:"desc"`
+	Salience        int           `json:"salience"`
+	AgendaGroup     string        `json:"agendaGroup"`
+	ActivationGroup string        `json:"activationGroup"`
+	NoLoop          bool          `json:"noLoop"`
+	LockOnActive    bool          `json:"lockOnActive"`
+	DateEffective   string        `json:"dateEffective"`
+	DateExpires     string        `json:"dateExpires"`
+	Enabled         *bool         `json:"enabled"`
+	When            interface{}   `json:"when"`
+	Then            []interface{} `json:"then"`
 }
 
 // JSONResource will parse rules in JSON fromat from underlying resource provider.
@@ -188,10 +196,40 @@ func parseRule(rule *GruleJSON) (string, error) {
 	var stringBuilder strings.Builder
 	stringBuilder.WriteString("rule ")
 	stringBuilder.WriteString(rule.Name)
+	if len(rule.Extends) > 0 {
+		stringBuilder.WriteString(" extends ")
+		stringBuilder.WriteString(rule.Extends)
+	}
 	stringBuilder.WriteString(" ")
 	stringBuilder.WriteString(strconv.Quote(rule.Description))
 	stringBuilder.WriteString(" salience ")
 	stringBuilder.WriteString(strconv.Itoa(rule.Salience))
+	if len(rule.AgendaGroup) > 0 {
+		stringBuilder.WriteString(" agenda-group ")
+		stringBuilder.WriteString(strconv.Quote(rule.AgendaGroup))
+	}
+	if len(rule.ActivationGroup) > 0 {
+		stringBuilder.WriteString(" activation-group ")
+		stringBuilder.WriteString(strconv.Quote(rule.ActivationGroup))
+	}
+	if rule.NoLoop {
+		stringBuilder.WriteString(" no-loop")
+	}
+	if rule.LockOnActive {
+		stringBuilder.WriteString(" lock-on-active")
+	}
+	if len(rule.DateEffective) > 0 {
+		stringBuilder.WriteString(" date-effective ")
+		stringBuilder.WriteString(strconv.Quote(rule.DateEffective))
+	}
+	if len(rule.DateExpires) > 0 {
+		stringBuilder.WriteString(" date-expires ")
+		stringBuilder.WriteString(strconv.Quote(rule.DateExpires))
+	}
+	if rule.Enabled != nil {
+		stringBuilder.WriteString(" enabled ")
+		stringBuilder.WriteString(strconv.FormatBool(*rule.Enabled))
+	}
 	stringBuilder.WriteString(" {\n    when\n        ")
 	when, err := parseWhen(rule.When)
 	if err != nil {
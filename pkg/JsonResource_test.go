@@ -262,3 +262,23 @@ func TestJSONBigIntConversion(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedBigIntConversion, rs)
 }
+
+func TestJSONRuleAttributes(t *testing.T) {
+	rs, err := ParseJSONRule([]byte(`{
+	"name": "Campaign",
+	"desc": "Campaign discount",
+	"salience": 1,
+	"noLoop": true,
+	"dateEffective": "2024-01-01",
+	"dateExpires": "2024-02-01 12:00",
+	"enabled": false,
+	"when": "Fact.Price > 100",
+	"then": ["Fact.Price = 90"]
+}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "rule Campaign \"Campaign discount\" salience 1 no-loop date-effective \"2024-01-01\" date-expires \"2024-02-01 12:00\" enabled false {\n    when\n        Fact.Price > 100\n    then\n        Fact.Price = 90;\n}\n", rs)
+
+	rs, err = ParseJSONRule([]byte(`{"name": "Sale", "extends": "Campaign", "when": "Fact.Sale", "then": ["Fact.Price = 80"]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "rule Sale extends Campaign \"\" salience 0 {\n    when\n        Fact.Sale\n    then\n        Fact.Price = 80;\n}\n", rs)
+}
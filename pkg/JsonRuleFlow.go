@@ -0,0 +1,83 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package pkg
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// RuleFlowJSON represents a rule flow in JSON format
+type RuleFlowJSON struct {
+	Name   string              `json:"name"`
+	Stages []RuleFlowStageJSON `json:"stages"`
+}
+
+// RuleFlowStageJSON represents a stage of a rule flow in JSON format
+type RuleFlowStageJSON struct {
+	Name        string                   `json:"name"`
+	AgendaGroup string                   `json:"agendaGroup"`
+	Transitions []RuleFlowTransitionJSON `json:"transitions"`
+}
+
+// RuleFlowTransitionJSON represents a transition between two stages of a rule flow in JSON format.
+// The when condition has the same format as the when of a GruleJSON rule.
+type RuleFlowTransitionJSON struct {
+	When interface{} `json:"when"`
+	To   string      `json:"to"`
+}
+
+// ParseJSONRuleFlows accepts a byte array containing a rule flow, or an array of rule flows, in JSON format.
+func ParseJSONRuleFlows(data []byte) ([]*RuleFlowJSON, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+
+		return nil, errors.New("invalid JSON input")
+	}
+	switch trimmed[0] {
+	case '[':
+		var flows []*RuleFlowJSON
+		err := json.Unmarshal(trimmed, &flows)
+		if err != nil {
+
+			return nil, err
+		}
+
+		return flows, nil
+	case '{':
+		flow := &RuleFlowJSON{}
+		err := json.Unmarshal(trimmed, flow)
+		if err != nil {
+
+			return nil, err
+		}
+
+		return []*RuleFlowJSON{flow}, nil
+	default:
+
+		return nil, errors.New("invalid JSON input")
+	}
+}
+
+// Condition returns the when condition of the transition in GRL syntax, empty if there is none.
+func (transition *RuleFlowTransitionJSON) Condition() (string, error) {
+	if transition.When == nil {
+
+		return "", nil
+	}
+
+	return parseWhen(transition.When)
+}
@@ -23,6 +23,7 @@ import (
 type GruleErrorReporter struct {
 	*antlr.DefaultErrorListener // Embed default which ensures we fit the interface
 	Errors                      []error
+	Resource                    string // the resource being built as stated by its String, if any
 }
 
 // AddError simply add an error into this reporter
@@ -44,6 +45,10 @@ func (c *GruleErrorReporter) HasError() bool {
 // Error return an error text. This function is there for compatibility reason.
 func (c *GruleErrorReporter) Error() string {
 	if c.HasError() {
+		if len(c.Resource) > 0 {
+
+			return fmt.Sprintf("%s: got %d error(s) in grl the script", c.Resource, len(c.Errors))
+		}
 
 		return fmt.Sprintf("got %d error(s) in grl the script", len(c.Errors))
 	}
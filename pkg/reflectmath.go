@@ -16,7 +16,10 @@ package pkg
 
 import (
 	"fmt"
+	"math"
+	"math/bits"
 	"reflect"
+	"regexp"
 	"time"
 )
 
@@ -194,9 +197,188 @@ func EvaluateModulo(left, right reflect.Value) (reflect.Value, error) {
 	}
 }
 
+// EvaluateIntegerDivision will evaluate integer division operation over two value, the quotient being truncated
+// toward zero into an int64
+func EvaluateIntegerDivision(left, right reflect.Value) (reflect.Value, error) {
+	left, right = GetValueElem(left), GetValueElem(right)
+	if !IsNumber(left) || !IsNumber(right) {
+
+		return reflect.ValueOf(nil), fmt.Errorf("can not use data type of %s and %s in integer division", left.Kind().String(), right.Kind().String())
+	}
+	if GetBaseKind(left) == reflect.Float64 || GetBaseKind(right) == reflect.Float64 {
+		divisor := numberToFloat(right)
+		if divisor == 0 {
+
+			return reflect.ValueOf(nil), fmt.Errorf("integer division by zero")
+		}
+
+		return reflect.ValueOf(int64(math.Trunc(numberToFloat(left) / divisor))), nil
+	}
+	if GetBaseKind(left) == reflect.Uint64 && GetBaseKind(right) == reflect.Uint64 {
+		if right.Uint() == 0 {
+
+			return reflect.ValueOf(nil), fmt.Errorf("integer division by zero")
+		}
+
+		return reflect.ValueOf(int64(left.Uint() / right.Uint())), nil
+	}
+	divisor := numberToInt(right)
+	if divisor == 0 {
+
+		return reflect.ValueOf(nil), fmt.Errorf("integer division by zero")
+	}
+
+	return reflect.ValueOf(numberToInt(left) / divisor), nil
+}
+
+// EvaluatePower will evaluate exponent operation over two value. An integer raised to a non negative integer
+// stays an integer as long as the result fits in it, any other number is raised as a float64
+func EvaluatePower(left, right reflect.Value) (reflect.Value, error) {
+	left, right = GetValueElem(left), GetValueElem(right)
+	if !IsNumber(left) || !IsNumber(right) {
+
+		return reflect.ValueOf(nil), fmt.Errorf("can not use data type of %s and %s in exponent", left.Kind().String(), right.Kind().String())
+	}
+	if GetBaseKind(left) == reflect.Float64 || GetBaseKind(right) == reflect.Float64 || numberToInt(right) < 0 {
+
+		return reflect.ValueOf(math.Pow(numberToFloat(left), numberToFloat(right))), nil
+	}
+	exponent := uint64(numberToInt(right))
+	if GetBaseKind(right) == reflect.Uint64 {
+		exponent = right.Uint()
+	}
+	if GetBaseKind(left) == reflect.Uint64 {
+		if result, ok := powerUint(left.Uint(), exponent); ok {
+
+			return reflect.ValueOf(result), nil
+		}
+	} else if result, ok := powerInt(left.Int(), exponent); ok {
+
+		return reflect.ValueOf(result), nil
+	}
+
+	// the result overflows the integer, it is raised as a float64 instead.
+	return reflect.ValueOf(math.Pow(numberToFloat(left), float64(exponent))), nil
+}
+
+// powerInt raises base by squaring, ok is false when the result overflows an int64.
+func powerInt(base int64, exponent uint64) (result int64, ok bool) {
+	result = 1
+	for ; exponent > 0; exponent >>= 1 {
+		if exponent&1 == 1 {
+			if result, ok = multiplyInt(result, base); !ok {
+
+				return 0, false
+			}
+		}
+		if exponent > 1 {
+			if base, ok = multiplyInt(base, base); !ok {
+
+				return 0, false
+			}
+		}
+	}
+
+	return result, true
+}
+
+// multiplyInt multiplies two int64, ok is false when the product overflows.
+func multiplyInt(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+
+		return 0, true
+	}
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+
+		return 0, false
+	}
+
+	return product, true
+}
+
+// powerUint raises base by squaring, ok is false when the result overflows an uint64.
+func powerUint(base, exponent uint64) (result uint64, ok bool) {
+	result = 1
+	for ; exponent > 0; exponent >>= 1 {
+		var hi uint64
+		if exponent&1 == 1 {
+			if hi, result = bits.Mul64(result, base); hi != 0 {
+
+				return 0, false
+			}
+		}
+		if exponent > 1 {
+			if hi, base = bits.Mul64(base, base); hi != 0 {
+
+				return 0, false
+			}
+		}
+	}
+
+	return result, true
+}
+
+// EvaluateUnaryMinus will evaluate the negative of a number, a time.Duration or a Period
+func EvaluateUnaryMinus(val reflect.Value) (reflect.Value, error) {
+	val = GetValueElem(val)
+	if val.IsValid() {
+		switch value := val.Interface().(type) {
+		case time.Duration:
+
+			return reflect.ValueOf(-value), nil
+		case Period:
+
+			return reflect.ValueOf(value.Negate()), nil
+		}
+	}
+	switch GetBaseKind(val) {
+	case reflect.Int64:
+
+		return reflect.ValueOf(-val.Int()), nil
+	case reflect.Uint64:
+
+		return reflect.ValueOf(-int64(val.Uint())), nil
+	case reflect.Float64:
+
+		return reflect.ValueOf(-val.Float()), nil
+	default:
+
+		return reflect.ValueOf(nil), fmt.Errorf("can not use data type of %s in unary minus", val.Kind().String())
+	}
+}
+
+// numberToInt returns the value of an integer or an unsigned integer as an int64.
+func numberToInt(val reflect.Value) int64 {
+	if GetBaseKind(val) == reflect.Uint64 {
+
+		return int64(val.Uint())
+	}
+
+	return val.Int()
+}
+
+// numberToFloat returns the value of any number as a float64.
+func numberToFloat(val reflect.Value) float64 {
+	switch GetBaseKind(val) {
+	case reflect.Int64:
+
+		return float64(val.Int())
+	case reflect.Uint64:
+
+		return float64(val.Uint())
+	}
+
+	return val.Float()
+}
+
 // EvaluateAddition will evaluate addition operation over two value
 func EvaluateAddition(left, right reflect.Value) (reflect.Value, error) {
 	left, right = GetValueElem(left), GetValueElem(right)
+	if result, ok, err := evaluateTimeArithmetic(left, right, false); ok {
+
+		return result, err
+	}
 	switch left.Kind() {
 	case reflect.String:
 		leftValue := left.String()
@@ -308,6 +490,10 @@ func EvaluateAddition(left, right reflect.Value) (reflect.Value, error) {
 // EvaluateSubtraction will evaluate subtraction operation over two value
 func EvaluateSubtraction(left, right reflect.Value) (reflect.Value, error) {
 	left, right = GetValueElem(left), GetValueElem(right)
+	if result, ok, err := evaluateTimeArithmetic(left, right, true); ok {
+
+		return result, err
+	}
 	switch left.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		leftValue := left.Int()
@@ -791,6 +977,10 @@ func EvaluateLesserThanEqual(left, right reflect.Value) (reflect.Value, error) {
 // EvaluateEqual will evaluate Equal operation over two value
 func EvaluateEqual(left, right reflect.Value) (reflect.Value, error) {
 	left, right = GetValueElem(left), GetValueElem(right)
+	if IsNilValue(left) || IsNilValue(right) {
+
+		return reflect.ValueOf(IsNilValue(left) && IsNilValue(right)), nil
+	}
 	switch left.Kind() {
 	case reflect.String:
 		leftValue := left.String()
@@ -872,7 +1062,7 @@ func EvaluateEqual(left, right reflect.Value) (reflect.Value, error) {
 			leftValue := left.Interface().(time.Time)
 			rightValue := right.Interface().(time.Time)
 
-			return reflect.ValueOf(leftValue == rightValue), nil
+			return reflect.ValueOf(leftValue.Equal(rightValue)), nil
 		}
 
 		return reflect.ValueOf(nil), fmt.Errorf("can not use data type of %s in EQ comparison", left.Kind().String())
@@ -882,6 +1072,10 @@ func EvaluateEqual(left, right reflect.Value) (reflect.Value, error) {
 // EvaluateNotEqual will evaluate NotEqual operation over two value
 func EvaluateNotEqual(left, right reflect.Value) (reflect.Value, error) {
 	left, right = GetValueElem(left), GetValueElem(right)
+	if IsNilValue(left) || IsNilValue(right) {
+
+		return reflect.ValueOf(IsNilValue(left) != IsNilValue(right)), nil
+	}
 	switch left.Kind() {
 	case reflect.String:
 		leftValue := left.String()
@@ -963,13 +1157,103 @@ func EvaluateNotEqual(left, right reflect.Value) (reflect.Value, error) {
 			leftValue := left.Interface().(time.Time)
 			rightValue := right.Interface().(time.Time)
 
-			return reflect.ValueOf(leftValue != rightValue), nil
+			return reflect.ValueOf(!leftValue.Equal(rightValue)), nil
 		}
 
 		return reflect.ValueOf(nil), fmt.Errorf("can not use data type of %s in EQ comparison", left.Kind().String())
 	}
 }
 
+// EvaluateIn will evaluate whether the left value equals an element of the right array or slice, or a key of the right map
+func EvaluateIn(left, right reflect.Value) (reflect.Value, error) {
+	left, right = GetValueElem(left), GetValueElem(right)
+	var candidates []reflect.Value
+	switch right.Kind() {
+	case reflect.Array, reflect.Slice:
+		candidates = make([]reflect.Value, right.Len())
+		for i := range candidates {
+			candidates[i] = right.Index(i)
+		}
+	case reflect.Map:
+		candidates = right.MapKeys()
+	default:
+
+		return reflect.ValueOf(nil), fmt.Errorf("can not use data type of %s in IN operation", right.Kind().String())
+	}
+	for _, candidate := range candidates {
+		if isEqual(left, candidate) {
+
+			return reflect.ValueOf(true), nil
+		}
+	}
+
+	return reflect.ValueOf(false), nil
+}
+
+// EvaluateNotIn will evaluate the negation of the IN operation over two value
+func EvaluateNotIn(left, right reflect.Value) (reflect.Value, error) {
+	in, err := EvaluateIn(left, right)
+	if err != nil {
+
+		return reflect.ValueOf(nil), err
+	}
+
+	return reflect.ValueOf(!in.Bool()), nil
+}
+
+// isEqual compares two values with the EQ comparison, falling back to the Go equality of comparable values
+// of the same type. Values that can not be compared are not equal.
+func isEqual(left, right reflect.Value) bool {
+	left, right = GetValueElem(left), GetValueElem(right)
+	if !left.IsValid() || !right.IsValid() {
+
+		return left.IsValid() == right.IsValid()
+	}
+	eq, err := EvaluateEqual(left, right)
+	if err == nil {
+
+		return eq.Bool()
+	}
+	if left.Type() == right.Type() && left.Type().Comparable() && left.CanInterface() && right.CanInterface() {
+
+		return left.Interface() == right.Interface()
+	}
+
+	return false
+}
+
+// EvaluateBetween will evaluate whether a number or time.Time value lies between low and high, both inclusive
+func EvaluateBetween(val, low, high reflect.Value) (reflect.Value, error) {
+	val = GetValueElem(val)
+	if !IsNumber(val) && !(val.IsValid() && val.Type().String() == "time.Time") {
+
+		return reflect.ValueOf(nil), fmt.Errorf("can not use data type of %s in BETWEEN operation", val.Kind().String())
+	}
+	aboveLow, err := EvaluateGreaterThanEqual(val, low)
+	if err != nil {
+
+		return reflect.ValueOf(nil), err
+	}
+	belowHigh, err := EvaluateLesserThanEqual(val, high)
+	if err != nil {
+
+		return reflect.ValueOf(nil), err
+	}
+
+	return reflect.ValueOf(aboveLow.Bool() && belowHigh.Bool()), nil
+}
+
+// EvaluateMatches will evaluate whether the left string value matches the regular expression pattern
+func EvaluateMatches(left reflect.Value, pattern *regexp.Regexp) (reflect.Value, error) {
+	left = GetValueElem(left)
+	if left.Kind() != reflect.String {
+
+		return reflect.ValueOf(nil), fmt.Errorf("can not use data type of %s in MATCHES operation", left.Kind().String())
+	}
+
+	return reflect.ValueOf(pattern.MatchString(left.String())), nil
+}
+
 // EvaluateLogicAnd will evaluate LogicalAnd operation over two value
 func EvaluateLogicAnd(left, right reflect.Value) (reflect.Value, error) {
 	left, right = GetValueElem(left), GetValueElem(right)
@@ -15,8 +15,11 @@
 package pkg
 
 import (
+	"math"
 	"reflect"
+	"regexp"
 	"testing"
+	"time"
 )
 
 var (
@@ -567,3 +570,181 @@ func TestEvaluateNotEqual(t *testing.T) {
 		}
 	}
 }
+
+func TestEvaluateIn(t *testing.T) {
+	type point struct{ X, Y int }
+	list := reflect.ValueOf([]interface{}{int64(3), "three", point{X: 1, Y: 2}, nil})
+	for _, va := range valuesB {
+		vc, err := EvaluateIn(va, list)
+		if err != nil || vc.Bool() == false {
+			t.Errorf("3 in list == false, %v", err)
+		}
+	}
+	for _, va := range []reflect.Value{reflect.ValueOf("three"), reflect.ValueOf(point{X: 1, Y: 2}), reflect.ValueOf(nil)} {
+		vc, err := EvaluateIn(va, list)
+		if err != nil || vc.Bool() == false {
+			t.Errorf("%v in list == false, %v", va, err)
+		}
+	}
+	vc, err := EvaluateNotIn(intVal, list)
+	if err != nil || vc.Bool() == false {
+		t.Errorf("12 not in list == false, %v", err)
+	}
+	vc, err = EvaluateIn(reflect.ValueOf("b"), reflect.ValueOf(map[string]int{"a": 1, "b": 2}))
+	if err != nil || vc.Bool() == false {
+		t.Errorf("b in map == false, %v", err)
+	}
+	_, err = EvaluateIn(intVal, reflect.ValueOf("12"))
+	if err == nil {
+		t.Errorf("12 in string must be an error")
+	}
+}
+
+func TestEvaluateBetween(t *testing.T) {
+	for _, va := range valuesA {
+		vc, err := EvaluateBetween(va, int64Val2, float64Val)
+		if err != nil || vc.Bool() == false {
+			t.Errorf("12 between 3 and 12 == false, %v", err)
+		}
+		vc, err = EvaluateBetween(va, int64Val2, uint8Val2)
+		if err != nil || vc.Bool() == true {
+			t.Errorf("12 between 3 and 3 == true, %v", err)
+		}
+	}
+	now := time.Now()
+	vc, err := EvaluateBetween(reflect.ValueOf(now), reflect.ValueOf(now.Add(-time.Hour)), reflect.ValueOf(now.Add(time.Hour)))
+	if err != nil || vc.Bool() == false {
+		t.Errorf("now between an hour ago and in an hour == false, %v", err)
+	}
+	_, err = EvaluateBetween(reflect.ValueOf("b"), reflect.ValueOf("a"), reflect.ValueOf("c"))
+	if err == nil {
+		t.Errorf("string between must be an error")
+	}
+}
+
+func TestEvaluateMatches(t *testing.T) {
+	pattern := regexp.MustCompile(`^[A-Z]{2}-\d+$`)
+	vc, err := EvaluateMatches(reflect.ValueOf("AB-12"), pattern)
+	if err != nil || vc.Bool() == false {
+		t.Errorf("AB-12 matches == false, %v", err)
+	}
+	vc, err = EvaluateMatches(reflect.ValueOf("ab-12"), pattern)
+	if err != nil || vc.Bool() == true {
+		t.Errorf("ab-12 matches == true, %v", err)
+	}
+	_, err = EvaluateMatches(intVal, pattern)
+	if err == nil {
+		t.Errorf("12 matches must be an error")
+	}
+}
+
+func TestEvaluateEqualNil(t *testing.T) {
+	var nilPtr *int
+	var nilSlice []int
+	nils := []reflect.Value{reflect.ValueOf(nil), reflect.ValueOf(nilPtr), reflect.ValueOf(nilSlice)}
+	for _, va := range nils {
+		for _, vb := range nils {
+			vc, err := EvaluateEqual(va, vb)
+			if err != nil || vc.Bool() == false {
+				t.Errorf("nil == nil == false, %v", err)
+			}
+		}
+		vc, err := EvaluateNotEqual(va, intPtr)
+		if err != nil || vc.Bool() == false {
+			t.Errorf("nil != 12 == false, %v", err)
+		}
+		vc, err = EvaluateEqual(reflect.ValueOf("a"), va)
+		if err != nil || vc.Bool() == true {
+			t.Errorf("a == nil == true, %v", err)
+		}
+	}
+}
+
+func TestEvaluatePower(t *testing.T) {
+	for _, va := range valuesA {
+		for _, vb := range valuesB {
+			vc, err := EvaluatePower(va, vb)
+			if err != nil {
+				t.Errorf("Error %v", err)
+			}
+			baseKind := GetBaseKind(GetValueElem(va))
+			if baseKind == reflect.Float64 || GetBaseKind(GetValueElem(vb)) == reflect.Float64 {
+				if vc.Kind() != reflect.Float64 || vc.Float() != 1728 {
+					t.Errorf("12 ** 3 should yield float64 1728, got %v", vc)
+				}
+			} else if GetBaseKind(vc) != baseKind || vc.Convert(reflect.TypeOf(int64(0))).Int() != 1728 {
+				t.Errorf("12 ** 3 should yield %s 1728, got %v", baseKind.String(), vc)
+			}
+		}
+	}
+	vc, err := EvaluatePower(intVal, reflect.ValueOf(-1))
+	if err != nil || vc.Kind() != reflect.Float64 {
+		t.Errorf("12 ** -1 should yield float64, got %v %v", vc, err)
+	}
+	vc, err = EvaluatePower(reflect.ValueOf(-2), reflect.ValueOf(-2))
+	if err != nil || vc.Kind() != reflect.Float64 || vc.Float() != 0.25 {
+		t.Errorf("-2 ** -2 should yield float64 0.25, got %v %v", vc, err)
+	}
+	vc, err = EvaluatePower(reflect.ValueOf(-2), reflect.ValueOf(63))
+	if err != nil || vc.Kind() != reflect.Int64 || vc.Int() != math.MinInt64 {
+		t.Errorf("-2 ** 63 should yield int64 %d, got %v %v", int64(math.MinInt64), vc, err)
+	}
+	vc, err = EvaluatePower(reflect.ValueOf(2), reflect.ValueOf(70))
+	if err != nil || vc.Kind() != reflect.Float64 || vc.Float() != math.Pow(2, 70) {
+		t.Errorf("2 ** 70 should overflow into float64 %v, got %v %v", math.Pow(2, 70), vc, err)
+	}
+	vc, err = EvaluatePower(reflect.ValueOf(uint(3)), reflect.ValueOf(41))
+	if err != nil || vc.Kind() != reflect.Float64 || vc.Float() != math.Pow(3, 41) {
+		t.Errorf("uint 3 ** 41 should overflow into float64 %v, got %v %v", math.Pow(3, 41), vc, err)
+	}
+	vc, err = EvaluatePower(reflect.ValueOf(uint(3)), reflect.ValueOf(40))
+	if err != nil || vc.Kind() != reflect.Uint64 || vc.Uint() != 12157665459056928801 {
+		t.Errorf("uint 3 ** 40 should yield uint64 12157665459056928801, got %v %v", vc, err)
+	}
+	_, err = EvaluatePower(reflect.ValueOf("12"), intVal)
+	if err == nil {
+		t.Errorf("\"12\" ** 12 must be an error")
+	}
+}
+
+func TestEvaluateIntegerDivision(t *testing.T) {
+	for _, va := range valuesA {
+		for _, vb := range valuesB {
+			vc, err := EvaluateIntegerDivision(va, vb)
+			if err != nil || vc.Kind() != reflect.Int64 || vc.Int() != 4 {
+				t.Errorf("12 ~/ 3 should yield int64 4, got %v %v", vc, err)
+			}
+		}
+	}
+	vc, err := EvaluateIntegerDivision(reflect.ValueOf(-7), reflect.ValueOf(2.0))
+	if err != nil || vc.Int() != -3 {
+		t.Errorf("-7 ~/ 2.0 should yield -3, got %v %v", vc, err)
+	}
+	for _, zero := range []reflect.Value{reflect.ValueOf(0), reflect.ValueOf(uint(0)), reflect.ValueOf(0.0)} {
+		_, err = EvaluateIntegerDivision(uintVal, zero)
+		if err == nil {
+			t.Errorf("12 ~/ 0 must be an error")
+		}
+	}
+}
+
+func TestEvaluateUnaryMinus(t *testing.T) {
+	for _, va := range valuesA {
+		vc, err := EvaluateUnaryMinus(va)
+		if err != nil {
+			t.Errorf("Error %v", err)
+		}
+		vc, err = EvaluateEqual(vc, reflect.ValueOf(-12))
+		if err != nil || !vc.Bool() {
+			t.Errorf("-12 should equal -12, %v", err)
+		}
+	}
+	vc, err := EvaluateUnaryMinus(reflect.ValueOf(time.Hour))
+	if err != nil || vc.Interface() != -time.Hour {
+		t.Errorf("-1h should yield -1h, got %v %v", vc, err)
+	}
+	_, err = EvaluateUnaryMinus(reflect.ValueOf("12"))
+	if err == nil {
+		t.Errorf("-\"12\" must be an error")
+	}
+}
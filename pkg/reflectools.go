@@ -659,6 +659,73 @@ func IsNumber(val reflect.Value) bool {
 	}
 }
 
+// ConvertValue will convert a value into the given type. Slices and maps are converted element by element,
+// so a []int64 list literal can be assigned to a []int field.
+func ConvertValue(val reflect.Value, typ reflect.Type) (reflect.Value, error) {
+	if val.Kind() == reflect.Interface {
+		val = val.Elem()
+	}
+	if !val.IsValid() {
+
+		return reflect.Zero(typ), nil
+	}
+	if val.Type().AssignableTo(typ) {
+
+		return val, nil
+	}
+	switch {
+	case val.Kind() == reflect.Slice && typ.Kind() == reflect.Slice:
+		ret := reflect.MakeSlice(typ, val.Len(), val.Len())
+		for i := 0; i < val.Len(); i++ {
+			elem, err := ConvertValue(val.Index(i), typ.Elem())
+			if err != nil {
+
+				return reflect.Value{}, err
+			}
+			ret.Index(i).Set(elem)
+		}
+
+		return ret, nil
+	case val.Kind() == reflect.Map && typ.Kind() == reflect.Map:
+		ret := reflect.MakeMapWithSize(typ, val.Len())
+		for _, key := range val.MapKeys() {
+			convertedKey, err := ConvertValue(key, typ.Key())
+			if err != nil {
+
+				return reflect.Value{}, err
+			}
+			elem, err := ConvertValue(val.MapIndex(key), typ.Elem())
+			if err != nil {
+
+				return reflect.Value{}, err
+			}
+			ret.SetMapIndex(convertedKey, elem)
+		}
+
+		return ret, nil
+	case IsNumber(val) && IsNumber(reflect.Zero(typ)):
+
+		return val.Convert(typ), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("can not convert %s into %s", val.Type().String(), typ.String())
+}
+
+// IsNilValue will check if a value is nil, either an invalid value or a nil pointer, interface, map or slice
+func IsNilValue(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.Invalid:
+
+		return true
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+
+		return val.IsNil()
+	default:
+
+		return false
+	}
+}
+
 // GetValueElem will return the value val contains if val is of Kind Interface or Pointer
 func GetValueElem(val reflect.Value) reflect.Value {
 	if val.Kind() == reflect.Pointer || val.Kind() == reflect.Interface {
@@ -506,3 +506,18 @@ func TestGetMapArrayValue(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestConvertValue(t *testing.T) {
+	val, err := ConvertValue(reflect.ValueOf([]int64{1, 2}), reflect.TypeOf([]int{}))
+	if err != nil || !reflect.DeepEqual(val.Interface(), []int{1, 2}) {
+		t.Errorf("expect []int{1, 2} but %v, %v", val, err)
+	}
+	val, err = ConvertValue(reflect.ValueOf(map[string]interface{}{"a": int64(1), "b": nil}), reflect.TypeOf(map[string]float32{}))
+	if err != nil || !reflect.DeepEqual(val.Interface(), map[string]float32{"a": 1, "b": 0}) {
+		t.Errorf("expect map[a:1 b:0] but %v, %v", val, err)
+	}
+	_, err = ConvertValue(reflect.ValueOf([]string{"a"}), reflect.TypeOf([]int{}))
+	if err == nil {
+		t.Errorf("strings converted into integers")
+	}
+}
@@ -0,0 +1,303 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package pkg
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// TemplateTableCSV is the format of a parameter table in CSV, its first record being the names of the columns.
+	TemplateTableCSV = "csv"
+	// TemplateTableJSON is the format of a parameter table in JSON, an array of objects keyed by column name.
+	TemplateTableJSON = "json"
+
+	// TemplateMetadata is the name of the metadata annotation added to the rules expanded from a template. Its values
+	// are the template, the parameter table and the row the rule was expanded with.
+	TemplateMetadata = "template"
+	// TemplateRowColumn is the placeholder of the row number, unless the parameter table has a column with that name.
+	TemplateRowColumn = "row"
+)
+
+// templatePlaceholder matches a placeholder of a template, such as @{Region}.
+var templatePlaceholder = regexp.MustCompile(`@\{\s*(\w+)\s*\}`)
+
+// NewCSVTemplateResourceBundle will create a bundle expanding the GRL template once for each row of the CSV table.
+func NewCSVTemplateResourceBundle(template, table Resource) *TemplateResourceBundle {
+
+	return &TemplateResourceBundle{
+		Template: template,
+		Table:    table,
+		Format:   TemplateTableCSV,
+	}
+}
+
+// NewJSONTemplateResourceBundle will create a bundle expanding the GRL template once for each row of the JSON table.
+func NewJSONTemplateResourceBundle(template, table Resource) *TemplateResourceBundle {
+
+	return &TemplateResourceBundle{
+		Template: template,
+		Table:    table,
+		Format:   TemplateTableJSON,
+	}
+}
+
+// TemplateResourceBundle expands a GRL template, in which placeholders such as @{Region} stand for the columns of a
+// parameter table, into one TemplateResource for each row of the table.
+type TemplateResourceBundle struct {
+	Template Resource
+	Table    Resource
+	Format   string // TemplateTableCSV or TemplateTableJSON
+}
+
+// Load will expand the template with every row of the table.
+func (bundle *TemplateResourceBundle) Load() ([]Resource, error) {
+	template, err := bundle.Template.Load()
+	if err != nil {
+
+		return nil, err
+	}
+	data, err := bundle.Table.Load()
+	if err != nil {
+
+		return nil, err
+	}
+	var rows []map[string]string
+	switch bundle.Format {
+	case TemplateTableCSV:
+		rows, err = ParseCSVTemplateTable(data)
+	case TemplateTableJSON:
+		rows, err = ParseJSONTemplateTable(data)
+	default:
+		err = fmt.Errorf("unknown template table format %s", bundle.Format)
+	}
+	if err != nil {
+
+		return nil, fmt.Errorf("invalid template table %s: %w", bundle.Table.String(), err)
+	}
+
+	segments := splitTemplateRuleHeaders(string(template))
+	resources := make([]Resource, 0, len(rows))
+	for i, row := range rows {
+		res := &TemplateResource{
+			Template: bundle.Template.String(),
+			Table:    bundle.Table.String(),
+			Row:      i + 1,
+		}
+		grl, err := res.expand(segments, row)
+		if err != nil {
+
+			return nil, err
+		}
+		res.Bytes = []byte(grl)
+		resources = append(resources, res)
+	}
+
+	return resources, nil
+}
+
+// MustLoad is the same as Load, but it will panic if the template can not be expanded.
+func (bundle *TemplateResourceBundle) MustLoad() []Resource {
+	resources, err := bundle.Load()
+	if err != nil {
+		panic(err)
+	}
+
+	return resources
+}
+
+// TemplateResource is the GRL expanded from a template with one row of its parameter table.
+type TemplateResource struct {
+	Template string // the template resource, as stated by its String
+	Table    string // the parameter table resource, as stated by its String
+	Row      int    // the row of the parameter table, the first row being 1
+	Bytes    []byte
+}
+
+// Load will load the expanded GRL.
+func (res *TemplateResource) Load() ([]byte, error) {
+
+	return res.Bytes, nil
+}
+
+// String will state the template and the row it was expanded with.
+func (res *TemplateResource) String() string {
+
+	return fmt.Sprintf("Template %s expanded with row %d of %s", res.Template, res.Row, res.Table)
+}
+
+// expand replaces the placeholders of the template segments with the values of the row, and adds the template
+// metadata annotation between the segments, right before the body of each rule.
+func (res *TemplateResource) expand(segments []string, row map[string]string) (string, error) {
+	var buff strings.Builder
+	annotation := fmt.Sprintf("@%s(%s, %s, %s) ", TemplateMetadata, strconv.Quote(res.Template), strconv.Quote(res.Table), strconv.Quote(strconv.Itoa(res.Row)))
+	for i, segment := range segments {
+		if i > 0 {
+			buff.WriteString(annotation)
+		}
+		var err error
+		expanded := templatePlaceholder.ReplaceAllStringFunc(segment, func(placeholder string) string {
+			column := templatePlaceholder.FindStringSubmatch(placeholder)[1]
+			if value, ok := row[column]; ok {
+
+				return value
+			}
+			if column == TemplateRowColumn {
+
+				return strconv.Itoa(res.Row)
+			}
+			if err == nil {
+				err = fmt.Errorf("template %s uses @{%s}, which is not a column of row %d of %s", res.Template, column, res.Row, res.Table)
+			}
+
+			return placeholder
+		})
+		if err != nil {
+
+			return "", err
+		}
+		buff.WriteString(expanded)
+	}
+
+	return buff.String(), nil
+}
+
+// splitTemplateRuleHeaders splits the template right before the opening brace of each rule body, skipping the
+// comments, the strings and the placeholders which may contain braces.
+func splitTemplateRuleHeaders(template string) []string {
+	segments := make([]string, 0)
+	start := 0
+	depth := 0
+	inHeader := false
+	for i := 0; i < len(template); i++ {
+		switch c := template[i]; {
+		case strings.HasPrefix(template[i:], "//"):
+			end := strings.IndexByte(template[i:], '\n')
+			if end < 0 {
+				end = len(template) - i
+			}
+			i += end
+		case strings.HasPrefix(template[i:], "/*"):
+			end := strings.Index(template[i+2:], "*/")
+			if end < 0 {
+				end = len(template) - i - 4
+			}
+			i += end + 3
+		case strings.HasPrefix(template[i:], "@{"):
+			end := strings.IndexByte(template[i:], '}')
+			if end < 0 {
+				end = len(template) - i
+			}
+			i += end
+		case c == '"' || c == '\'':
+			for i++; i < len(template) && template[i] != c; i++ {
+				if template[i] == '\\' {
+					i++
+				}
+			}
+		case isTemplateWordByte(c):
+			end := i
+			for end < len(template) && isTemplateWordByte(template[end]) {
+				end++
+			}
+			if depth == 0 && strings.EqualFold(template[i:end], "rule") {
+				inHeader = true
+			}
+			i = end - 1
+		case c == '{':
+			if depth == 0 && inHeader {
+				segments = append(segments, template[start:i])
+				start = i
+				inHeader = false
+			}
+			depth++
+		case c == '}':
+			depth--
+		}
+	}
+
+	return append(segments, template[start:])
+}
+
+func isTemplateWordByte(c byte) bool {
+
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// ParseCSVTemplateTable parses a CSV parameter table, its first record being the names of the columns.
+func ParseCSVTemplateTable(data []byte) ([]map[string]string, error) {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+
+		return nil, err
+	}
+	if len(records) == 0 {
+
+		return nil, fmt.Errorf("the table has no header")
+	}
+	columns := records[0]
+	for i, column := range columns {
+		columns[i] = strings.TrimSpace(column)
+	}
+	rows := make([]map[string]string, 0, len(records)-1)
+	for _, record := range records[1:] {
+		row := make(map[string]string, len(columns))
+		for i, column := range columns {
+			row[column] = record[i]
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// ParseJSONTemplateTable parses a JSON parameter table, an array of objects whose values are strings, numbers or
+// booleans. A string is inserted without its quotes.
+func ParseJSONTemplateTable(data []byte) ([]map[string]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var objects []map[string]interface{}
+	err := decoder.Decode(&objects)
+	if err != nil {
+
+		return nil, err
+	}
+	rows := make([]map[string]string, 0, len(objects))
+	for i, object := range objects {
+		row := make(map[string]string, len(object))
+		for column, value := range object {
+			switch v := value.(type) {
+			case string:
+				row[column] = v
+			case json.Number:
+				row[column] = v.String()
+			case bool:
+				row[column] = strconv.FormatBool(v)
+			default:
+
+				return nil, fmt.Errorf("column %s of row %d is not a string, a number or a boolean", column, i+1)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,90 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const discountTemplate = `// one rule for each region, the {braces} of the comments are ignored
+rule Discount_@{Region} "discount of {@{Region}}" salience @{row} {
+	when
+		Order.Region == "@{Region}" && Order.Total > @{Minimum}
+	then
+		Order.Discount = @{Rate};
+}
+`
+
+func TestTemplateResourceBundle_CSV(t *testing.T) {
+	table := NewBytesResource([]byte("Region, Minimum, Rate\nEU,100,0.1\nUS,200,0.15\n"))
+	resources, err := NewCSVTemplateResourceBundle(NewBytesResource([]byte(discountTemplate)), table).Load()
+	assert.NoError(t, err)
+	assert.Len(t, resources, 2)
+
+	grl, err := resources[1].Load()
+	assert.NoError(t, err)
+	assert.Equal(t, `// one rule for each region, the {braces} of the comments are ignored
+rule Discount_US "discount of {US}" salience 2 @template("Byte array resources 238 bytes", "Byte array resources 45 bytes", "2") {
+	when
+		Order.Region == "US" && Order.Total > 200
+	then
+		Order.Discount = 0.15;
+}
+`, string(grl))
+	assert.Equal(t, "Template Byte array resources 238 bytes expanded with row 2 of Byte array resources 45 bytes", resources[1].String())
+}
+
+func TestTemplateResourceBundle_JSON(t *testing.T) {
+	table := NewBytesResource([]byte(`[{"Region": "EU", "Minimum": 100, "Rate": 0.1}, {"Region": "APAC", "Minimum": 50.5, "Rate": 0.2}]`))
+	resources := NewJSONTemplateResourceBundle(NewBytesResource([]byte(discountTemplate)), table).MustLoad()
+	assert.Len(t, resources, 2)
+
+	grl, err := resources[1].Load()
+	assert.NoError(t, err)
+	assert.Contains(t, string(grl), `rule Discount_APAC "discount of {APAC}" salience 2 @template(`)
+	assert.Contains(t, string(grl), `Order.Region == "APAC" && Order.Total > 50.5`)
+}
+
+func TestTemplateResourceBundle_Errors(t *testing.T) {
+	template := NewBytesResource([]byte(discountTemplate))
+	for _, bundle := range []*TemplateResourceBundle{
+		NewCSVTemplateResourceBundle(template, NewBytesResource([]byte("Region,Rate\nEU,0.1\n"))),
+		NewCSVTemplateResourceBundle(template, NewBytesResource([]byte("Region,Minimum,Rate\nEU,100\n"))),
+		NewCSVTemplateResourceBundle(template, NewBytesResource([]byte(""))),
+		NewJSONTemplateResourceBundle(template, NewBytesResource([]byte(`[{"Region": "EU", "Minimum": [100], "Rate": 0.1}]`))),
+		NewJSONTemplateResourceBundle(template, NewBytesResource([]byte(`{"Region": "EU"}`))),
+	} {
+		_, err := bundle.Load()
+		assert.Error(t, err)
+	}
+
+	_, err := NewCSVTemplateResourceBundle(template, NewBytesResource([]byte("Region,Rate\nEU,0.1\n"))).Load()
+	assert.EqualError(t, err, "template Byte array resources 238 bytes uses @{Minimum}, which is not a column of row 1 of Byte array resources 19 bytes")
+}
+
+func TestSplitTemplateRuleHeaders(t *testing.T) {
+	segments := splitTemplateRuleHeaders(`
+const LIMITS = {"a": 1};
+/* rule Commented { */
+rule First "the { of a string" { when true then Retract("First"); }
+function Double(x) { return x * 2; }
+RULE Second @{Name} { when true then Retract("Second"); }`)
+	assert.Len(t, segments, 3)
+	assert.True(t, len(segments[1]) > 0 && segments[1][0] == '{')
+	assert.Contains(t, segments[1], "function Double(x)")
+	assert.Equal(t, `{ when true then Retract("Second"); }`, segments[2])
+}
@@ -0,0 +1,254 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// TimeType is the reflect type of time.Time
+	TimeType = reflect.TypeOf(time.Time{})
+	// DurationType is the reflect type of time.Duration
+	DurationType = reflect.TypeOf(time.Duration(0))
+	// PeriodType is the reflect type of Period
+	PeriodType = reflect.TypeOf(Period{})
+)
+
+// DateLiteralLayouts are the layouts accepted by a date literal, without its leading @.
+// A date without time zone is in the local time zone.
+var DateLiteralLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// Period is a calendar amount of time, such as the ISO 8601 duration P1Y2M. Unlike a time.Duration, the length of
+// its years, months and days depends on the time it is added to.
+type Period struct {
+	Years    int
+	Months   int
+	Days     int
+	Duration time.Duration
+}
+
+// AddTo returns the time t plus this period.
+func (p Period) AddTo(t time.Time) time.Time {
+
+	return t.AddDate(p.Years, p.Months, p.Days).Add(p.Duration)
+}
+
+// Negate returns the period going back in time by the same amount.
+func (p Period) Negate() Period {
+
+	return Period{Years: -p.Years, Months: -p.Months, Days: -p.Days, Duration: -p.Duration}
+}
+
+// String returns the period in the ISO 8601 duration format.
+func (p Period) String() string {
+	var buff strings.Builder
+	buff.WriteString("P")
+	for _, part := range []struct {
+		value int
+		unit  string
+	}{{p.Years, "Y"}, {p.Months, "M"}, {p.Days, "D"}} {
+		if part.value != 0 {
+			buff.WriteString(fmt.Sprintf("%d%s", part.value, part.unit))
+		}
+	}
+	if p.Duration != 0 {
+		buff.WriteString(fmt.Sprintf("T%gS", p.Duration.Seconds()))
+	}
+	if buff.Len() == 1 {
+		buff.WriteString("0D")
+	}
+
+	return buff.String()
+}
+
+// durationUnits are the units of a short duration literal such as 1h30m, a day being 24 hours and a week 7 days.
+var durationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+	"w":  7 * 24 * time.Hour,
+}
+
+// ParseDurationLiteral parses a duration literal, either short such as 30d or 1h30m, or ISO 8601 such as P1M or PT2H.
+// The value is a time.Duration, or a Period when the literal counts years or months.
+func ParseDurationLiteral(text string) (reflect.Value, error) {
+	if strings.HasPrefix(text, "P") {
+
+		return parseISODuration(text)
+	}
+	var duration time.Duration
+	rest := text
+	for len(rest) > 0 {
+		digits := strings.IndexFunc(rest, func(r rune) bool { return r < '0' || r > '9' })
+		if digits <= 0 {
+
+			return reflect.Value{}, fmt.Errorf("invalid duration %s", text)
+		}
+		unitEnd := strings.IndexFunc(rest[digits:], func(r rune) bool { return r >= '0' && r <= '9' })
+		if unitEnd < 0 {
+			unitEnd = len(rest) - digits
+		}
+		unit, ok := durationUnits[rest[digits:digits+unitEnd]]
+		if !ok {
+
+			return reflect.Value{}, fmt.Errorf("invalid duration %s, unknown unit %s", text, rest[digits:digits+unitEnd])
+		}
+		count, err := strconv.ParseInt(rest[:digits], 10, 64)
+		if err != nil {
+
+			return reflect.Value{}, fmt.Errorf("invalid duration %s: %w", text, err)
+		}
+		duration += time.Duration(count) * unit
+		rest = rest[digits+unitEnd:]
+	}
+
+	return reflect.ValueOf(duration), nil
+}
+
+// parseISODuration parses an ISO 8601 duration such as P1Y2M10DT2H30M. Weeks and days count 24 hours each
+// unless the duration has years or months, in which case it is a calendar Period.
+func parseISODuration(text string) (reflect.Value, error) {
+	period := Period{}
+	inTime := false
+	rest := text[1:]
+	for len(rest) > 0 {
+		if rest[0] == 'T' && !inTime {
+			inTime = true
+			rest = rest[1:]
+
+			continue
+		}
+		digits := strings.IndexFunc(rest, func(r rune) bool { return r < '0' || r > '9' })
+		if digits <= 0 {
+
+			return reflect.Value{}, fmt.Errorf("invalid duration %s", text)
+		}
+		count, err := strconv.Atoi(rest[:digits])
+		if err != nil {
+
+			return reflect.Value{}, fmt.Errorf("invalid duration %s: %w", text, err)
+		}
+		switch unit := rest[digits]; {
+		case !inTime && unit == 'Y':
+			period.Years += count
+		case !inTime && unit == 'M':
+			period.Months += count
+		case !inTime && unit == 'W':
+			period.Days += 7 * count
+		case !inTime && unit == 'D':
+			period.Days += count
+		case inTime && unit == 'H':
+			period.Duration += time.Duration(count) * time.Hour
+		case inTime && unit == 'M':
+			period.Duration += time.Duration(count) * time.Minute
+		case inTime && unit == 'S':
+			period.Duration += time.Duration(count) * time.Second
+		default:
+
+			return reflect.Value{}, fmt.Errorf("invalid duration %s, unknown unit %c", text, unit)
+		}
+		rest = rest[digits+1:]
+	}
+	if period.Years == 0 && period.Months == 0 {
+
+		return reflect.ValueOf(time.Duration(period.Days)*24*time.Hour + period.Duration), nil
+	}
+
+	return reflect.ValueOf(period), nil
+}
+
+// ParseDateLiteral parses a date literal such as @2024-01-01 or @2024-01-01T10:30:00Z using the DateLiteralLayouts.
+func ParseDateLiteral(text string) (time.Time, error) {
+	date := strings.TrimPrefix(text, "@")
+	for _, layout := range DateLiteralLayouts {
+		if t, err := time.ParseInLocation(layout, date, time.Local); err == nil {
+
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %s, the expected format is @yyyy-mm-dd[Thh:mm[:ss][zone]]", text)
+}
+
+// evaluateTimeArithmetic adds, or subtracts when subtract is true, two values of which at least one is a time.Time,
+// a time.Duration or a Period. A time plus or minus a duration or a period is a time, the difference of two times
+// is a duration, and durations add up to a duration. It returns false when neither value is one of those types.
+func evaluateTimeArithmetic(left, right reflect.Value, subtract bool) (reflect.Value, bool, error) {
+	if !left.IsValid() || !right.IsValid() || !isTimeType(left.Type()) && !isTimeType(right.Type()) {
+
+		return reflect.Value{}, false, nil
+	}
+	operation := "add"
+	if subtract {
+		operation = "subtract"
+	}
+	switch {
+	case left.Type() == TimeType && right.Type() == TimeType && subtract:
+
+		return reflect.ValueOf(left.Interface().(time.Time).Sub(right.Interface().(time.Time))), true, nil
+	case left.Type() == TimeType && right.Type() == DurationType:
+		duration := right.Interface().(time.Duration)
+		if subtract {
+			duration = -duration
+		}
+
+		return reflect.ValueOf(left.Interface().(time.Time).Add(duration)), true, nil
+	case left.Type() == TimeType && right.Type() == PeriodType:
+		period := right.Interface().(Period)
+		if subtract {
+			period = period.Negate()
+		}
+
+		return reflect.ValueOf(period.AddTo(left.Interface().(time.Time))), true, nil
+	case left.Type() == DurationType && right.Type() == TimeType && !subtract:
+
+		return reflect.ValueOf(right.Interface().(time.Time).Add(left.Interface().(time.Duration))), true, nil
+	case left.Type() == PeriodType && right.Type() == TimeType && !subtract:
+
+		return reflect.ValueOf(left.Interface().(Period).AddTo(right.Interface().(time.Time))), true, nil
+	case left.Type() == DurationType && right.Type() == DurationType:
+		if subtract {
+
+			return reflect.ValueOf(left.Interface().(time.Duration) - right.Interface().(time.Duration)), true, nil
+		}
+
+		return reflect.ValueOf(left.Interface().(time.Duration) + right.Interface().(time.Duration)), true, nil
+	case left.Type() == TimeType || right.Type() == TimeType:
+
+		return reflect.Value{}, true, fmt.Errorf("can not %s %s and %s", operation, left.Type().String(), right.Type().String())
+	}
+
+	// a duration or a period with a number or a string falls back to the arithmetic of their kinds.
+	return reflect.Value{}, false, nil
+}
+
+func isTimeType(typ reflect.Type) bool {
+
+	return typ == TimeType || typ == DurationType || typ == PeriodType
+}
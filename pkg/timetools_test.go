@@ -0,0 +1,108 @@
+//  Copyright DataWiseHQ/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package pkg
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDurationLiteral(t *testing.T) {
+	for text, expected := range map[string]interface{}{
+		"30d":          30 * 24 * time.Hour,
+		"2h":           2 * time.Hour,
+		"1h30m":        90 * time.Minute,
+		"2w":           14 * 24 * time.Hour,
+		"500ms":        500 * time.Millisecond,
+		"PT2H30M":      150 * time.Minute,
+		"P1W":          7 * 24 * time.Hour,
+		"P1DT12H":      36 * time.Hour,
+		"P1M":          Period{Months: 1},
+		"P1Y2M10DT2H":  Period{Years: 1, Months: 2, Days: 10, Duration: 2 * time.Hour},
+		"P18Y":         Period{Years: 18},
+		"PT10S":        10 * time.Second,
+		"P2M3D":        Period{Months: 2, Days: 3},
+		"1d12h":        36 * time.Hour,
+		"10s":          10 * time.Second,
+		"P1Y2M3DT4M5S": Period{Years: 1, Months: 2, Days: 3, Duration: 4*time.Minute + 5*time.Second},
+	} {
+		val, err := ParseDurationLiteral(text)
+		assert.NoError(t, err, text)
+		assert.Equal(t, expected, val.Interface(), text)
+	}
+
+	for _, text := range []string{"30x", "P1H", "PT1D", "d"} {
+		_, err := ParseDurationLiteral(text)
+		assert.Error(t, err, text)
+	}
+}
+
+func TestParseDateLiteral(t *testing.T) {
+	date, err := ParseDateLiteral("@2024-01-01")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), date)
+
+	date, err = ParseDateLiteral("@2024-01-01T10:30:15+02:00")
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2024, 1, 1, 8, 30, 15, 0, time.UTC).Equal(date))
+
+	_, err = ParseDateLiteral("@2024-02-30")
+	assert.Error(t, err)
+}
+
+func TestEvaluateTimeArithmetic(t *testing.T) {
+	start := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+	startVal := reflect.ValueOf(start)
+	dayVal := reflect.ValueOf(24 * time.Hour)
+	monthVal := reflect.ValueOf(Period{Months: 1})
+
+	val, err := EvaluateAddition(startVal, dayVal)
+	assert.NoError(t, err)
+	assert.Equal(t, start.Add(24*time.Hour), val.Interface())
+
+	val, err = EvaluateAddition(dayVal, reflect.ValueOf(&start))
+	assert.NoError(t, err)
+	assert.Equal(t, start.Add(24*time.Hour), val.Interface())
+
+	val, err = EvaluateAddition(startVal, monthVal)
+	assert.NoError(t, err)
+	assert.Equal(t, start.AddDate(0, 1, 0), val.Interface())
+
+	val, err = EvaluateSubtraction(startVal, monthVal)
+	assert.NoError(t, err)
+	assert.Equal(t, start.AddDate(0, -1, 0), val.Interface())
+
+	val, err = EvaluateSubtraction(startVal, reflect.ValueOf(start.Add(-time.Hour)))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, val.Interface())
+
+	val, err = EvaluateAddition(dayVal, reflect.ValueOf(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 25*time.Hour, val.Interface())
+
+	_, err = EvaluateAddition(startVal, startVal)
+	assert.Error(t, err)
+	_, err = EvaluateSubtraction(dayVal, startVal)
+	assert.Error(t, err)
+	_, err = EvaluateAddition(startVal, reflect.ValueOf(1))
+	assert.Error(t, err)
+
+	val, err = EvaluateEqual(startVal, reflect.ValueOf(start.In(time.FixedZone("UTC+2", 2*60*60))))
+	assert.NoError(t, err)
+	assert.True(t, val.Bool())
+}